@@ -11,6 +11,7 @@ import (
 	"finance-manager-backend/internal/finance-mngr/repository/dbrepo"
 	"finance-manager-backend/internal/finance-mngr/service/fmservice"
 	"finance-manager-backend/internal/finance-mngr/service/polygonservice"
+	"finance-manager-backend/internal/finance-mngr/tax"
 	"finance-manager-backend/internal/finance-mngr/validation"
 	"fmt"
 	"log"
@@ -71,6 +72,21 @@ func main() {
 
 	app.ExternalService = &externalService
 
+	//Load tax tables. Incomes fall back to their flat tax rate if they are unavailable
+	var taxEngine tax.TaxEngine
+	bte, err := tax.LoadBracketTaxEngine(config.GetEnvFromEnvValue(appConfig.TaxTableFile))
+
+	if err != nil {
+		klogger.Warn(method, "failed to load tax tables, flat tax rates will be used:\n%v", err)
+	} else {
+		taxEngine = bte
+	}
+
+	app.Service = &fmservice.FMService{
+		DB:        app.DB,
+		TaxEngine: taxEngine,
+	}
+
 	app.Handler = &fmhandler.FinanceManagerHandler{
 		JSONUtil:        &jsonutils.JSONUtil{},
 		DB:              app.DB,
@@ -78,10 +94,8 @@ func main() {
 		Validator:       &validation.FinanceManagerValidator{DB: app.DB},
 		Version:         constants.AppVersion,
 		ExternalService: &externalService,
-		Service: &fmservice.FMService{
-			DB: app.DB,
-		},
-		ApiPort: port,
+		Service:         app.Service,
+		ApiPort:         port,
 	}
 
 	defer app.DB.Connection().Close()
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"finance-manager-backend/internal/finance-mngr/enums/compounding"
 	"finance-manager-backend/internal/finance-mngr/enums/payfrequency"
 	"finance-manager-backend/internal/finance-mngr/models/restmodels"
 	"flag"
@@ -21,9 +22,12 @@ func main() {
 	cmd := flag.NewFlagSet("calcSavings", flag.ExitOnError)
 	goal := cmd.Float64("goal", 0.0, "savings goal")
 	amount := cmd.Float64("amount", 0.0, "amount per pay to save")
-	payFreq := cmd.String("payFreq", "", "frequency of pay. Options are 'weekly', 'bi-weekly', and 'monthly'")
+	payFreq := cmd.String("payFreq", "", "frequency of pay. Options are 'weekly', 'bi-weekly', 'semi-monthly', 'monthly', 'quarterly', and 'annual'")
 	nPay := cmd.String("nextPay", "", "next payment date in yyyy-mm-dd format")
 	d := cmd.String("deadline", "", "deadline for goal in yyyy-mm-dd format")
+	startingBalance := cmd.Float64("startingBalance", 0.0, "amount already saved")
+	apy := cmd.Float64("apy", 0.0, "annual percentage yield earned on savings")
+	comp := cmd.String("compounding", "", "how often interest is credited. Options are 'daily', 'monthly', 'quarterly', and 'annual'. Default is 'monthly'")
 
 	cmd.Parse(os.Args[2:])
 
@@ -36,6 +40,9 @@ func main() {
 	r.PayFrequency = payfrequency.GetPayFrequency(*payFreq)
 	r.NextPay = getDate(*nPay)
 	r.Deadline = getDate(*d)
+	r.StartingBalance = *startingBalance
+	r.APY = *apy
+	r.Compounding = compounding.Compounding(*comp)
 
 	resp, err := r.Calculate()
 
@@ -45,18 +52,24 @@ func main() {
 
 	klogger.Info(method, "Deadline: %v", resp.Deadline)
 	klogger.Info(method, "Number of pays before deadline: %d", resp.NumPays)
+	if resp.StartingBalance > 0.0 {
+		klogger.Info(method, "Starting balance: $%.2f", resp.StartingBalance)
+	}
+
 	if r.Goal > 0.0 {
 		klogger.Info(method, "Goal Details:")
 		klogger.Info(method, "Goal: $%.2f", resp.Goal)
 		klogger.Info(method, "Save per pay: $%.2f", resp.PerPay)
+		klogger.Info(method, "Interest earned by deadline: $%.2f", resp.GoalInterest)
 	}
 
 	if resp.Actual > 0.0 {
 		klogger.Info(method, "Savings Details:")
 		klogger.Info(method, "Amount saving per pay: $%.2f", resp.ManPerPay)
 		klogger.Info(method, "Amount saved by deadline: $%.2f", resp.Actual)
+		klogger.Info(method, "Interest earned by deadline: $%.2f", resp.Interest)
 	}
-	
+
 	klogger.Exit(method)
 }
 
@@ -72,7 +72,7 @@ const docTemplate = `{
         },
         "/calc-savings": {
             "post": {
-                "description": "Performs calculation on request and returns a result without saving",
+                "description": "Performs calculation on request and returns a result without saving\nWhen an apy is supplied the per pay amount and projected savings include the interest earned before the deadline",
                 "produces": [
                     "application/json"
                 ],
@@ -95,7 +95,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Income"
+                            "$ref": "#/definitions/restmodels.SavingsCalculationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
                     "403": {
@@ -542,16 +548,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/bills": {
+        "/users/{userId}/bank-accounts": {
             "get": {
-                "description": "Returns an array of Bill objects belonging to a given user",
+                "description": "Returns an array of Bank Account objects belonging to a given user with their current balances",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Bills"
+                    "Bank Accounts"
                 ],
-                "summary": "Get All User Bills",
+                "summary": "Get All User Bank Accounts",
                 "parameters": [
                     {
                         "type": "integer",
@@ -562,7 +568,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Search for bills by name",
+                        "description": "Search for bank accounts by name",
                         "name": "search",
                         "in": "query"
                     }
@@ -573,7 +579,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Bill"
+                                "$ref": "#/definitions/models.BankAccount"
                             }
                         }
                     },
@@ -583,12 +589,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/jsonutils.JSONResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -598,7 +598,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Inserts a new Bill into the Database for a given user",
+                "description": "Inserts a new checking, savings or cash account holding openingBalance on openingDt into the Database for a given user",
                 "consumes": [
                     "application/json"
                 ],
@@ -606,9 +606,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Bills"
+                    "Bank Accounts"
                 ],
-                "summary": "Insert Bill",
+                "summary": "Insert Bank Account",
                 "parameters": [
                     {
                         "type": "integer",
@@ -618,30 +618,30 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "The bill to insert",
-                        "name": "bill",
+                        "description": "The bank account to insert",
+                        "name": "account",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Bill"
+                            "$ref": "#/definitions/models.BankAccount"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
@@ -655,16 +655,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/bills/{billId}": {
+        "/users/{userId}/bank-accounts/{accountId}": {
             "get": {
-                "description": "Returns a Bill by its ID for a given user",
+                "description": "Returns a Bank Account by its ID for a given user with its current balance and transaction ledger",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Bills"
+                    "Bank Accounts"
                 ],
-                "summary": "Get Bill by ID",
+                "summary": "Get Bank Account by ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -675,8 +675,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "Bill ID",
-                        "name": "billId",
+                        "description": "ID of the Bank Account",
+                        "name": "accountId",
                         "in": "path",
                         "required": true
                     }
@@ -685,7 +685,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Bill"
+                            "$ref": "#/definitions/models.BankAccount"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
                     "403": {
@@ -709,7 +715,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Updates an existing Bill for a user",
+                "description": "Updates an existing Bank Account for a user. The account's transactions are kept",
                 "consumes": [
                     "application/json"
                 ],
@@ -717,9 +723,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Bills"
+                    "Bank Accounts"
                 ],
-                "summary": "Update Bill",
+                "summary": "Update Bank Account",
                 "parameters": [
                     {
                         "type": "integer",
@@ -730,18 +736,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "ID of the bill to update",
-                        "name": "billId",
+                        "description": "ID of the Bank Account to update",
+                        "name": "accountId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "The bill to update",
-                        "name": "bill",
+                        "description": "The bank account to update",
+                        "name": "account",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Bill"
+                            "$ref": "#/definitions/models.BankAccount"
                         }
                     }
                 ],
@@ -752,6 +758,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
@@ -773,14 +785,14 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Deletes a user's Bill by its ID",
+                "description": "Deletes a user's Bank Account and its transactions by its ID. Incomes and bills posting to the account are unlinked from it",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Bills"
+                    "Bank Accounts"
                 ],
-                "summary": "Delete Bill by ID",
+                "summary": "Delete Bank Account by ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -791,15 +803,21 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "ID of the bill",
-                        "name": "billId",
+                        "description": "ID of the Bank Account",
+                        "name": "accountId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
@@ -825,16 +843,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/credit-cards": {
-            "get": {
-                "description": "Returns an array of CreditCard objects belonging to a given user",
+        "/users/{userId}/bank-accounts/{accountId}/import": {
+            "post": {
+                "description": "Imports the transactions of an OFX, QFX or CSV statement exported by a bank to a Bank Account's ledger\nTransactions are identified by the FITID of OFX and QFX statements, or the idColumn of CSV statements, and otherwise by a hash of their date, amount, payee and memo. Transactions already imported to the account are skipped\nEach imported transaction suggests the income, bill or credit card it appears to be for. Accounts that import statements should not also be linked to incomes and bills, or their paydays and due dates are counted twice\nCSV columns are named by their header, or by their zero based index when noHeader is true. Either amountColumn, holding signed amounts, or debitColumn and creditColumn are required",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Credit Cards"
+                    "Bank Accounts"
                 ],
-                "summary": "Get All User Credit Cards",
+                "summary": "Import Bank Statement",
                 "parameters": [
                     {
                         "type": "integer",
@@ -843,73 +864,90 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Bank Account",
+                        "name": "accountId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "The statement to import",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
                     {
                         "type": "string",
-                        "description": "Search for Credit Cards by name",
-                        "name": "search",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.CreditCard"
-                            }
-                        }
+                        "description": "One of ofx, qfx or csv. Default is the extension of the file",
+                        "name": "format",
+                        "in": "formData"
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/jsonutils.JSONResponse"
-                        }
+                    {
+                        "type": "string",
+                        "description": "CSV column holding the transaction date",
+                        "name": "dateColumn",
+                        "in": "formData"
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/jsonutils.JSONResponse"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Go layout of CSV dates. Default is 01/02/2006",
+                        "name": "dateFormat",
+                        "in": "formData"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/jsonutils.JSONResponse"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "description": "Inserts a new Credit Card object for a given user",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Credit Cards"
-                ],
-                "summary": "Insert Credit Card",
-                "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "userId",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "CSV column holding signed amounts",
+                        "name": "amountColumn",
+                        "in": "formData"
                     },
                     {
-                        "description": "Credit card to insert",
-                        "name": "creditCard",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreditCard"
-                        }
+                        "type": "string",
+                        "description": "CSV column holding withdrawals",
+                        "name": "debitColumn",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSV column holding deposits",
+                        "name": "creditColumn",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSV column holding the payee",
+                        "name": "payeeColumn",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSV column holding the memo",
+                        "name": "memoColumn",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSV column holding the bank's transaction id",
+                        "name": "idColumn",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "True if the CSV has no header row",
+                        "name": "noHeader",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatementImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
@@ -926,6 +964,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -935,16 +979,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/credit-cards/{ccId}": {
+        "/users/{userId}/bank-accounts/{accountId}/transactions": {
             "get": {
-                "description": "Fetches a Credit Card by its ID for a given user",
+                "description": "Returns the transaction ledger of a Bank Account in the order the transactions were made",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Credit Cards"
+                    "Bank Accounts"
                 ],
-                "summary": "Get Credit Card by ID",
+                "summary": "Get All Bank Account Transactions",
                 "parameters": [
                     {
                         "type": "integer",
@@ -955,8 +999,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "ID of the Credit Card",
-                        "name": "ccId",
+                        "description": "ID of the Bank Account",
+                        "name": "accountId",
                         "in": "path",
                         "required": true
                     }
@@ -965,7 +1009,16 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CreditCard"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.BankAccountTransaction"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
                     "403": {
@@ -988,15 +1041,18 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Updates a Credit Card by its ID for a given user",
+            "post": {
+                "description": "Records a deposit to or withdrawal from a Bank Account. Amounts are always positive, and a category may be given by hand\nTransfers are recorded with a type of transfer-out or transfer-in and the other account as transferAccountId, and post to both accounts",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Credit Cards"
+                    "Bank Accounts"
                 ],
-                "summary": "Update Credit Card by ID",
+                "summary": "Insert Bank Account Transaction",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1007,15 +1063,30 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "ID of the Credit Card",
-                        "name": "ccId",
+                        "description": "ID of the Bank Account",
+                        "name": "accountId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "The transaction to insert",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BankAccountTransaction"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
@@ -1039,16 +1110,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/users/{userId}/bank-accounts/{accountId}/transactions/{transactionId}": {
             "delete": {
-                "description": "Deletes a Credit Card by its ID for a given user",
+                "description": "Deletes a transaction from the ledger of a Bank Account belonging to a given user. Deleting either side of a transfer deletes both",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Credit Cards"
+                    "Bank Accounts"
                 ],
-                "summary": "Delete Credit Card by ID",
+                "summary": "Delete Bank Account Transaction by ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1059,15 +1132,28 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "ID of the Credit Card",
-                        "name": "ccId",
+                        "description": "ID of the Bank Account",
+                        "name": "accountId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Bank Account Transaction",
+                        "name": "transactionId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
@@ -1093,16 +1179,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/incomes": {
+        "/users/{userId}/bills": {
             "get": {
-                "description": "Returns an array of Income objects belonging to a given user",
+                "description": "Returns an array of Bill objects belonging to a given user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Incomes"
+                    "Bills"
                 ],
-                "summary": "Get All User Incomes",
+                "summary": "Get All User Bills",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1113,7 +1199,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Search for incomes by name",
+                        "description": "Search for bills by name",
                         "name": "search",
                         "in": "query"
                     }
@@ -1124,7 +1210,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Income"
+                                "$ref": "#/definitions/models.Bill"
                             }
                         }
                     },
@@ -1149,7 +1235,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Inserts a new Income into the Database for a given user",
+                "description": "Inserts a new Bill into the Database for a given user\nAvailable frequencies are 'weekly', 'bi-weekly', 'monthly', 'quarterly' and 'annual'. Bills without a frequency are monthly\nA due date is required for bills that are not monthly and is used as the anchor date for their recurrence\nBills with an accountId and a due date are paid from that bank account on each due date",
                 "consumes": [
                     "application/json"
                 ],
@@ -1157,9 +1243,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Incomes"
+                    "Bills"
                 ],
-                "summary": "Insert Income",
+                "summary": "Insert Bill",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1169,12 +1255,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "The income to insert",
-                        "name": "income",
+                        "description": "The bill to insert",
+                        "name": "bill",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Income"
+                            "$ref": "#/definitions/models.Bill"
                         }
                     }
                 ],
@@ -1206,16 +1292,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/incomes/{incomeId}": {
+        "/users/{userId}/bills/{billId}": {
             "get": {
-                "description": "Returns an Income object belonging to a given user",
+                "description": "Returns a Bill by its ID for a given user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Incomes"
+                    "Bills"
                 ],
-                "summary": "Get Income by ID",
+                "summary": "Get Bill by ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1226,8 +1312,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "the ID of the Income",
-                        "name": "incomeId",
+                        "description": "Bill ID",
+                        "name": "billId",
                         "in": "path",
                         "required": true
                     }
@@ -1236,7 +1322,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Income"
+                            "$ref": "#/definitions/models.Bill"
                         }
                     },
                     "403": {
@@ -1260,7 +1346,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Updates an existing Income for a user",
+                "description": "Updates an existing Bill for a user",
                 "consumes": [
                     "application/json"
                 ],
@@ -1268,9 +1354,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Incomes"
+                    "Bills"
                 ],
-                "summary": "Update Income",
+                "summary": "Update Bill",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1281,18 +1367,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "ID of the income to update",
-                        "name": "incomeId",
+                        "description": "ID of the bill to update",
+                        "name": "billId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "The income to update",
-                        "name": "income",
+                        "description": "The bill to update",
+                        "name": "bill",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Income"
+                            "$ref": "#/definitions/models.Bill"
                         }
                     }
                 ],
@@ -1303,6 +1389,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
@@ -1324,14 +1416,14 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Deletes a user's Income by its ID",
+                "description": "Deletes a user's Bill by its ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Incomes"
+                    "Bills"
                 ],
-                "summary": "Delete Income by ID",
+                "summary": "Delete Bill by ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1342,8 +1434,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "ID of the Income",
-                        "name": "incomeId",
+                        "description": "ID of the bill",
+                        "name": "billId",
                         "in": "path",
                         "required": true
                     }
@@ -1376,16 +1468,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/loans": {
+        "/users/{userId}/budgets": {
             "get": {
-                "description": "Returns an array of Loan objects belonging to a given user",
+                "description": "Returns an array of Budget objects belonging to a given user ordered by category",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Loans"
+                    "Budgets"
                 ],
-                "summary": "Get All User Loans",
+                "summary": "Get All User Budgets",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1393,12 +1485,6 @@ const docTemplate = `{
                         "name": "userId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search for loans by name",
-                        "name": "search",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1407,7 +1493,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Loan"
+                                "$ref": "#/definitions/models.Budget"
                             }
                         }
                     },
@@ -1417,12 +1503,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/jsonutils.JSONResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1432,7 +1512,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Inserts a new Loan into the Database for a given user",
+                "description": "Inserts a new Budget into the Database for a given user. Users may have one budget per category\nBudgets linked to a bill or loan by source and sourceId are fixed. Their category defaults to the name of the bill or loan, and a limit of 0 defaults to its cost each month\nstartDt is the first month of the budget and defaults to the current month",
                 "consumes": [
                     "application/json"
                 ],
@@ -1440,9 +1520,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Loans"
+                    "Budgets"
                 ],
-                "summary": "Insert Loan",
+                "summary": "Insert Budget",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1452,30 +1532,30 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "The loan to insert",
-                        "name": "loan",
+                        "description": "The budget to insert",
+                        "name": "budget",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Loan"
+                            "$ref": "#/definitions/models.Budget"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
@@ -1489,19 +1569,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/loans-summary": {
+        "/users/{userId}/budgets/status": {
             "get": {
-                "description": "Gets a summary of all loans for a user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Compares each of a user's budgets to their planned and actual spending for a month\nPlanned spending is the cost of the bill or loan a fixed budget is linked to. Actual spending is the net amount withdrawn in the budget's category, and is only reported when the user has bank account transactions during the month\nBudgets that roll over add the part of each month's limit that was not spent to the next month's available amount",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Loans"
+                    "Budgets"
                 ],
-                "summary": "Get User Loan Summary",
+                "summary": "Get Budget Statuses",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1509,23 +1586,32 @@ const docTemplate = `{
                         "name": "userId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "A date in the month to compare in YYYY-MM-DD format. Default is today",
+                        "name": "date",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.LoansSummary"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.BudgetStatus"
+                            }
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
@@ -1539,16 +1625,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/loans/{loanId}": {
+        "/users/{userId}/budgets/{budgetId}": {
             "get": {
-                "description": "Returns a Loan object belonging to a given user",
+                "description": "Returns a Budget by its ID for a given user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Loans"
+                    "Budgets"
                 ],
-                "summary": "Get Loan by ID",
+                "summary": "Get Budget by ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1559,8 +1645,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "the ID of the Loan",
-                        "name": "loanId",
+                        "description": "ID of the Budget",
+                        "name": "budgetId",
                         "in": "path",
                         "required": true
                     }
@@ -1569,7 +1655,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Loan"
+                            "$ref": "#/definitions/models.Budget"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
                     "403": {
@@ -1593,7 +1685,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Updates an existing Loan for a user",
+                "description": "Updates an existing Budget for a user",
                 "consumes": [
                     "application/json"
                 ],
@@ -1601,9 +1693,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Loans"
+                    "Budgets"
                 ],
-                "summary": "Update Loan",
+                "summary": "Update Budget",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1614,18 +1706,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "ID of the loan to update",
-                        "name": "loanId",
+                        "description": "ID of the Budget to update",
+                        "name": "budgetId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "The loan to update",
-                        "name": "loan",
+                        "description": "The budget to update",
+                        "name": "budget",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Loan"
+                            "$ref": "#/definitions/models.Budget"
                         }
                     }
                 ],
@@ -1636,6 +1728,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
@@ -1657,14 +1755,14 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Deletes a Loan object belonging to a given user",
+                "description": "Deletes a user's Budget by its ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Loans"
+                    "Budgets"
                 ],
-                "summary": "Delete Loan by ID",
+                "summary": "Delete Budget by ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1675,17 +1773,23 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "the ID of the Loan",
-                        "name": "loanId",
+                        "description": "ID of the Budget",
+                        "name": "budgetId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "$ref": "#/definitions/models.Loan"
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
                     "403": {
@@ -1709,16 +1813,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/loans/{loanId}/calculate": {
-            "post": {
-                "description": "Performs calculations on a loan and returns the loan with updated values.\nDoes not Persist values",
+        "/users/{userId}/calendar": {
+            "get": {
+                "description": "Returns every income payday, bill due date, loan payment and credit card minimum payment expected between two dates\nEach event includes the projected balance after it occurs. Credit cards and bills without a due date are due monthly on the day they were created\nLoans are due monthly on the day of their most recent payment. Loan and credit card payments are projected from their current balances",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Loans"
+                    "Calendar"
                 ],
-                "summary": "Calculate Loan Values",
+                "summary": "Get User Calendar",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1728,40 +1832,39 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Loan ID. Will also accept 'new' for unsaved loan",
-                        "name": "loanId",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "First date of the calendar in YYYY-MM-DD format. Default is today",
+                        "name": "from",
+                        "in": "query"
                     },
                     {
-                        "description": "The Loan to Calculate values for",
-                        "name": "loan",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Loan"
-                        }
+                        "type": "string",
+                        "description": "Last date of the calendar in YYYY-MM-DD format. Default is one month after from",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "The balance to project events from. Default is 0",
+                        "name": "balance",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Loan"
-                            }
+                            "$ref": "#/definitions/models.Calendar"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
@@ -1775,16 +1878,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/loans/{loanId}/compare-payments": {
-            "post": {
-                "description": "Performs calculations on a loan and a Persisted loan with an Id, then returns a list comparing the two\nDoes not Persist values",
+        "/users/{userId}/categorization-rules": {
+            "get": {
+                "description": "Returns an array of Categorization Rule objects belonging to a given user in the order they are applied",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Loans"
+                    "Categorization Rules"
                 ],
-                "summary": "Compare Loan Payments",
+                "summary": "Get All User Categorization Rules",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1792,22 +1895,6 @@ const docTemplate = `{
                         "name": "userId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The ID of the persisted loan to compare against",
-                        "name": "loanId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "The new Loan to calculate and compare",
-                        "name": "loan",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Loan"
-                        }
                     }
                 ],
                 "responses": {
@@ -1816,7 +1903,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.PaymentScheduleComparisonItem"
+                                "$ref": "#/definitions/models.CategorizationRule"
                             }
                         }
                     },
@@ -1826,12 +1913,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/jsonutils.JSONResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1839,51 +1920,52 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/{userId}/roles": {
-            "get": {
-                "description": "Returns an array of UserRole objects belonging to a given user",
+            },
+            "post": {
+                "description": "Inserts a new Categorization Rule into the Database for a given user\nRules match transactions whose payee matches the payeePattern regular expression without regard to case, whose amount is between minAmount and maxAmount and that were made in accountId. Criteria that are not set match every transaction\nMatched transactions are given the rule's category and linked to the income, bill or credit-card named by source and sourceId when they are set and the transaction is not already linked. Only the matching rule with the lowest priority is applied",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User Roles"
+                    "Categorization Rules"
                 ],
-                "summary": "Get All User Roles",
+                "summary": "Insert Categorization Rule",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID of the user we are searching for",
+                        "description": "User ID",
                         "name": "userId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Search for user role by role name",
-                        "name": "search",
-                        "in": "query"
+                        "description": "The categorization rule to insert",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CategorizationRule"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.UserRole"
-                            }
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
@@ -1897,30 +1979,23 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/roles/{roleId}": {
+        "/users/{userId}/categorization-rules/apply": {
             "post": {
-                "description": "Adds a new role to a User",
+                "description": "Re-runs a user's Categorization Rules against all of their bank account transactions. Rules are also applied to transactions as they are imported",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User Roles"
+                    "Categorization Rules"
                 ],
-                "summary": "Add User Role",
+                "summary": "Apply Categorization Rules",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID of the user to add a role to",
+                        "description": "User ID",
                         "name": "userId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "ID of the role to add to the user",
-                        "name": "roleId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -1936,12 +2011,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/jsonutils.JSONResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1949,28 +2018,30 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Removes a role from a a User",
+            }
+        },
+        "/users/{userId}/categorization-rules/{ruleId}": {
+            "get": {
+                "description": "Returns a Categorization Rule by its ID for a given user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User Roles"
+                    "Categorization Rules"
                 ],
-                "summary": "Remove User Role",
+                "summary": "Get Categorization Rule by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID of the user to remove a role from",
+                        "description": "User ID",
                         "name": "userId",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "integer",
-                        "description": "ID of the role to remove from the user",
-                        "name": "roleId",
+                        "description": "ID of the Categorization Rule",
+                        "name": "ruleId",
                         "in": "path",
                         "required": true
                     }
@@ -1978,6 +2049,12 @@ const docTemplate = `{
                 "responses": {
                     "200": {
                         "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CategorizationRule"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
@@ -2001,11 +2078,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/{userId}/stock-operation": {
-            "post": {
-                "description": "Modifies a user's stock. This is an add or remove operation and can be used to post new stock",
+            },
+            "put": {
+                "description": "Updates an existing Categorization Rule for a user. Transactions it has already categorized are not changed until the rules are applied again",
                 "consumes": [
                     "application/json"
                 ],
@@ -2013,24 +2088,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Stocks"
+                    "Categorization Rules"
                 ],
-                "summary": "Modify User Stock",
+                "summary": "Update Categorization Rule",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID of the user to modify stocks for",
+                        "description": "User ID",
                         "name": "userId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "The request to process",
-                        "name": "request",
+                        "type": "integer",
+                        "description": "ID of the Categorization Rule to update",
+                        "name": "ruleId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The categorization rule to update",
+                        "name": "rule",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/restmodels.ModifyStockRequest"
+                            "$ref": "#/definitions/models.CategorizationRule"
                         }
                     }
                 ],
@@ -2041,6 +2123,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
@@ -2060,21 +2148,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/{userId}/stock-portfolio": {
-            "get": {
-                "description": "Gets a summary of all stock data for a user",
-                "consumes": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Deletes a user's Categorization Rule by its ID. Transactions it has categorized keep their category",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Summary"
+                    "Categorization Rules"
                 ],
-                "summary": "Get Stock Portfolio Summary",
+                "summary": "Delete Categorization Rule by ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2082,13 +2165,26 @@ const docTemplate = `{
                         "name": "userId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Categorization Rule",
+                        "name": "ruleId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "$ref": "#/definitions/models.UserStockPortfolioSummary"
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
                     "403": {
@@ -2112,39 +2208,93 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/stock-portfolio-history": {
+        "/users/{userId}/credit-cards": {
             "get": {
-                "description": "Gets History of a User's Stock Portfolio Balance",
-                "consumes": [
+                "description": "Returns an array of CreditCard objects belonging to a given user",
+                "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "Credit Cards"
+                ],
+                "summary": "Get All User Credit Cards",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search for Credit Cards by name",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CreditCard"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Inserts a new Credit Card object for a given user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Stocks"
+                    "Credit Cards"
                 ],
-                "summary": "Get User Stock Portfolio History",
+                "summary": "Insert Credit Card",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "The ID of the user to get Portfolio History for",
+                        "description": "User ID",
                         "name": "userId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "The lenght of history to fetch. Available values are 'week', 'month', and 'year'. Default is 'week'",
-                        "name": "histLength",
-                        "in": "query"
+                        "description": "Credit card to insert",
+                        "name": "creditCard",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreditCard"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.StockPortfolioHistoryResponse"
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
                     "403": {
@@ -2168,48 +2318,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/stocks": {
+        "/users/{userId}/credit-cards/{ccId}": {
             "get": {
-                "description": "Gets a list of stocks currently owned or watched by a given user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Fetches a Credit Card by its ID for a given user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Stocks"
+                    "Credit Cards"
                 ],
-                "summary": "Get User Stocks",
+                "summary": "Get Credit Card by ID",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "The ID of the user to fetch stocks for",
+                        "type": "integer",
+                        "description": "User ID",
                         "name": "userId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "The Type of stocks to fetch. Available types are {'own','watchlist'}. Default is 'own'.",
-                        "name": "type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search for User stocks by ticker",
-                        "name": "search",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "ID of the Credit Card",
+                        "name": "ccId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Stock"
-                            }
+                            "$ref": "#/definitions/models.CreditCard"
                         }
                     },
                     "403": {
@@ -2232,18 +2371,15 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Inserts a new Stock into the Database for a given user",
-                "consumes": [
-                    "application/json"
-                ],
+            "put": {
+                "description": "Updates a Credit Card by its ID for a given user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Stocks"
+                    "Credit Cards"
                 ],
-                "summary": "Insert Stock",
+                "summary": "Update Credit Card by ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2253,13 +2389,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "The stock to insert",
-                        "name": "stock",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UserStock"
-                        }
+                        "type": "integer",
+                        "description": "ID of the Credit Card",
+                        "name": "ccId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2288,21 +2422,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/{userId}/summary": {
-            "get": {
-                "description": "Gets a summary of all financial data for a user",
-                "consumes": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Deletes a Credit Card by its ID for a given user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Summary"
+                    "Credit Cards"
                 ],
-                "summary": "Get Finance Summary",
+                "summary": "Delete Credit Card by ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2310,13 +2439,20 @@ const docTemplate = `{
                         "name": "userId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Credit Card",
+                        "name": "ccId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Summary"
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
                         }
                     },
                     "403": {
@@ -2339,408 +2475,4647 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "authentication.TokenPairs": {
-            "type": "object",
-            "properties": {
-                "access_token": {
-                    "type": "string"
-                },
-                "refresh_token": {
-                    "type": "string"
-                }
-            }
-        },
-        "jsonutils.JSONResponse": {
-            "type": "object",
-            "properties": {
-                "data": {},
-                "error": {
-                    "type": "boolean"
-                },
-                "message": {
-                    "type": "string"
-                }
-            }
         },
-        "models.Bill": {
-            "type": "object",
-            "properties": {
-                "amount": {
-                    "type": "number"
+        "/users/{userId}/credit-cards/{ccId}/projection": {
+            "get": {
+                "description": "Projects paying off a Credit Card with only minimum payments and optionally with a fixed monthly payment\nInterest is accrued monthly from the card's APR and the minimum payment is recalculated as the balance falls",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Credit Cards"
+                ],
+                "summary": "Get Credit Card Payoff Projection",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Credit Card",
+                        "name": "ccId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "A fixed monthly payment to compare against paying only the minimum",
+                        "name": "payment",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreditCardProjection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/debt-plan": {
+            "get": {
+                "description": "Simulates paying off all of a user's loans and credit cards with a fixed monthly budget\nPayments freed up by paid off debts are rolled into the next debt chosen by the strategy",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Debt Plan"
+                ],
+                "summary": "Get Debt Payoff Plan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The payoff strategy. Available values are 'avalanche' and 'snowball'. Default is 'avalanche'",
+                        "name": "strategy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "The total monthly amount available for debt payments",
+                        "name": "budget",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DebtPlan"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/incomes": {
+            "get": {
+                "description": "Returns an array of Income objects belonging to a given user\nEach income includes a paystub breaking a single paycheck down from gross pay to net pay",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Incomes"
+                ],
+                "summary": "Get All User Incomes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search for incomes by name",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Income"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Inserts a new Income into the Database for a given user\nAvailable frequencies are 'weekly', 'bi-weekly', 'semi-monthly', 'monthly', 'quarterly' and 'annual'. Semi-monthly incomes are paid on the 15th and last day of each month\nPaydays that fall on a weekend are paid the Friday before\nIncomes with an end date are not paid after it. Use income versions to record changes to the pay rate\nHourly incomes pay hours past regularHoursCap in a paycheck at overtimeMultiplier times the rate (1.5 if not set). Use income hours to log the hours actually worked\nIncomes with an accountId deposit their net pay to that bank account on each payday",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Incomes"
+                ],
+                "summary": "Insert Income",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The income to insert",
+                        "name": "income",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Income"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/incomes/{incomeId}": {
+            "get": {
+                "description": "Returns an Income object belonging to a given user\nThe income includes a paystub breaking a single paycheck down from gross pay to net pay",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Incomes"
+                ],
+                "summary": "Get Income by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the ID of the Income",
+                        "name": "incomeId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Income"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing Income for a user\nAvailable frequencies are 'weekly', 'bi-weekly', 'semi-monthly', 'monthly', 'quarterly' and 'annual'. Semi-monthly incomes are paid on the 15th and last day of each month\nPaydays that fall on a weekend are paid the Friday before\nIncomes with an end date are not paid after it. Use income versions to record changes to the pay rate\nHourly incomes pay hours past regularHoursCap in a paycheck at overtimeMultiplier times the rate (1.5 if not set). Use income hours to log the hours actually worked\nIncomes with an accountId deposit their net pay to that bank account on each payday",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Incomes"
+                ],
+                "summary": "Update Income",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the income to update",
+                        "name": "incomeId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The income to update",
+                        "name": "income",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Income"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a user's Income by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Incomes"
+                ],
+                "summary": "Delete Income by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Income",
+                        "name": "incomeId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/incomes/{incomeId}/hours": {
+            "get": {
+                "description": "Returns the hours logged for each pay period of an hourly Income in the order of their pay dates",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Income Hours"
+                ],
+                "summary": "Get All Income Hours",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Income",
+                        "name": "incomeId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.IncomeHours"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Logs the hours actually worked in the pay period of an hourly Income ending on a given payday\nPaychecks are paid for their logged hours, and paychecks without logged hours are projected from the average of the most recent pay periods logged before them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Income Hours"
+                ],
+                "summary": "Insert Income Hours",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Income",
+                        "name": "incomeId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The hours to log",
+                        "name": "hours",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.IncomeHours"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/incomes/{incomeId}/hours/{hoursId}": {
+            "delete": {
+                "description": "Deletes the hours logged for a pay period of an Income belonging to a given user. The paycheck is projected from the pay periods logged before it in their place",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Income Hours"
+                ],
+                "summary": "Delete Income Hours by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Income",
+                        "name": "incomeId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the logged Income Hours",
+                        "name": "hoursId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/incomes/{incomeId}/versions": {
+            "get": {
+                "description": "Returns the pay rate changes of an Income in the order they take effect\nEach version expires when the next begins, and the last version expires on the income's end date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Income Versions"
+                ],
+                "summary": "Get All Income Versions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Income",
+                        "name": "incomeId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.IncomeVersion"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Records a change to the pay rate of an Income, such as a raise, that takes effect on a given date\nPaychecks on or after the effective date are paid at the new rate. Versions without hours keep the hours of the income",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Income Versions"
+                ],
+                "summary": "Insert Income Version",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Income",
+                        "name": "incomeId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The income version to insert",
+                        "name": "version",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.IncomeVersion"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/incomes/{incomeId}/versions/{versionId}": {
+            "delete": {
+                "description": "Deletes a pay rate change of an Income belonging to a given user. The previous version remains in effect in its place",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Income Versions"
+                ],
+                "summary": "Delete Income Version by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Income",
+                        "name": "incomeId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Income Version",
+                        "name": "versionId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/loans": {
+            "get": {
+                "description": "Returns an array of Loan objects belonging to a given user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Get All User Loans",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search for loans by name",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Loan"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Inserts a new Loan into the Database for a given user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Insert Loan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The loan to insert",
+                        "name": "loan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Loan"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/loans-summary": {
+            "get": {
+                "description": "Gets a summary of all loans for a user\nBalances are the remaining balance of each loan after its recorded payments\nCount and monthly cost only include loans that are not paid off, and totalCount includes all loans",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Get User Loan Summary",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.LoansSummary"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/loans/{loanId}": {
+            "get": {
+                "description": "Returns a Loan object belonging to a given user\nThe loan's balance and payment history are derived from the payments recorded against it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Get Loan by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the ID of the Loan",
+                        "name": "loanId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Loan"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing Loan for a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Update Loan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the loan to update",
+                        "name": "loanId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The loan to update",
+                        "name": "loan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Loan"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a Loan object belonging to a given user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Delete Loan by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the ID of the Loan",
+                        "name": "loanId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Loan"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/loans/{loanId}/calculate": {
+            "post": {
+                "description": "Performs calculations on a loan and returns the loan with updated values.\nPrepayments on the loan are applied to the payment schedule and the interest and months saved by them are returned.\nLoans with a rate schedule are re-amortized over their remaining term each time the rate changes.\nDoes not Persist values",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Calculate Loan Values",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Loan ID. Will also accept 'new' for unsaved loan",
+                        "name": "loanId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The Loan to Calculate values for",
+                        "name": "loan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Loan"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Loan"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/loans/{loanId}/compare-payments": {
+            "post": {
+                "description": "Performs calculations on a loan and a Persisted loan with an Id, then returns a list comparing the two\nIf the new loan has no total, it is treated as the persisted loan with the new loan's prepayments applied\nDoes not Persist values",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Compare Loan Payments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The ID of the persisted loan to compare against",
+                        "name": "loanId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The new Loan to calculate and compare",
+                        "name": "loan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Loan"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PaymentScheduleComparisonItem"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/loans/{loanId}/payment-comparison": {
+            "get": {
+                "description": "Compares the scheduled amortization of a Loan against the payments recorded against it\nScheduled values are returned as the original values and actual values are returned as the new values",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loan Payments"
+                ],
+                "summary": "Compare Actual Loan Payments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Loan",
+                        "name": "loanId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PaymentScheduleComparisonItem"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/loans/{loanId}/payments": {
+            "get": {
+                "description": "Returns the payments, late fees and extra principal payments recorded against a Loan in the order they were made\nThe principal, interest and remaining balance of each payment are derived from the loan's payment history",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loan Payments"
+                ],
+                "summary": "Get All Loan Payments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Loan",
+                        "name": "loanId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.LoanPayment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Records a payment, late fee or extra principal payment against a Loan\nAvailable types are 'payment', 'late-fee' and 'extra-principal'",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loan Payments"
+                ],
+                "summary": "Insert Loan Payment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Loan",
+                        "name": "loanId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The loan payment to insert",
+                        "name": "payment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LoanPayment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/loans/{loanId}/payments/{paymentId}": {
+            "delete": {
+                "description": "Deletes a payment recorded against a Loan belonging to a given user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loan Payments"
+                ],
+                "summary": "Delete Loan Payment by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Loan",
+                        "name": "loanId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Loan Payment",
+                        "name": "paymentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/loans/{loanId}/refinance": {
+            "post": {
+                "description": "Compares the remaining balance of a persisted loan against refinancing it with a new rate and term\nReturns the break even month, the lifetime interest delta, the monthly payment change and a month by month comparison\nDoes not Persist values",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Refinance Loan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The ID of the persisted loan to refinance",
+                        "name": "loanId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The new rate, term, closing costs and cash out of the refinance",
+                        "name": "refinance",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LoanRefinance"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.LoanRefinance"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/roles": {
+            "get": {
+                "description": "Returns an array of UserRole objects belonging to a given user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Roles"
+                ],
+                "summary": "Get All User Roles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the user we are searching for",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search for user role by role name",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.UserRole"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/roles/{roleId}": {
+            "post": {
+                "description": "Adds a new role to a User",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Roles"
+                ],
+                "summary": "Add User Role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the user to add a role to",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the role to add to the user",
+                        "name": "roleId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a role from a a User",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Roles"
+                ],
+                "summary": "Remove User Role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the user to remove a role from",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the role to remove from the user",
+                        "name": "roleId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/savings-goals": {
+            "get": {
+                "description": "Returns an array of Savings Goal objects belonging to a given user in the order of their deadlines\nEach goal includes its contributions, the amount required from each pay to reach the target by the deadline, and whether it is on track",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Savings Goals"
+                ],
+                "summary": "Get All User Savings Goals",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search for savings goals by name",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SavingsGoal"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Inserts a new Savings Goal into the Database for a given user\ncontributionPerPay is set aside from each paycheck of the linked income. Goals without a linked income are contributed to on the first of each month",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Savings Goals"
+                ],
+                "summary": "Insert Savings Goal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The savings goal to insert",
+                        "name": "goal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SavingsGoal"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/savings-goals/{goalId}": {
+            "get": {
+                "description": "Returns a Savings Goal by its ID for a given user\nThe goal includes its contributions, the amount required from each pay to reach the target by the deadline, and whether it is on track",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Savings Goals"
+                ],
+                "summary": "Get Savings Goal by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Savings Goal",
+                        "name": "goalId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SavingsGoal"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing Savings Goal for a user. The goal's contributions are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Savings Goals"
+                ],
+                "summary": "Update Savings Goal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Savings Goal to update",
+                        "name": "goalId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The savings goal to update",
+                        "name": "goal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SavingsGoal"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a user's Savings Goal and its contributions by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Savings Goals"
+                ],
+                "summary": "Delete Savings Goal by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Savings Goal",
+                        "name": "goalId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/savings-goals/{goalId}/contributions": {
+            "get": {
+                "description": "Returns the contribution ledger of a Savings Goal in the order the contributions were made",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Savings Goals"
+                ],
+                "summary": "Get All Savings Goal Contributions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Savings Goal",
+                        "name": "goalId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SavingsGoalContribution"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Records an amount set aside toward a Savings Goal. Withdrawals from the goal are recorded as negative amounts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Savings Goals"
+                ],
+                "summary": "Insert Savings Goal Contribution",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Savings Goal",
+                        "name": "goalId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The contribution to insert",
+                        "name": "contribution",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SavingsGoalContribution"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/savings-goals/{goalId}/contributions/{contributionId}": {
+            "delete": {
+                "description": "Deletes a contribution from the ledger of a Savings Goal belonging to a given user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Savings Goals"
+                ],
+                "summary": "Delete Savings Goal Contribution by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Savings Goal",
+                        "name": "goalId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the Savings Goal Contribution",
+                        "name": "contributionId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/stock-operation": {
+            "post": {
+                "description": "Modifies a user's stock. This is an add or remove operation and can be used to post new stock\nEach operation is recorded as a buy or sell transaction at price per share with its fees. Each buy opens a lot, and each sell is taken from the open lots chosen by lotMethod, or from the lots it selects when lotMethod is specific-id\nAmounts are the number of shares on the date of the operation. Shares held across a later split of the stock are split on its execution date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocks"
+                ],
+                "summary": "Modify User Stock",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the user to modify stocks for",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The request to process",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/restmodels.ModifyStockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/stock-portfolio": {
+            "get": {
+                "description": "Gets a summary of all stock data for a user\nPositions with recorded buys report their open lots, cost basis and unrealized gain split into short-term and long-term, and the summary reports realized gains by tax year\nDividends paid to the user for the shares they held before each ex-dividend date are listed and totaled in dividendIncome",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Summary"
+                ],
+                "summary": "Get Stock Portfolio Summary",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserStockPortfolioSummary"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/stock-portfolio-history": {
+            "get": {
+                "description": "Gets History of a User's Stock Portfolio Balance\nBenchmark tickers are loaded if they are not already, normalized to the portfolio's starting value and compared to its return",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocks"
+                ],
+                "summary": "Get User Stock Portfolio History",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The ID of the user to get Portfolio History for",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The lenght of history to fetch. Available values are 'week', 'month', and 'year'. Default is 'week'",
+                        "name": "histLength",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "A comma-separated list of up to 5 benchmark tickers to compare the portfolio to, such as 'SPY,QQQ'",
+                        "name": "benchmarks",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StockPortfolioHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/stock-portfolio/performance": {
+            "get": {
+                "description": "Gets the returns of a user's stock portfolio and of each stock they have owned over each period up to today\ntimeWeightedReturn is the percentage return with the effect of buying and selling shares removed, and moneyWeightedReturn is the annualized percentage internal rate of return of the period's cash flows\nShares bought and sold are valued at the close of the day of the operation, and dividends paid are treated as received from the portfolio",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocks"
+                ],
+                "summary": "Get User Stock Portfolio Performance",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The ID of the user to get portfolio performance for",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The period to calculate returns over. Available values are '1M', '3M', 'YTD', '1Y' and 'inception'. Default is every period",
+                        "name": "period",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PortfolioPerformance"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/stock-transactions": {
+            "get": {
+                "description": "Gets the buy and sell transactions recorded by a user's stock operations in the order they were made. The ID of each buy is the ID of the lot it opened",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocks"
+                ],
+                "summary": "Get User Stock Transactions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The ID of the user to fetch stock transactions for",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only fetch the transactions of this ticker",
+                        "name": "ticker",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.StockTransaction"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/stocks": {
+            "get": {
+                "description": "Gets a list of stocks currently owned or watched by a given user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocks"
+                ],
+                "summary": "Get User Stocks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The ID of the user to fetch stocks for",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The Type of stocks to fetch. Available types are {'own','watchlist'}. Default is 'own'.",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search for User stocks by ticker",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Stock"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Inserts a new Stock into the Database for a given user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocks"
+                ],
+                "summary": "Insert Stock",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The stock to insert",
+                        "name": "stock",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UserStock"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/summary": {
+            "get": {
+                "description": "Gets a summary of all financial data for a user for the current month\nNet worth is the balance of the user's bank accounts at the end of the month less their loan and credit card balances\nActual spending is the total withdrawn from the user's bank accounts during the month, and categoryTotals break the month's transactions down by category to compare against the planned bills\nBudgets whose spending for the month is more than their limit and any amount rolled over into it are flagged in overspentBudgets\nWhen includeDividends is true the dividends paid to the user during the month are added to their income",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Summary"
+                ],
+                "summary": "Get Finance Summary",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Whether to include stock dividends in income",
+                        "name": "includeDividends",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Summary"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/summary/categories": {
+            "get": {
+                "description": "Totals a user's bank account transactions by category for each month between two dates. Transfers between accounts are not included",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Summary"
+                ],
+                "summary": "Get Category Totals",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "A date in the first month to total in YYYY-MM-DD format. Default is twelve months before to",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "A date in the last month to total in YYYY-MM-DD format. Default is today",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MonthlyCategoryTotals"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/summary/history": {
+            "get": {
+                "description": "Gets the month end snapshots of a user's summary taken between two dates, ordered by date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Summary"
+                ],
+                "summary": "Get Finance Summary History",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date of the history in YYYY-MM-DD format. Default is twelve months before to",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date of the history in YYYY-MM-DD format. Default is today",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SummarySnapshot"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/summary/projection": {
+            "get": {
+                "description": "Gets a summary of all financial data for a user for each of a number of months beginning with the current month\nLoan balances decline per their amortization and credit card balances per their minimum payments in each projected month\nWhen includeDividends is true the dividends paid to the user during each month are added to their income",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Summary"
+                ],
+                "summary": "Get Finance Summary Projection",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of months to project",
+                        "name": "months",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Whether to include stock dividends in income",
+                        "name": "includeDividends",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Summary"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jsonutils.JSONResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "authentication.TokenPairs": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "compounding.Compounding": {
+            "type": "string",
+            "enum": [
+                ""
+            ],
+            "x-enum-varnames": [
+                "Undefined"
+            ]
+        },
+        "jsonutils.JSONResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "error": {
+                    "type": "boolean"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BankAccount": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "openingBalance": {
+                    "type": "number"
+                },
+                "openingDt": {
+                    "type": "string"
+                },
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BankAccountTransaction"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.BankAccountTransaction": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "bankAccountId": {
+                    "type": "integer"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "externalId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "payee": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "sourceId": {
+                    "type": "integer"
+                },
+                "suggestion": {
+                    "$ref": "#/definitions/models.TransactionMatch"
+                },
+                "transactionDate": {
+                    "type": "string"
+                },
+                "transferAccountId": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.BenchmarkComparison": {
+            "type": "object",
+            "properties": {
+                "alpha": {
+                    "type": "number"
+                },
+                "beta": {
+                    "type": "number"
+                },
+                "endDt": {
+                    "type": "string"
+                },
+                "excessReturn": {
+                    "type": "number"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BenchmarkPoint"
+                    }
+                },
+                "portfolioReturn": {
+                    "type": "number"
+                },
+                "return": {
+                    "type": "number"
+                },
+                "startDt": {
+                    "type": "string"
+                },
+                "ticker": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BenchmarkPoint": {
+            "type": "object",
+            "properties": {
+                "close": {
+                    "type": "number"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Bill": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "integer"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "createDt": {
+                    "type": "string"
+                },
+                "dueDt": {
+                    "type": "string"
+                },
+                "endDt": {
+                    "type": "string"
+                },
+                "frequency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lastUpdateDt": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Budget": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "limit": {
+                    "type": "number"
+                },
+                "rollover": {
+                    "type": "boolean"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "sourceId": {
+                    "type": "integer"
+                },
+                "startDt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.BudgetStatus": {
+            "type": "object",
+            "properties": {
+                "actual": {
+                    "type": "number"
+                },
+                "available": {
+                    "type": "number"
+                },
+                "budgetId": {
+                    "type": "integer"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "limit": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "overspent": {
+                    "type": "boolean"
+                },
+                "planned": {
+                    "type": "number"
+                },
+                "remaining": {
+                    "type": "number"
+                },
+                "rolledOver": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Calendar": {
+            "type": "object",
+            "properties": {
+                "endingBalance": {
+                    "type": "number"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CalendarEvent"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "lowestBalance": {
+                    "type": "number"
+                },
+                "lowestBalanceDt": {
+                    "type": "string"
+                },
+                "startingBalance": {
+                    "type": "number"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "totalExpenses": {
+                    "type": "number"
+                },
+                "totalIncome": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.CalendarEvent": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "balance": {
+                    "type": "number"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CategorizationRule": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "integer"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "maxAmount": {
+                    "type": "number"
+                },
+                "minAmount": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payeePattern": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "sourceId": {
+                    "type": "integer"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CategoryTotal": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "net": {
+                    "type": "number"
+                },
+                "received": {
+                    "type": "number"
+                },
+                "spent": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.CreditCard": {
+            "type": "object",
+            "properties": {
+                "apr": {
+                    "type": "number"
+                },
+                "balance": {
+                    "type": "number"
+                },
+                "createDt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lastUpdateDt": {
+                    "type": "string"
+                },
+                "limit": {
+                    "type": "number"
+                },
+                "minPayment": {
+                    "type": "number"
+                },
+                "minPaymentPercentage": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payment": {
+                    "type": "number"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CreditCardPayoff": {
+            "type": "object",
+            "properties": {
+                "months": {
+                    "type": "integer"
+                },
+                "payment": {
+                    "type": "number"
+                },
+                "paymentSchedule": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PaymentScheduleItem"
+                    }
+                },
+                "totalInterest": {
+                    "type": "number"
+                },
+                "totalPayment": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.CreditCardProjection": {
+            "type": "object",
+            "properties": {
+                "apr": {
+                    "type": "number"
+                },
+                "balance": {
+                    "type": "number"
+                },
+                "fixedPayment": {
+                    "$ref": "#/definitions/models.CreditCardPayoff"
+                },
+                "minimumPayment": {
+                    "$ref": "#/definitions/models.CreditCardPayoff"
+                }
+            }
+        },
+        "models.CreditSummary": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "number"
+                },
+                "total": {
+                    "type": "number"
+                },
+                "utilization": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.DebtPlan": {
+            "type": "object",
+            "properties": {
+                "budget": {
+                    "type": "number"
+                },
+                "debts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DebtPlanDebt"
+                    }
+                },
+                "minPayment": {
+                    "type": "number"
+                },
+                "months": {
+                    "type": "integer"
+                },
+                "payoffDate": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DebtPlanScheduleItem"
+                    }
+                },
+                "strategy": {
+                    "type": "string"
+                },
+                "totalBalance": {
+                    "type": "number"
+                },
+                "totalInterest": {
+                    "type": "number"
+                },
+                "totalPayment": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.DebtPlanDebt": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "interestRate": {
+                    "type": "number"
+                },
+                "minPayment": {
+                    "type": "number"
+                },
+                "minPaymentPercentage": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payoffDate": {
+                    "type": "string"
+                },
+                "payoffMonth": {
+                    "type": "integer"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "totalInterest": {
+                    "type": "number"
+                },
+                "totalPayment": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.DebtPlanPayment": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "interest": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payment": {
+                    "type": "number"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "remainingBalance": {
+                    "type": "number"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DebtPlanScheduleItem": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "interest": {
+                    "type": "number"
+                },
+                "interestToDate": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "integer"
+                },
+                "payment": {
+                    "type": "number"
+                },
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DebtPlanPayment"
+                    }
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "remainingBalance": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.DividendPayment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "cashAmount": {
+                    "type": "number"
+                },
+                "exDividendDt": {
+                    "type": "string"
+                },
+                "payDt": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "ticker": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.EnableModuleRequest": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ExpenseSummary": {
+            "type": "object",
+            "properties": {
+                "accountBalance": {
+                    "type": "number"
+                },
+                "actualSpending": {
+                    "type": "number"
+                },
+                "bills": {
+                    "type": "number"
+                },
+                "categoryTotals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CategoryTotal"
+                    }
+                },
+                "creditCardBalance": {
+                    "type": "number"
+                },
+                "creditCards": {
+                    "type": "number"
+                },
+                "deductions": {
+                    "type": "number"
+                },
+                "expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SummaryItem"
+                    }
+                },
+                "loanBalance": {
+                    "type": "number"
+                },
+                "loanCost": {
+                    "type": "number"
+                },
+                "netWorth": {
+                    "type": "number"
+                },
+                "overallBalance": {
+                    "type": "number"
+                },
+                "retirementContributions": {
+                    "type": "number"
+                },
+                "savingsGoals": {
+                    "type": "number"
+                },
+                "taxes": {
+                    "type": "number"
+                },
+                "totalBalance": {
+                    "type": "number"
+                },
+                "totalCost": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Income": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "integer"
+                },
+                "createDt": {
+                    "type": "string"
+                },
+                "endDt": {
+                    "type": "string"
+                },
+                "federalTax": {
+                    "type": "number"
+                },
+                "filingStatus": {
+                    "type": "string"
+                },
+                "frequency": {
+                    "type": "string"
+                },
+                "garnishment": {
+                    "type": "number"
+                },
+                "grossPay": {
+                    "type": "number"
+                },
+                "healthPremium": {
+                    "type": "number"
+                },
+                "hours": {
+                    "type": "number"
+                },
+                "hsaContribution": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lastUpdateDt": {
+                    "type": "string"
+                },
+                "loggedHours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IncomeHours"
+                    }
+                },
+                "medicareTax": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "netPay": {
+                    "type": "number"
+                },
+                "nextDt": {
+                    "type": "string"
+                },
+                "overtimeHours": {
+                    "type": "number"
+                },
+                "overtimeMultiplier": {
+                    "type": "number"
+                },
+                "overtimePay": {
+                    "type": "number"
+                },
+                "paidHours": {
+                    "type": "number"
+                },
+                "paystub": {
+                    "$ref": "#/definitions/models.Paystub"
+                },
+                "postTaxDeductions": {
+                    "type": "number"
+                },
+                "preTaxDeductions": {
+                    "type": "number"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "regularHoursCap": {
+                    "type": "number"
+                },
+                "retirementContribution": {
+                    "type": "number"
+                },
+                "retirementPercentage": {
+                    "type": "number"
+                },
+                "rothPercentage": {
+                    "type": "number"
+                },
+                "socialSecurityTax": {
+                    "type": "number"
+                },
+                "startDt": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "stateTax": {
+                    "type": "number"
+                },
+                "taxPercentage": {
+                    "type": "number"
+                },
+                "taxableGross": {
+                    "type": "number"
+                },
+                "taxes": {
+                    "type": "number"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                },
+                "versions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IncomeVersion"
+                    }
+                }
+            }
+        },
+        "models.IncomeHours": {
+            "type": "object",
+            "properties": {
+                "hours": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "incomeId": {
+                    "type": "integer"
+                },
+                "payDt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.IncomeSummary": {
+            "type": "object",
+            "properties": {
+                "dividendIncome": {
+                    "type": "number"
+                },
+                "incomes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SummaryItem"
+                    }
+                },
+                "totalIncome": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.IncomeVersion": {
+            "type": "object",
+            "properties": {
+                "effectiveDt": {
+                    "type": "string"
+                },
+                "expirationDt": {
+                    "type": "string"
+                },
+                "hours": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "incomeId": {
+                    "type": "integer"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Loan": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "number"
+                },
+                "feesPaid": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "interest": {
+                    "type": "number"
+                },
+                "interestPaid": {
+                    "type": "number"
+                },
+                "interestRate": {
+                    "type": "number"
+                },
+                "interestSaved": {
+                    "type": "number"
+                },
+                "lastPaymentDt": {
+                    "type": "string"
+                },
+                "loanTerm": {
+                    "type": "integer"
+                },
+                "monthlyPayment": {
+                    "type": "number"
+                },
+                "monthsSaved": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "paymentHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PaymentScheduleItem"
+                    }
+                },
+                "paymentSchedule": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PaymentScheduleItem"
+                    }
+                },
+                "paymentsMade": {
+                    "type": "integer"
+                },
+                "prepayments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.LoanPrepayment"
+                    }
+                },
+                "principalPaid": {
+                    "type": "number"
+                },
+                "rateSchedule": {
+                    "$ref": "#/definitions/models.LoanRateSchedule"
+                },
+                "total": {
+                    "type": "number"
+                },
+                "totalCost": {
+                    "type": "number"
+                },
+                "totalPayment": {
+                    "type": "number"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.LoanPayment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "interest": {
+                    "type": "number"
+                },
+                "loanId": {
+                    "type": "integer"
+                },
+                "paymentDate": {
+                    "type": "string"
+                },
+                "principal": {
+                    "type": "number"
                 },
-                "createDt": {
+                "remainingBalance": {
+                    "type": "number"
+                },
+                "type": {
                     "type": "string"
                 },
-                "id": {
+                "userId": {
                     "type": "integer"
+                }
+            }
+        },
+        "models.LoanPrepayment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
                 },
-                "lastUpdateDt": {
+                "endMonth": {
+                    "type": "integer"
+                },
+                "startMonth": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.LoanRateSchedule": {
+            "type": "object",
+            "properties": {
+                "adjustedRate": {
+                    "type": "number"
+                },
+                "adjustmentInterval": {
+                    "type": "integer"
+                },
+                "initialCap": {
+                    "type": "number"
+                },
+                "initialFixedMonths": {
+                    "type": "integer"
+                },
+                "lifetimeCap": {
+                    "type": "number"
+                },
+                "periodicCap": {
+                    "type": "number"
+                },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.LoanRateStep"
+                    }
+                }
+            }
+        },
+        "models.LoanRateStep": {
+            "type": "object",
+            "properties": {
+                "interestRate": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.LoanRefinance": {
+            "type": "object",
+            "properties": {
+                "breakEvenMonth": {
+                    "type": "integer"
+                },
+                "breaksEven": {
+                    "type": "boolean"
+                },
+                "cashOut": {
+                    "type": "number"
+                },
+                "closingCosts": {
+                    "type": "number"
+                },
+                "currentBalance": {
+                    "type": "number"
+                },
+                "currentInterest": {
+                    "type": "number"
+                },
+                "currentPayment": {
+                    "type": "number"
+                },
+                "financeClosingCosts": {
+                    "type": "boolean"
+                },
+                "interestDelta": {
+                    "type": "number"
+                },
+                "interestRate": {
+                    "type": "number"
+                },
+                "loanTerm": {
+                    "type": "integer"
+                },
+                "monthlyPaymentDelta": {
+                    "type": "number"
+                },
+                "newInterest": {
+                    "type": "number"
+                },
+                "newPayment": {
+                    "type": "number"
+                },
+                "paymentComparison": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PaymentScheduleComparisonItem"
+                    }
+                },
+                "totalCostDelta": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.LoansSummary": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "monthlyCost": {
+                    "type": "number"
+                },
+                "totalBalance": {
+                    "type": "number"
+                },
+                "totalCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ModuleEnabledResponse": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.MonthlyCategoryTotals": {
+            "type": "object",
+            "properties": {
+                "month": {
                     "type": "string"
                 },
+                "totals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CategoryTotal"
+                    }
+                }
+            }
+        },
+        "models.PaymentScheduleComparisonItem": {
+            "type": "object",
+            "properties": {
+                "extraPrincipal": {
+                    "type": "number"
+                },
+                "extraPrincipalDelta": {
+                    "type": "number"
+                },
+                "extraPrincipalNew": {
+                    "type": "number"
+                },
+                "interest": {
+                    "type": "number"
+                },
+                "interestDelta": {
+                    "type": "number"
+                },
+                "interestNew": {
+                    "type": "number"
+                },
+                "interestToDate": {
+                    "type": "number"
+                },
+                "interestToDateDelta": {
+                    "type": "number"
+                },
+                "interestToDateNew": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "integer"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "principalDelta": {
+                    "type": "number"
+                },
+                "principalNew": {
+                    "type": "number"
+                },
+                "principalToDate": {
+                    "type": "number"
+                },
+                "principalToDateDelta": {
+                    "type": "number"
+                },
+                "principalToDateNew": {
+                    "type": "number"
+                },
+                "remainingBalance": {
+                    "type": "number"
+                },
+                "remainingBalanceDelta": {
+                    "type": "number"
+                },
+                "remainingBalanceNew": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PaymentScheduleItem": {
+            "type": "object",
+            "properties": {
+                "extraPrincipal": {
+                    "type": "number"
+                },
+                "fees": {
+                    "type": "number"
+                },
+                "interest": {
+                    "type": "number"
+                },
+                "interestRate": {
+                    "type": "number"
+                },
+                "interestToDate": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "integer"
+                },
+                "payment": {
+                    "type": "number"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "principalToDate": {
+                    "type": "number"
+                },
+                "remainingBalance": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Paystub": {
+            "type": "object",
+            "properties": {
+                "grossPay": {
+                    "type": "number"
+                },
+                "hours": {
+                    "type": "number"
+                },
+                "netPay": {
+                    "type": "number"
+                },
+                "overtimeHours": {
+                    "type": "number"
+                },
+                "overtimePay": {
+                    "type": "number"
+                },
+                "postTaxDeductions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PaystubItem"
+                    }
+                },
+                "preTaxDeductions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PaystubItem"
+                    }
+                },
+                "taxableGross": {
+                    "type": "number"
+                },
+                "taxes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PaystubItem"
+                    }
+                },
+                "totalDeductions": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PaystubItem": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
                 "name": {
                     "type": "string"
-                },
-                "userId": {
-                    "type": "integer"
                 }
             }
         },
-        "models.CreditCard": {
+        "models.PeriodReturn": {
             "type": "object",
             "properties": {
-                "apr": {
-                    "type": "number"
-                },
-                "balance": {
+                "dividends": {
                     "type": "number"
                 },
-                "createDt": {
+                "endDt": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
-                },
-                "lastUpdateDt": {
-                    "type": "string"
+                "endValue": {
+                    "type": "number"
                 },
-                "limit": {
+                "gain": {
                     "type": "number"
                 },
-                "minPayment": {
+                "moneyWeightedReturn": {
                     "type": "number"
                 },
-                "minPaymentPercentage": {
+                "netFlows": {
                     "type": "number"
                 },
-                "name": {
+                "period": {
                     "type": "string"
                 },
-                "payment": {
+                "startDt": {
+                    "type": "string"
+                },
+                "startValue": {
                     "type": "number"
                 },
-                "userId": {
-                    "type": "integer"
+                "timeWeightedReturn": {
+                    "type": "number"
                 }
             }
         },
-        "models.CreditSummary": {
+        "models.PortfolioBalanceHistory": {
             "type": "object",
             "properties": {
-                "available": {
+                "close": {
                     "type": "number"
                 },
-                "total": {
+                "date": {
+                    "type": "string"
+                },
+                "high": {
                     "type": "number"
                 },
-                "utilization": {
+                "low": {
+                    "type": "number"
+                },
+                "open": {
                     "type": "number"
                 }
             }
         },
-        "models.EnableModuleRequest": {
+        "models.PortfolioPerformance": {
             "type": "object",
             "properties": {
-                "key": {
+                "asOf": {
                     "type": "string"
+                },
+                "positions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PositionPerformance"
+                    }
+                },
+                "returns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PeriodReturn"
+                    }
                 }
             }
         },
-        "models.ExpenseSummary": {
+        "models.PortfolioPosition": {
             "type": "object",
             "properties": {
-                "bills": {
+                "asOf": {
+                    "type": "string"
+                },
+                "close": {
                     "type": "number"
                 },
-                "creditCardBalance": {
+                "costBasis": {
                     "type": "number"
                 },
-                "creditCards": {
+                "dividendIncome": {
                     "type": "number"
                 },
-                "expenses": {
+                "high": {
+                    "type": "number"
+                },
+                "longTermUnrealizedGain": {
+                    "type": "number"
+                },
+                "lots": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.SummaryItem"
+                        "$ref": "#/definitions/models.StockLot"
                     }
                 },
-                "loanBalance": {
+                "low": {
                     "type": "number"
                 },
-                "loanCost": {
+                "open": {
                     "type": "number"
                 },
-                "overallBalance": {
+                "quantity": {
                     "type": "number"
                 },
-                "taxes": {
+                "realizedGains": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TaxYearGains"
+                    }
+                },
+                "shortTermUnrealizedGain": {
                     "type": "number"
                 },
-                "totalBalance": {
+                "ticker": {
+                    "type": "string"
+                },
+                "unrealizedGain": {
                     "type": "number"
                 },
-                "totalCost": {
+                "value": {
                     "type": "number"
                 }
             }
         },
-        "models.Income": {
+        "models.PositionHistory": {
             "type": "object",
             "properties": {
-                "createDt": {
-                    "type": "string"
+                "close": {
+                    "type": "number"
                 },
-                "frequency": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 },
-                "grossPay": {
+                "delta": {
                     "type": "number"
                 },
-                "hours": {
+                "deltaPercentage": {
                     "type": "number"
                 },
-                "id": {
-                    "type": "integer"
-                },
-                "lastUpdateDt": {
-                    "type": "string"
-                },
-                "name": {
+                "endDt": {
                     "type": "string"
                 },
-                "netPay": {
+                "high": {
                     "type": "number"
                 },
-                "nextDt": {
-                    "type": "string"
+                "low": {
+                    "type": "number"
                 },
-                "rate": {
+                "open": {
                     "type": "number"
                 },
                 "startDt": {
                     "type": "string"
                 },
-                "taxPercentage": {
-                    "type": "number"
-                },
-                "taxes": {
-                    "type": "number"
-                },
-                "type": {
+                "ticker": {
                     "type": "string"
                 },
-                "userId": {
-                    "type": "integer"
-                }
-            }
-        },
-        "models.IncomeSummary": {
-            "type": "object",
-            "properties": {
-                "incomes": {
+                "values": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.SummaryItem"
+                        "$ref": "#/definitions/models.Stock"
                     }
-                },
-                "totalIncome": {
-                    "type": "number"
                 }
             }
         },
-        "models.Loan": {
+        "models.PositionPerformance": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
-                },
-                "interest": {
-                    "type": "number"
-                },
-                "interestRate": {
-                    "type": "number"
-                },
-                "loanTerm": {
-                    "type": "integer"
-                },
-                "monthlyPayment": {
-                    "type": "number"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "paymentSchedule": {
+                "returns": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.PaymentScheduleItem"
+                        "$ref": "#/definitions/models.PeriodReturn"
                     }
                 },
-                "total": {
-                    "type": "number"
-                },
-                "totalCost": {
-                    "type": "number"
-                },
-                "totalPayment": {
-                    "type": "number"
-                },
-                "userId": {
-                    "type": "integer"
+                "ticker": {
+                    "type": "string"
                 }
             }
         },
-        "models.LoansSummary": {
+        "models.Role": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
-                },
-                "monthlyCost": {
-                    "type": "number"
+                "code": {
+                    "type": "string"
                 },
-                "totalBalance": {
-                    "type": "number"
-                }
-            }
-        },
-        "models.ModuleEnabledResponse": {
-            "type": "object",
-            "properties": {
-                "enabled": {
-                    "type": "boolean"
+                "id": {
+                    "type": "integer"
                 }
             }
         },
-        "models.PaymentScheduleComparisonItem": {
+        "models.SavingsGoal": {
             "type": "object",
             "properties": {
-                "interest": {
+                "contributionPerPay": {
                     "type": "number"
                 },
-                "interestDelta": {
-                    "type": "number"
+                "contributions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SavingsGoalContribution"
+                    }
                 },
-                "interestNew": {
-                    "type": "number"
+                "deadline": {
+                    "type": "string"
                 },
-                "interestToDate": {
-                    "type": "number"
+                "id": {
+                    "type": "integer"
                 },
-                "interestToDateDelta": {
-                    "type": "number"
+                "incomeId": {
+                    "type": "integer"
                 },
-                "interestToDateNew": {
-                    "type": "number"
+                "name": {
+                    "type": "string"
                 },
-                "month": {
+                "numPays": {
                     "type": "integer"
                 },
-                "principal": {
-                    "type": "number"
-                },
-                "principalDelta": {
-                    "type": "number"
+                "onTrack": {
+                    "type": "boolean"
                 },
-                "principalNew": {
+                "perPay": {
                     "type": "number"
                 },
-                "principalToDate": {
+                "percentComplete": {
                     "type": "number"
                 },
-                "principalToDateDelta": {
+                "projectedSavings": {
                     "type": "number"
                 },
-                "principalToDateNew": {
+                "remaining": {
                     "type": "number"
                 },
-                "remainingBalance": {
+                "saved": {
                     "type": "number"
                 },
-                "remainingBalanceDelta": {
+                "target": {
                     "type": "number"
                 },
-                "remainingBalanceNew": {
-                    "type": "number"
+                "userId": {
+                    "type": "integer"
                 }
             }
         },
-        "models.PaymentScheduleItem": {
+        "models.SavingsGoalContribution": {
             "type": "object",
             "properties": {
-                "interest": {
+                "amount": {
                     "type": "number"
                 },
-                "interestToDate": {
-                    "type": "number"
+                "contributionDate": {
+                    "type": "string"
                 },
-                "month": {
+                "id": {
+                    "type": "integer"
+                },
+                "savingsGoalId": {
+                    "type": "integer"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.StatementImportResponse": {
+            "type": "object",
+            "properties": {
+                "duplicates": {
                     "type": "integer"
                 },
-                "principal": {
-                    "type": "number"
-                },
-                "principalToDate": {
-                    "type": "number"
+                "imported": {
+                    "type": "integer"
                 },
-                "remainingBalance": {
-                    "type": "number"
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BankAccountTransaction"
+                    }
                 }
             }
         },
-        "models.PortfolioBalanceHistory": {
+        "models.Stock": {
             "type": "object",
             "properties": {
                 "close": {
                     "type": "number"
                 },
+                "createDt": {
+                    "type": "string"
+                },
                 "date": {
                     "type": "string"
                 },
                 "high": {
                     "type": "number"
                 },
+                "id": {
+                    "type": "integer"
+                },
+                "lastUpdateDt": {
+                    "type": "string"
+                },
                 "low": {
                     "type": "number"
                 },
                 "open": {
                     "type": "number"
+                },
+                "ticker": {
+                    "type": "string"
                 }
             }
         },
-        "models.PortfolioPosition": {
+        "models.StockLot": {
             "type": "object",
             "properties": {
-                "asOf": {
+                "acquiredDt": {
                     "type": "string"
                 },
-                "close": {
+                "costBasis": {
                     "type": "number"
                 },
-                "high": {
+                "costPerShare": {
                     "type": "number"
                 },
-                "low": {
-                    "type": "number"
+                "id": {
+                    "type": "integer"
                 },
-                "open": {
+                "quantity": {
                     "type": "number"
                 },
-                "quantity": {
+                "remaining": {
                     "type": "number"
                 },
+                "term": {
+                    "type": "string"
+                },
                 "ticker": {
                     "type": "string"
                 },
+                "unrealizedGain": {
+                    "type": "number"
+                },
                 "value": {
                     "type": "number"
                 }
             }
         },
-        "models.PositionHistory": {
+        "models.StockPortfolioHistoryResponse": {
             "type": "object",
             "properties": {
+                "benchmarks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BenchmarkComparison"
+                    }
+                },
                 "close": {
                     "type": "number"
                 },
@@ -2753,141 +7128,182 @@ const docTemplate = `{
                 "deltaPercentage": {
                     "type": "number"
                 },
-                "endDt": {
-                    "type": "string"
-                },
                 "high": {
                     "type": "number"
                 },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PortfolioBalanceHistory"
+                    }
+                },
                 "low": {
                     "type": "number"
                 },
                 "open": {
                     "type": "number"
+                }
+            }
+        },
+        "models.StockTransaction": {
+            "type": "object",
+            "properties": {
+                "fees": {
+                    "type": "number"
                 },
-                "startDt": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 },
-                "ticker": {
+                "lotMethod": {
                     "type": "string"
                 },
-                "values": {
+                "lots": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Stock"
+                        "$ref": "#/definitions/restmodels.StockLotSelection"
                     }
-                }
-            }
-        },
-        "models.Role": {
-            "type": "object",
-            "properties": {
-                "code": {
+                },
+                "price": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "ticker": {
                     "type": "string"
                 },
-                "id": {
+                "transactionDt": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "userId": {
                     "type": "integer"
                 }
             }
         },
-        "models.Stock": {
+        "models.Summary": {
             "type": "object",
             "properties": {
-                "close": {
-                    "type": "number"
-                },
-                "createDt": {
-                    "type": "string"
+                "creditSummary": {
+                    "$ref": "#/definitions/models.CreditSummary"
                 },
                 "date": {
                     "type": "string"
                 },
-                "high": {
-                    "type": "number"
+                "expenseSummary": {
+                    "$ref": "#/definitions/models.ExpenseSummary"
                 },
-                "id": {
+                "incomeSummary": {
+                    "$ref": "#/definitions/models.IncomeSummary"
+                },
+                "month": {
                     "type": "integer"
                 },
-                "lastUpdateDt": {
-                    "type": "string"
+                "netFunds": {
+                    "type": "number"
                 },
-                "low": {
+                "overspentBudgets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BudgetStatus"
+                    }
+                }
+            }
+        },
+        "models.SummaryItem": {
+            "type": "object",
+            "properties": {
+                "amount": {
                     "type": "number"
                 },
-                "open": {
+                "balance": {
                     "type": "number"
                 },
-                "ticker": {
+                "name": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "models.StockPortfolioHistoryResponse": {
+        "models.SummarySnapshot": {
             "type": "object",
             "properties": {
-                "close": {
+                "accountBalance": {
                     "type": "number"
                 },
-                "count": {
+                "createDt": {
+                    "type": "string"
+                },
+                "creditCardBalance": {
+                    "type": "number"
+                },
+                "creditUtilization": {
+                    "type": "number"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "delta": {
+                "loanBalance": {
                     "type": "number"
                 },
-                "deltaPercentage": {
+                "netFunds": {
                     "type": "number"
                 },
-                "high": {
+                "netWorth": {
                     "type": "number"
                 },
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.PortfolioBalanceHistory"
-                    }
+                "snapshotDt": {
+                    "type": "string"
                 },
-                "low": {
+                "totalBalance": {
                     "type": "number"
                 },
-                "open": {
+                "totalCost": {
+                    "type": "number"
+                },
+                "totalIncome": {
                     "type": "number"
+                },
+                "userId": {
+                    "type": "integer"
                 }
             }
         },
-        "models.Summary": {
+        "models.TaxYearGains": {
             "type": "object",
             "properties": {
-                "creditSummary": {
-                    "$ref": "#/definitions/models.CreditSummary"
-                },
-                "expenseSummary": {
-                    "$ref": "#/definitions/models.ExpenseSummary"
+                "longTerm": {
+                    "type": "number"
                 },
-                "incomeSummary": {
-                    "$ref": "#/definitions/models.IncomeSummary"
+                "shortTerm": {
+                    "type": "number"
                 },
-                "netFunds": {
+                "total": {
                     "type": "number"
+                },
+                "year": {
+                    "type": "integer"
                 }
             }
         },
-        "models.SummaryItem": {
+        "models.TransactionMatch": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "number"
-                },
-                "balance": {
-                    "type": "number"
-                },
                 "name": {
                     "type": "string"
                 },
                 "source": {
                     "type": "string"
                 },
-                "type": {
-                    "type": "string"
+                "sourceId": {
+                    "type": "integer"
                 }
             }
         },
@@ -2937,6 +7353,9 @@ const docTemplate = `{
                 "asOf": {
                     "type": "string"
                 },
+                "costBasis": {
+                    "type": "number"
+                },
                 "currentClose": {
                     "type": "number"
                 },
@@ -2952,11 +7371,35 @@ const docTemplate = `{
                 "currentValue": {
                     "type": "number"
                 },
+                "dividendIncome": {
+                    "type": "number"
+                },
+                "dividends": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DividendPayment"
+                    }
+                },
+                "longTermUnrealizedGain": {
+                    "type": "number"
+                },
                 "positions": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/models.PortfolioPosition"
                     }
+                },
+                "realizedGains": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TaxYearGains"
+                    }
+                },
+                "shortTermUnrealizedGain": {
+                    "type": "number"
+                },
+                "unrealizedGain": {
+                    "type": "number"
                 }
             }
         },
@@ -2994,6 +7437,21 @@ const docTemplate = `{
                     "description": "Date of operation",
                     "type": "string"
                 },
+                "fees": {
+                    "description": "Commissions and fees paid for the whole operation",
+                    "type": "number"
+                },
+                "lotMethod": {
+                    "description": "How the lots a remove operation is taken from are chosen. Options are 'fifo', 'lifo' and 'specific-id'. Default is 'fifo'",
+                    "type": "string"
+                },
+                "lots": {
+                    "description": "The lots a specific-id remove operation is taken from",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/restmodels.StockLotSelection"
+                    }
+                },
                 "operation": {
                     "description": "The operation. Options are 'buy' and 'sell'",
                     "allOf": [
@@ -3002,6 +7460,10 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "price": {
+                    "description": "Price paid or received per share. Must be greater than 0",
+                    "type": "number"
+                },
                 "ticker": {
                     "description": "The ticker to modify",
                     "type": "string"
@@ -3014,6 +7476,12 @@ const docTemplate = `{
                 "amount": {
                     "type": "number"
                 },
+                "apy": {
+                    "type": "number"
+                },
+                "compounding": {
+                    "$ref": "#/definitions/compounding.Compounding"
+                },
                 "deadline": {
                     "type": "string"
                 },
@@ -3025,6 +7493,52 @@ const docTemplate = `{
                 },
                 "payFrequency": {
                     "$ref": "#/definitions/payfrequency.PayFrequency"
+                },
+                "startingBalance": {
+                    "type": "number"
+                }
+            }
+        },
+        "restmodels.SavingsCalculationResponse": {
+            "type": "object",
+            "properties": {
+                "actual": {
+                    "type": "number"
+                },
+                "deadline": {
+                    "type": "string"
+                },
+                "goal": {
+                    "type": "number"
+                },
+                "goalInterest": {
+                    "type": "number"
+                },
+                "interest": {
+                    "type": "number"
+                },
+                "manPerPay": {
+                    "type": "number"
+                },
+                "numPays": {
+                    "type": "integer"
+                },
+                "perPay": {
+                    "type": "number"
+                },
+                "startingBalance": {
+                    "type": "number"
+                }
+            }
+        },
+        "restmodels.StockLotSelection": {
+            "type": "object",
+            "properties": {
+                "lotId": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "number"
                 }
             }
         },
@@ -23,4 +23,5 @@ type Application struct {
 	Handler         handlers.Handler
 	JSONUtil        jsonutils.JSONUtils
 	ExternalService service.ExternalService
+	Service         service.Service
 }
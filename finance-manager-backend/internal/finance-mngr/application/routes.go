@@ -59,6 +59,11 @@ func (app *Application) Routes() http.Handler {
 			r.Delete("/", app.Handler.DeleteUserById)
 			r.Get("/", app.Handler.GetUserByID)
 			r.Get("/summary", app.Handler.GetUserSummary)
+			r.Get("/summary/projection", app.Handler.GetUserSummaryProjection)
+			r.Get("/summary/history", app.Handler.GetUserSummaryHistory)
+			r.Get("/summary/categories", app.Handler.GetUserCategoryTotals)
+			r.Get("/debt-plan", app.Handler.GetDebtPlan)
+			r.Get("/calendar", app.Handler.GetUserCalendar)
 
 			//User Role Routes
 			r.Route("/roles", func(r chi.Router) {
@@ -79,6 +84,14 @@ func (app *Application) Routes() http.Handler {
 					r.Delete("/", app.Handler.DeleteLoanById)
 					r.Post("/calculate", app.Handler.CalculateLoan)
 					r.Post("/compare-payments", app.Handler.CompareLoanPayments)
+					r.Post("/refinance", app.Handler.RefinanceLoan)
+					r.Get("/payment-comparison", app.Handler.CompareLoanActualPayments)
+
+					r.Route("/payments", func(r chi.Router) {
+						r.Get("/", app.Handler.GetAllLoanPayments)
+						r.Post("/", app.Handler.SaveLoanPayment)
+						r.Delete("/{paymentId}", app.Handler.DeleteLoanPaymentById)
+					})
 				})
 
 			})
@@ -92,6 +105,18 @@ func (app *Application) Routes() http.Handler {
 					r.Get("/", app.Handler.GetIncomeById)
 					r.Put("/", app.Handler.UpdateIncome)
 					r.Delete("/", app.Handler.DeleteIncomeById)
+
+					r.Route("/versions", func(r chi.Router) {
+						r.Get("/", app.Handler.GetAllIncomeVersions)
+						r.Post("/", app.Handler.SaveIncomeVersion)
+						r.Delete("/{versionId}", app.Handler.DeleteIncomeVersionById)
+					})
+
+					r.Route("/hours", func(r chi.Router) {
+						r.Get("/", app.Handler.GetAllIncomeHours)
+						r.Post("/", app.Handler.SaveIncomeHours)
+						r.Delete("/{hoursId}", app.Handler.DeleteIncomeHoursById)
+					})
 				})
 
 			})
@@ -117,6 +142,70 @@ func (app *Application) Routes() http.Handler {
 					r.Get("/", app.Handler.GetCreditCardById)
 					r.Delete("/", app.Handler.DeleteCreditCardById)
 					r.Put("/", app.Handler.UpdateCreditCard)
+					r.Get("/projection", app.Handler.GetCreditCardProjection)
+				})
+			})
+
+			//Savings Goal Routes
+			r.Route("/savings-goals", func(r chi.Router) {
+				r.Get("/", app.Handler.GetAllUserSavingsGoals)
+				r.Post("/", app.Handler.SaveSavingsGoal)
+
+				r.Route("/{goalId}", func(r chi.Router) {
+					r.Get("/", app.Handler.GetSavingsGoalById)
+					r.Put("/", app.Handler.UpdateSavingsGoal)
+					r.Delete("/", app.Handler.DeleteSavingsGoalById)
+
+					r.Route("/contributions", func(r chi.Router) {
+						r.Get("/", app.Handler.GetAllSavingsGoalContributions)
+						r.Post("/", app.Handler.SaveSavingsGoalContribution)
+						r.Delete("/{contributionId}", app.Handler.DeleteSavingsGoalContributionById)
+					})
+				})
+			})
+
+			//Bank Account Routes
+			r.Route("/bank-accounts", func(r chi.Router) {
+				r.Get("/", app.Handler.GetAllUserBankAccounts)
+				r.Post("/", app.Handler.SaveBankAccount)
+
+				r.Route("/{accountId}", func(r chi.Router) {
+					r.Get("/", app.Handler.GetBankAccountById)
+					r.Put("/", app.Handler.UpdateBankAccount)
+					r.Delete("/", app.Handler.DeleteBankAccountById)
+					r.Post("/import", app.Handler.ImportBankStatement)
+
+					r.Route("/transactions", func(r chi.Router) {
+						r.Get("/", app.Handler.GetAllBankAccountTransactions)
+						r.Post("/", app.Handler.SaveBankAccountTransaction)
+						r.Delete("/{transactionId}", app.Handler.DeleteBankAccountTransactionById)
+					})
+				})
+			})
+
+			//Budget Routes
+			r.Route("/budgets", func(r chi.Router) {
+				r.Get("/", app.Handler.GetAllUserBudgets)
+				r.Post("/", app.Handler.SaveBudget)
+				r.Get("/status", app.Handler.GetUserBudgetStatuses)
+
+				r.Route("/{budgetId}", func(r chi.Router) {
+					r.Get("/", app.Handler.GetBudgetById)
+					r.Put("/", app.Handler.UpdateBudget)
+					r.Delete("/", app.Handler.DeleteBudgetById)
+				})
+			})
+
+			//Categorization Rule Routes
+			r.Route("/categorization-rules", func(r chi.Router) {
+				r.Get("/", app.Handler.GetAllUserCategorizationRules)
+				r.Post("/", app.Handler.SaveCategorizationRule)
+				r.Post("/apply", app.Handler.ApplyCategorizationRules)
+
+				r.Route("/{ruleId}", func(r chi.Router) {
+					r.Get("/", app.Handler.GetCategorizationRuleById)
+					r.Put("/", app.Handler.UpdateCategorizationRule)
+					r.Delete("/", app.Handler.DeleteCategorizationRuleById)
 				})
 			})
 
@@ -127,7 +216,9 @@ func (app *Application) Routes() http.Handler {
 			})
 
 			r.Post("/stock-operation", app.Handler.ModifyStockOperation)
+			r.Get("/stock-transactions", app.Handler.GetUserStockTransactions)
 			r.Get("/stock-portfolio", app.Handler.GetUserStockPortfolioSummary)
+			r.Get("/stock-portfolio/performance", app.Handler.GetUserStockPortfolioPerformance)
 			r.Get("/stock-portfolio-history", app.Handler.GetUserStockPortfolioHistory)
 		})
 
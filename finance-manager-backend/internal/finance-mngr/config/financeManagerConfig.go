@@ -12,6 +12,7 @@ type FinanceManagerConfig struct {
 	FrontendUrl  Env_value
 	TimeZone     Env_value
 	PolygonApi   Env_value
+	TaxTableFile Env_value
 }
 
 //Function GetDefaultConfig returns a FinanceManagerConfig object containing the default values for each environment variable
@@ -53,6 +54,10 @@ func GetDefaultConfig() FinanceManagerConfig {
 			envName: "PolygonApi",
 			defaultVal: "https://api.polygon.io/v2",
 		},
+		TaxTableFile: Env_value{
+			envName:    "TaxTableFile",
+			defaultVal: "properties/tax-tables.yml",
+		},
 	}
 
 	return config
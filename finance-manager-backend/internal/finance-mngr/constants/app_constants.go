@@ -1,4 +1,7 @@
 package constants
 
 const AppVersion = "2.0.0"
-const PropertyFileName = "\\properties\\properties.yml"
\ No newline at end of file
+const PropertyFileName = "\\properties\\properties.yml"
+
+// Format of date query params accepted by the api
+const DateParamFormat = "2006-01-02"
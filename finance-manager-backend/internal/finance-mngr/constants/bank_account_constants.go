@@ -0,0 +1,37 @@
+package constants
+
+const BankAccountTypeChecking = "checking"
+const BankAccountTypeSavings = "savings"
+const BankAccountTypeCash = "cash"
+
+var ValidBankAccountTypes = []string{BankAccountTypeChecking, BankAccountTypeSavings, BankAccountTypeCash}
+
+// Types of the transactions recorded in a bank account's ledger. Transfers are recorded as a transfer-out of one account and a transfer-in to the other
+const TransactionTypeDeposit = "deposit"
+const TransactionTypeWithdrawal = "withdrawal"
+const TransactionTypeTransferIn = "transfer-in"
+const TransactionTypeTransferOut = "transfer-out"
+
+var ValidTransactionTypes = []string{TransactionTypeDeposit, TransactionTypeWithdrawal, TransactionTypeTransferIn, TransactionTypeTransferOut}
+
+// Sources of the transactions posted to an account for the paydays of an income or the due dates of a bill.
+// Imported transactions may also be matched to a credit card payment
+const TransactionSourceIncome = "income"
+const TransactionSourceBill = "bill"
+const TransactionSourceCreditCard = "credit-card"
+
+// Formats of the bank statements that can be imported to an account. QFX files are OFX files with Quicken specific additions
+const StatementFormatOFX = "ofx"
+const StatementFormatQFX = "qfx"
+const StatementFormatCSV = "csv"
+
+var ValidStatementFormats = []string{StatementFormatOFX, StatementFormatQFX, StatementFormatCSV}
+
+// Largest statement file that can be imported
+const StatementImportMaxBytes = 1024 * 1024 * 5
+
+// Date format of CSV statements that do not name one
+const StatementCsvDefaultDateFormat = "01/02/2006"
+
+// Number of days an imported transaction can be from a payday or due date and still be matched to it
+const TransactionMatchWindowDays = 3
@@ -0,0 +1,9 @@
+package constants
+
+const BillFreqWeekly = RecurrenceFreqWeekly
+const BillFreqBiWeekly = RecurrenceFreqBiWeekly
+const BillFreqMonthly = RecurrenceFreqMonthly
+const BillFreqQuarterly = RecurrenceFreqQuarterly
+const BillFreqAnnual = RecurrenceFreqAnnual
+
+var ValidBillFreq = []string{BillFreqWeekly, BillFreqBiWeekly, BillFreqMonthly, BillFreqQuarterly, BillFreqAnnual}
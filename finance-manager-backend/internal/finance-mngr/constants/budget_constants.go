@@ -0,0 +1,7 @@
+package constants
+
+const BudgetSourceBill = "bill"
+const BudgetSourceLoan = "loan"
+
+// Sources a budget's planned cost may be derived from
+var ValidBudgetSources = []string{BudgetSourceBill, BudgetSourceLoan}
@@ -0,0 +1,7 @@
+package constants
+
+// Number of months the calendar covers when no end date is requested
+const CalendarDefaultMonths = 1
+
+// Maximum number of days a single calendar request may cover
+const CalendarMaxDays = 366
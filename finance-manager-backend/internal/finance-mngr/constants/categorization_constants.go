@@ -0,0 +1,7 @@
+package constants
+
+// Category of the transactions that have not been categorized
+const UncategorizedCategory = "uncategorized"
+
+// Sources categorization rules can link the transactions they match to
+var ValidRuleSources = []string{TransactionSourceIncome, TransactionSourceBill, TransactionSourceCreditCard}
@@ -0,0 +1,4 @@
+package constants
+
+// Maximum number of months a credit card projection will simulate before it is considered unpayable
+const CreditCardMaxProjectionMonths = 1200
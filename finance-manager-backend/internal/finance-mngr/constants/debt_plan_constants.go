@@ -0,0 +1,9 @@
+package constants
+
+const DebtPlanStrategyAvalanche = "avalanche"
+const DebtPlanStrategySnowball = "snowball"
+
+// Maximum number of months a debt plan will simulate before it is considered unpayable
+const DebtPlanMaxMonths = 1200
+
+var ValidDebtPlanStrategies = []string{DebtPlanStrategyAvalanche, DebtPlanStrategySnowball}
@@ -55,3 +55,11 @@ const StockOperationInvalidAmountError = "amount must be greater than 0"
 const StockOperationTickerRequiredError = "ticker is required"
 const StockOperationAlreadyExistsError = "a stock operation already exists for the given time"
 const StockOperationBelowZeroError = "stock operations cannot result in a quantity below 0"
+const StockOperationInvalidPriceError = "price must be greater than 0"
+const StockOperationInvalidFeesError = "fees cannot be negative"
+const StockOperationInvalidLotMethodError = "lotMethod must be one of fifo, lifo or specific-id"
+const StockOperationLotsNotAllowedError = "lots can only be selected when removing stock with the specific-id lotMethod"
+const StockOperationLotsRequiredError = "lots must select the full amount when lotMethod is specific-id"
+const PerformanceInvalidPeriodError = "period must be one of 1M, 3M, YTD, 1Y or inception"
+const BenchmarkLimitError = "no more than 5 benchmarks can be compared at once"
+const StockNotFoundError = "no stock data was found for the given ticker"
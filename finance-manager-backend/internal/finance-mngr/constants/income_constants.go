@@ -2,9 +2,25 @@ package constants
 
 const IncomeTypeSalary = "salary"
 const IncomeTypeHourly = "hourly"
-const IncomeFreqWeekly = "weekly"
-const IncomeFreqBiWeekly = "bi-weekly"
-const IncomeFreqMonthly = "monthly"
+const IncomeFreqWeekly = RecurrenceFreqWeekly
+const IncomeFreqBiWeekly = RecurrenceFreqBiWeekly
+const IncomeFreqSemiMonthly = RecurrenceFreqSemiMonthly
+const IncomeFreqMonthly = RecurrenceFreqMonthly
+const IncomeFreqQuarterly = RecurrenceFreqQuarterly
+const IncomeFreqAnnual = RecurrenceFreqAnnual
 
 var ValidTypes = []string{IncomeTypeHourly, IncomeTypeSalary}
-var ValidFreq = []string{IncomeFreqWeekly, IncomeFreqBiWeekly, IncomeFreqMonthly}
+var ValidFreq = []string{IncomeFreqWeekly, IncomeFreqBiWeekly, IncomeFreqSemiMonthly, IncomeFreqMonthly, IncomeFreqQuarterly, IncomeFreqAnnual}
+
+// Names of the deductions taken from each paycheck
+const DeductionNameRetirement = "401k"
+const DeductionNameRoth = "roth 401k"
+const DeductionNameHSA = "hsa"
+const DeductionNameHealthPremium = "health premium"
+const DeductionNameGarnishment = "garnishment"
+
+// Hours worked past the regular hours cap are paid at this multiple of the pay rate unless the income sets its own
+const DefaultOvertimeMultiplier = 1.5
+
+// Number of logged pay periods averaged to project the hours of future paychecks
+const TrailingHoursPeriods = 4
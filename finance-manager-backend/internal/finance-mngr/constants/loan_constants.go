@@ -0,0 +1,15 @@
+package constants
+
+const LoanPrepaymentTypeRecurring = "recurring"
+const LoanPrepaymentTypeLumpSum = "lump-sum"
+
+var ValidLoanPrepaymentTypes = []string{LoanPrepaymentTypeRecurring, LoanPrepaymentTypeLumpSum}
+
+// Default number of months between rate adjustments on a variable rate loan when none is given
+const LoanDefaultAdjustmentInterval = 12
+
+const LoanPaymentTypePayment = "payment"
+const LoanPaymentTypeLateFee = "late-fee"
+const LoanPaymentTypeExtraPrincipal = "extra-principal"
+
+var ValidLoanPaymentTypes = []string{LoanPaymentTypePayment, LoanPaymentTypeLateFee, LoanPaymentTypeExtraPrincipal}
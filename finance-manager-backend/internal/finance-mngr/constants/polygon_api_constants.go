@@ -1,4 +1,9 @@
 package constants
 
 const PolygonGetPrevCloseAPI = "/aggs/ticker/%s/prev"
-const PolygonGetDateRangeAPI = "/aggs/ticker/%s/range/1/day/%s/%s"
\ No newline at end of file
+const PolygonGetDateRangeAPI = "/aggs/ticker/%s/range/1/day/%s/%s"
+
+// Reference APIs are versioned separately from the aggregate APIs and are called from the root of the polygon API
+const PolygonGetSplitsAPI = "/v3/reference/splits?ticker=%s&limit=1000"
+const PolygonGetDividendsAPI = "/v3/reference/dividends?ticker=%s&limit=1000"
+const PolygonDateFormat = "2006-01-02"
@@ -0,0 +1,9 @@
+package constants
+
+// Frequencies understood by the shared recurrence engine in fmUtil
+const RecurrenceFreqWeekly = "weekly"
+const RecurrenceFreqBiWeekly = "bi-weekly"
+const RecurrenceFreqSemiMonthly = "semi-monthly"
+const RecurrenceFreqMonthly = "monthly"
+const RecurrenceFreqQuarterly = "quarterly"
+const RecurrenceFreqAnnual = "annual"
@@ -0,0 +1,9 @@
+package constants
+
+// How often interest is credited to a savings balance
+const CompoundingDaily = "daily"
+const CompoundingMonthly = RecurrenceFreqMonthly
+const CompoundingQuarterly = RecurrenceFreqQuarterly
+const CompoundingAnnual = RecurrenceFreqAnnual
+
+var ValidCompounding = []string{CompoundingDaily, CompoundingMonthly, CompoundingQuarterly, CompoundingAnnual}
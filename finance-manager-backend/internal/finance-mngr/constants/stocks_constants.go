@@ -11,4 +11,30 @@ const UserStockTypeWatch = "w"
 
 const ModifyStockOperationAdd = "add"
 const ModifyStockOperationRemove = "remove"
-const ModifyStockOperationUndefined = "undefined"
\ No newline at end of file
+const ModifyStockOperationUndefined = "undefined"
+
+const StockTransactionTypeBuy = "buy"
+const StockTransactionTypeSell = "sell"
+
+// Methods of choosing the lots a sale is taken from
+const LotMethodFIFO = "fifo"
+const LotMethodLIFO = "lifo"
+const LotMethodSpecificID = "specific-id"
+
+var ValidLotMethods = []string{LotMethodFIFO, LotMethodLIFO, LotMethodSpecificID}
+
+// Holding terms of gains. Lots held for more than a year are long-term
+const GainTermShort = "short-term"
+const GainTermLong = "long-term"
+
+// Periods that portfolio performance is measured over. Inception measures performance since the user first held each stock
+const PerformancePeriodOneMonth = "1M"
+const PerformancePeriodThreeMonths = "3M"
+const PerformancePeriodYearToDate = "YTD"
+const PerformancePeriodOneYear = "1Y"
+const PerformancePeriodInception = "inception"
+
+var ValidPerformancePeriods = []string{PerformancePeriodOneMonth, PerformancePeriodThreeMonths, PerformancePeriodYearToDate, PerformancePeriodOneYear, PerformancePeriodInception}
+
+// The most benchmark tickers a portfolio's history can be compared to at once
+const MaxBenchmarks = 5
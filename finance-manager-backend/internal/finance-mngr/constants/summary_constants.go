@@ -0,0 +1,7 @@
+package constants
+
+// Maximum number of months a summary projection may cover
+const SummaryMaxMonths = 60
+
+// Number of months of summary history returned when no from date is requested
+const SummaryHistoryDefaultMonths = 12
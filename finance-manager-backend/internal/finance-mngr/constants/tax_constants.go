@@ -0,0 +1,22 @@
+package constants
+
+const FilingStatusSingle = "single"
+const FilingStatusMarriedJoint = "married-joint"
+const FilingStatusMarriedSeparate = "married-separate"
+const FilingStatusHeadOfHousehold = "head-of-household"
+
+var ValidFilingStatuses = []string{FilingStatusSingle, FilingStatusMarriedJoint, FilingStatusMarriedSeparate, FilingStatusHeadOfHousehold}
+
+// Names of the itemized taxes withheld from each paycheck
+const TaxNameFederal = "federal income tax"
+const TaxNameState = "state income tax"
+const TaxNameSocialSecurity = "social security"
+const TaxNameMedicare = "medicare"
+
+// Number of paychecks in a year for each income frequency
+const PaysPerYearWeekly = 52
+const PaysPerYearBiWeekly = 26
+const PaysPerYearSemiMonthly = 24
+const PaysPerYearMonthly = 12
+const PaysPerYearQuarterly = 4
+const PaysPerYearAnnual = 1
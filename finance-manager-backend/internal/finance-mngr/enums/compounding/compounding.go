@@ -0,0 +1,57 @@
+package compounding
+
+import "finance-manager-backend/internal/finance-mngr/constants"
+
+type Compounding string
+
+const (
+	Undefined Compounding = ""
+	Daily     Compounding = constants.CompoundingDaily
+	Monthly   Compounding = constants.CompoundingMonthly
+	Quarterly Compounding = constants.CompoundingQuarterly
+	Annual    Compounding = constants.CompoundingAnnual
+)
+
+func GetCompounding(s string) Compounding {
+	switch s {
+	case constants.CompoundingDaily:
+		return Daily
+	case constants.CompoundingMonthly:
+		return Monthly
+	case constants.CompoundingQuarterly:
+		return Quarterly
+	case constants.CompoundingAnnual:
+		return Annual
+	default:
+		return Undefined
+	}
+}
+
+func (c Compounding) String() string {
+	switch c {
+	case Daily:
+		return constants.CompoundingDaily
+	case Monthly:
+		return constants.CompoundingMonthly
+	case Quarterly:
+		return constants.CompoundingQuarterly
+	case Annual:
+		return constants.CompoundingAnnual
+	default:
+		return ""
+	}
+}
+
+// Function PeriodsPerYear returns the number of times interest is credited in a year
+func (c Compounding) PeriodsPerYear() int {
+	switch c {
+	case Daily:
+		return 365
+	case Quarterly:
+		return 4
+	case Annual:
+		return 1
+	default:
+		return 12
+	}
+}
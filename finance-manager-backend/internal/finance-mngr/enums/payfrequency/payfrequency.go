@@ -5,10 +5,13 @@ import "finance-manager-backend/internal/finance-mngr/constants"
 type PayFrequency string
 
 const (
-	Undefined PayFrequency = ""
-	Weekly    PayFrequency = constants.IncomeFreqWeekly
-	BiWeekly  PayFrequency = constants.IncomeFreqBiWeekly
-	Monthly   PayFrequency = constants.IncomeFreqMonthly
+	Undefined   PayFrequency = ""
+	Weekly      PayFrequency = constants.IncomeFreqWeekly
+	BiWeekly    PayFrequency = constants.IncomeFreqBiWeekly
+	SemiMonthly PayFrequency = constants.IncomeFreqSemiMonthly
+	Monthly     PayFrequency = constants.IncomeFreqMonthly
+	Quarterly   PayFrequency = constants.IncomeFreqQuarterly
+	Annual      PayFrequency = constants.IncomeFreqAnnual
 )
 
 func GetPayFrequency(s string) PayFrequency {
@@ -17,8 +20,14 @@ func GetPayFrequency(s string) PayFrequency {
 		return Weekly
 	case constants.IncomeFreqBiWeekly:
 		return BiWeekly
+	case constants.IncomeFreqSemiMonthly:
+		return SemiMonthly
 	case constants.IncomeFreqMonthly:
 		return Monthly
+	case constants.IncomeFreqQuarterly:
+		return Quarterly
+	case constants.IncomeFreqAnnual:
+		return Annual
 	default:
 		return Undefined
 	}
@@ -30,8 +39,14 @@ func (p PayFrequency) String() string {
 		return constants.IncomeFreqWeekly
 	case BiWeekly:
 		return constants.IncomeFreqBiWeekly
+	case SemiMonthly:
+		return constants.IncomeFreqSemiMonthly
 	case Monthly:
 		return constants.IncomeFreqMonthly
+	case Quarterly:
+		return constants.IncomeFreqQuarterly
+	case Annual:
+		return constants.IncomeFreqAnnual
 	default:
 		return ""
 	}
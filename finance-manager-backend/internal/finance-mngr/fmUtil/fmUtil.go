@@ -43,3 +43,48 @@ func GetStartOfDay(date time.Time) time.Time {
 	klogger.Exit(method)
 	return newDate
 }
+
+// Function AddMonths adds n months to date. Unlike time.AddDate the day is clamped to the last day of the
+// resulting month, so adding one month to January 31st returns the last day of February
+func AddMonths(date time.Time, n int) time.Time {
+	method := "fmUtil.AddMonths"
+	klogger.Enter(method)
+
+	first := time.Date(date.Year(), date.Month()+time.Month(n), 1, date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location())
+	day := date.Day()
+
+	if last := GetMonthEndDate(first).Day(); day > last {
+		day = last
+	}
+
+	klogger.Exit(method)
+	return first.AddDate(0, 0, day-1)
+}
+
+// Function GetMonthsBetween returns the number of months from the month containing s to the month containing e.
+// The result is negative when e is in an earlier month than s
+func GetMonthsBetween(s time.Time, e time.Time) int {
+	method := "fmUtil.GetMonthsBetween"
+	klogger.Enter(method)
+
+	n := (e.Year()-s.Year())*12 + int(e.Month()) - int(s.Month())
+
+	klogger.Exit(method)
+	return n
+}
+
+// Function GetPreviousBusinessDay returns date if it is a weekday, otherwise it returns the Friday before it
+func GetPreviousBusinessDay(date time.Time) time.Time {
+	method := "fmUtil.GetPreviousBusinessDay"
+	klogger.Enter(method)
+
+	switch date.Weekday() {
+	case time.Saturday:
+		date = date.AddDate(0, 0, -1)
+	case time.Sunday:
+		date = date.AddDate(0, 0, -2)
+	}
+
+	klogger.Exit(method)
+	return date
+}
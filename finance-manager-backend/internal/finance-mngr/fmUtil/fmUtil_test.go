@@ -64,3 +64,45 @@ func TestGetStartOfDay(t *testing.T) {
 
 	klogger.Exit(method)
 }
+
+func TestAddMonths(t *testing.T) {
+	method := "fmUtil_test.TestAddMonths"
+	klogger.Enter(method)
+
+	date := time.Date(2024, 1, 31, 7, 33, 32, 1, time.UTC)
+
+	assert.Equal(t, time.Date(2024, 2, 29, 7, 33, 32, 1, time.UTC), AddMonths(date, 1))
+	assert.Equal(t, time.Date(2024, 3, 31, 7, 33, 32, 1, time.UTC), AddMonths(date, 2))
+	assert.Equal(t, time.Date(2023, 12, 31, 7, 33, 32, 1, time.UTC), AddMonths(date, -1))
+	assert.Equal(t, time.Date(2025, 1, 31, 7, 33, 32, 1, time.UTC), AddMonths(date, 12))
+
+	klogger.Exit(method)
+}
+
+func TestGetMonthsBetween(t *testing.T) {
+	method := "fmUtil_test.TestGetMonthsBetween"
+	klogger.Enter(method)
+
+	date := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 0, GetMonthsBetween(date, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 1, GetMonthsBetween(date, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 13, GetMonthsBetween(date, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, -1, GetMonthsBetween(date, time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)))
+
+	klogger.Exit(method)
+}
+
+func TestGetPreviousBusinessDay(t *testing.T) {
+	method := "fmUtil_test.TestGetPreviousBusinessDay"
+	klogger.Enter(method)
+
+	friday := time.Date(2024, 6, 14, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, friday, GetPreviousBusinessDay(friday))
+	assert.Equal(t, friday, GetPreviousBusinessDay(time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, friday, GetPreviousBusinessDay(time.Date(2024, 6, 16, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2024, 6, 17, 0, 0, 0, 0, time.UTC), GetPreviousBusinessDay(time.Date(2024, 6, 17, 0, 0, 0, 0, time.UTC)))
+
+	klogger.Exit(method)
+}
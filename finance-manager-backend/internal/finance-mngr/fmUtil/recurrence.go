@@ -0,0 +1,184 @@
+package fmUtil
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type Recurrence describes an event that repeats every Frequency starting on StartDt and ending on EndDt if it is set.
+// It is shared by every entity that occurs on a schedule, such as income paydays and bill due dates.
+// Recurrences on BusinessDays that fall on a weekend occur on the Friday before instead
+type Recurrence struct {
+	Frequency    string
+	StartDt      time.Time
+	EndDt        *time.Time
+	BusinessDays bool
+}
+
+// Function GetDatesBetween returns each date the recurrence occurs between s and e inclusively
+func (r *Recurrence) GetDatesBetween(s time.Time, e time.Time) []time.Time {
+	method := "recurrence.GetDatesBetween"
+	klogger.Enter(method)
+
+	if !r.BusinessDays {
+		dates := r.getScheduledDatesBetween(s, e)
+		klogger.Exit(method)
+		return dates
+	}
+
+	//Dates scheduled on the weekend after e are moved back into the range
+	var dates []time.Time
+	for _, d := range r.getScheduledDatesBetween(s, e.AddDate(0, 0, 2)) {
+		d = GetPreviousBusinessDay(d)
+
+		if !d.Before(s) && !d.After(e) {
+			dates = append(dates, d)
+		}
+	}
+
+	klogger.Exit(method)
+	return dates
+}
+
+// Function getScheduledDatesBetween returns each date the recurrence is scheduled for between s and e inclusively before any business day adjustment.
+// Monthly, quarterly and annual recurrences always add months to StartDt so days are not lost in short months.
+// Semi-monthly recurrences occur on the 15th and last day of each month
+func (r *Recurrence) getScheduledDatesBetween(s time.Time, e time.Time) []time.Time {
+	method := "recurrence.getScheduledDatesBetween"
+	klogger.Enter(method)
+
+	var dates []time.Time
+
+	if r.StartDt.IsZero() {
+		klogger.Info(method, "recurrence does not have a start date")
+		klogger.Exit(method)
+		return dates
+	}
+
+	anchor := GetStartOfDay(r.StartDt)
+
+	//Recurrences end on their end date if they have one
+	if r.EndDt != nil && r.EndDt.Before(e) {
+		e = *r.EndDt
+	}
+
+	switch r.Frequency {
+	case constants.RecurrenceFreqWeekly, constants.RecurrenceFreqBiWeekly:
+		step := 7
+		if r.Frequency == constants.RecurrenceFreqBiWeekly {
+			step = 14
+		}
+
+		//Skip ahead to the first date on or after s
+		date := anchor
+		if date.Before(s) {
+			periods := int(s.Sub(date).Hours()/24) / step
+			date = date.AddDate(0, 0, periods*step)
+		}
+
+		for date.Before(s) {
+			date = date.AddDate(0, 0, step)
+		}
+
+		for !date.After(e) {
+			dates = append(dates, date)
+			date = date.AddDate(0, 0, step)
+		}
+	case constants.RecurrenceFreqMonthly, constants.RecurrenceFreqQuarterly, constants.RecurrenceFreqAnnual:
+		step := getRecurrenceMonthStep(r.Frequency)
+
+		//Skip ahead to the period before s
+		k := 0
+		if anchor.Before(s) {
+			months := (s.Year()-anchor.Year())*12 + int(s.Month()-anchor.Month())
+			k = (months/step - 1) * step
+			if k < 0 {
+				k = 0
+			}
+		}
+
+		date := AddMonths(anchor, k)
+		for date.Before(s) {
+			k += step
+			date = AddMonths(anchor, k)
+		}
+
+		for !date.After(e) {
+			dates = append(dates, date)
+			k += step
+			date = AddMonths(anchor, k)
+		}
+	case constants.RecurrenceFreqSemiMonthly:
+		month := time.Date(anchor.Year(), anchor.Month(), 1, 0, 0, 0, 0, anchor.Location())
+		if month.Before(s) {
+			month = time.Date(s.Year(), s.Month(), 1, 0, 0, 0, 0, anchor.Location())
+		}
+
+		for !month.After(e) {
+			mid := month.AddDate(0, 0, 14)
+			last := month.AddDate(0, 1, -1)
+
+			for _, date := range []time.Time{mid, last} {
+				if !date.Before(anchor) && !date.Before(s) && !date.After(e) {
+					dates = append(dates, date)
+				}
+			}
+
+			month = month.AddDate(0, 1, 0)
+		}
+	default:
+		klogger.Info(method, "recurrence frequency %s is not supported", r.Frequency)
+	}
+
+	klogger.Exit(method)
+	return dates
+}
+
+// Function GetNextDate returns the first date the recurrence occurs on or after the day containing t.
+// A zero time is returned if the recurrence has ended
+func (r *Recurrence) GetNextDate(t time.Time) time.Time {
+	method := "recurrence.GetNextDate"
+	klogger.Enter(method)
+
+	s := GetStartOfDay(t)
+
+	//Every supported frequency occurs at least once in any thirteen month window
+	dates := r.GetDatesBetween(s, AddMonths(s, 13))
+
+	if len(dates) == 0 {
+		klogger.Exit(method)
+		return time.Time{}
+	}
+
+	klogger.Exit(method)
+	return dates[0]
+}
+
+// Function GetCountForMonthContainingDate returns the number of times the recurrence occurs in the month containing t
+func (r *Recurrence) GetCountForMonthContainingDate(t time.Time) int {
+	method := "recurrence.GetCountForMonthContainingDate"
+	klogger.Enter(method)
+
+	count := len(r.GetDatesBetween(GetMonthBeginDate(t), GetMonthEndDate(t)))
+
+	klogger.Exit(method)
+	return count
+}
+
+// Function getRecurrenceMonthStep returns the number of months between occurrences of month based frequencies
+func getRecurrenceMonthStep(f string) int {
+	method := "recurrence.getRecurrenceMonthStep"
+	klogger.Enter(method)
+
+	step := 1
+	if f == constants.RecurrenceFreqQuarterly {
+		step = 3
+	} else if f == constants.RecurrenceFreqAnnual {
+		step = 12
+	}
+
+	klogger.Exit(method)
+	return step
+}
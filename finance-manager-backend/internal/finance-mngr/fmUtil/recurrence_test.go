@@ -0,0 +1,161 @@
+package fmUtil
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDatesBetween(t *testing.T) {
+	method := "recurrence_test.TestGetDatesBetween"
+	klogger.Enter(method)
+
+	s := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	e := time.Date(2024, 2, 29, 23, 59, 59, 0, time.UTC)
+
+	r := Recurrence{
+		Frequency: constants.RecurrenceFreqWeekly,
+		StartDt:   time.Date(2024, 1, 12, 10, 2, 4, 5, time.UTC),
+	}
+
+	assert.Equal(t, 4, len(r.GetDatesBetween(s, e)))
+	assert.Equal(t, time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC), r.GetDatesBetween(s, e)[0])
+
+	//Recurrences stop on their end date
+	end := time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)
+	r.EndDt = &end
+	assert.Equal(t, 2, len(r.GetDatesBetween(s, e)))
+
+	//Month based recurrences keep their day in short months
+	r = Recurrence{
+		Frequency: constants.RecurrenceFreqQuarterly,
+		StartDt:   time.Date(2023, 11, 30, 0, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, []time.Time{time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)}, r.GetDatesBetween(s, e))
+	assert.Equal(t, time.Date(2024, 5, 30, 0, 0, 0, 0, time.UTC), r.GetDatesBetween(s, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))[1])
+
+	//Unsupported frequencies and missing start dates never occur
+	r.Frequency = "invalid"
+	assert.Equal(t, 0, len(r.GetDatesBetween(s, e)))
+
+	r = Recurrence{Frequency: constants.RecurrenceFreqMonthly}
+	assert.Equal(t, 0, len(r.GetDatesBetween(s, e)))
+
+	klogger.Exit(method)
+}
+
+func TestGetDatesBetween_semiMonthly(t *testing.T) {
+	method := "recurrence_test.TestGetDatesBetween_semiMonthly"
+	klogger.Enter(method)
+
+	s := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	e := time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)
+
+	r := Recurrence{
+		Frequency: constants.RecurrenceFreqSemiMonthly,
+		StartDt:   time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	//Semi-monthly recurrences occur on the 15th and last day of each month
+	assert.Equal(t, []time.Time{
+		time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+	}, r.GetDatesBetween(s, e))
+
+	//Dates before the start date do not occur
+	r.StartDt = time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), r.GetDatesBetween(s, e)[0])
+	assert.Equal(t, 3, len(r.GetDatesBetween(s, e)))
+
+	klogger.Exit(method)
+}
+
+func TestGetDatesBetween_businessDays(t *testing.T) {
+	method := "recurrence_test.TestGetDatesBetween_businessDays"
+	klogger.Enter(method)
+
+	r := Recurrence{
+		Frequency:    constants.RecurrenceFreqSemiMonthly,
+		StartDt:      time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		BusinessDays: true,
+	}
+
+	//June 15th 2024 is a Saturday and June 30th is a Sunday
+	s := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	e := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, []time.Time{
+		time.Date(2024, 6, 14, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 6, 28, 0, 0, 0, 0, time.UTC),
+	}, r.GetDatesBetween(s, e))
+
+	//Weekend dates just after the range are moved into it
+	assert.Equal(t, []time.Time{time.Date(2024, 6, 28, 0, 0, 0, 0, time.UTC)}, r.GetDatesBetween(time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 28, 0, 0, 0, 0, time.UTC)))
+
+	//Dates moved into the previous month are counted in that month
+	r = Recurrence{
+		Frequency:    constants.RecurrenceFreqMonthly,
+		StartDt:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		BusinessDays: true,
+	}
+
+	assert.Equal(t, 2, r.GetCountForMonthContainingDate(time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 0, r.GetCountForMonthContainingDate(time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)))
+
+	klogger.Exit(method)
+}
+
+func TestGetNextDate(t *testing.T) {
+	method := "recurrence_test.TestGetNextDate"
+	klogger.Enter(method)
+
+	d := time.Date(2024, 1, 23, 7, 45, 23, 0, time.UTC)
+
+	r := Recurrence{
+		Frequency: constants.RecurrenceFreqBiWeekly,
+		StartDt:   time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC), r.GetNextDate(d))
+
+	//The day containing t counts as the next date
+	r.StartDt = time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 1, 23, 0, 0, 0, 0, time.UTC), r.GetNextDate(d))
+
+	r.Frequency = constants.RecurrenceFreqAnnual
+	assert.Equal(t, time.Date(2025, 1, 9, 0, 0, 0, 0, time.UTC), r.GetNextDate(d))
+
+	//Ended recurrences have no next date
+	end := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	r.EndDt = &end
+	assert.True(t, r.GetNextDate(d).IsZero())
+
+	klogger.Exit(method)
+}
+
+func TestGetCountForMonthContainingDate(t *testing.T) {
+	method := "recurrence_test.TestGetCountForMonthContainingDate"
+	klogger.Enter(method)
+
+	d := time.Date(2024, 2, 12, 0, 0, 0, 0, time.UTC)
+
+	r := Recurrence{
+		Frequency: constants.RecurrenceFreqBiWeekly,
+		StartDt:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, 3, r.GetCountForMonthContainingDate(d))
+	assert.Equal(t, 0, r.GetCountForMonthContainingDate(time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC)))
+
+	r.Frequency = constants.RecurrenceFreqAnnual
+	assert.Equal(t, 1, r.GetCountForMonthContainingDate(d))
+	assert.Equal(t, 0, r.GetCountForMonthContainingDate(time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC)))
+
+	klogger.Exit(method)
+}
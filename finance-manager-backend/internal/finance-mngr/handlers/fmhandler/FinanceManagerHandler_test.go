@@ -40,7 +40,7 @@ func TestMain(m *testing.M) {
 		JSONUtil:        &jsonutils.JSONUtil{},
 		Validator:       &validation.FinanceManagerValidator{DB: db},
 		Auth:            test.GetTestAuth(),
-		Service:         &fmservice.FMService{},
+		Service:         &fmservice.FMService{DB: db},
 		ExternalService: &polygonservice.PolygonService{},
 	}
 
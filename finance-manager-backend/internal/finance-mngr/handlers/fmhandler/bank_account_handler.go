@@ -0,0 +1,400 @@
+package fmhandler
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jon-kamis/klogger"
+)
+
+// GetAllUserBankAccounts godoc
+// @title		Get All User Bank Accounts
+// @version 	1.0.0
+// @Tags 		Bank Accounts
+// @Summary 	Get All User Bank Accounts
+// @Description Returns an array of Bank Account objects belonging to a given user with their current balances
+// @Param		userId path int true "User ID"
+// @Param		search query string false "Search for bank accounts by name"
+// @Produce 	json
+// @Success 	200 {array} models.BankAccount
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/bank-accounts [get]
+func (fmh *FinanceManagerHandler) GetAllUserBankAccounts(w http.ResponseWriter, r *http.Request) {
+	method := "bank_account_handler.GetAllUserBankAccounts"
+	klogger.Enter(method)
+
+	//Read ID from url
+	search := r.URL.Query().Get("search")
+	id, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	accounts, err := fmh.DB.GetAllUserBankAccounts(id, search)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	transactions, err := fmh.DB.GetAllUserBankAccountTransactions(id)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	for _, a := range accounts {
+		a.LoadTransactions(transactions)
+		a.CalcBalance(time.Now())
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, accounts)
+}
+
+// GetBankAccountById godoc
+// @title		Get Bank Account by ID
+// @version 	1.0.0
+// @Tags 		Bank Accounts
+// @Summary 	Get Bank Account by ID
+// @Description Returns a Bank Account by its ID for a given user with its current balance and transaction ledger
+// @Param		userId path int true "User ID"
+// @Param		accountId path int true "ID of the Bank Account"
+// @Produce 	json
+// @Success 	200 {object} models.BankAccount
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/bank-accounts/{accountId} [get]
+func (fmh *FinanceManagerHandler) GetBankAccountById(w http.ResponseWriter, r *http.Request) {
+	method := "bank_account_handler.GetBankAccountById"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	accountId, err1 := strconv.Atoi(chi.URLParam(r, "accountId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	account, err := fmh.DB.GetBankAccountByID(accountId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if account.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.BankAccountBelongsToUser(account, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	transactions, err := fmh.DB.GetAllBankAccountTransactionsByBankAccountID(accountId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	account.LoadTransactions(transactions)
+	account.CalcBalance(time.Now())
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, account)
+}
+
+// SaveBankAccount godoc
+// @title		Insert Bank Account
+// @version 	1.0.0
+// @Tags 		Bank Accounts
+// @Summary 	Insert Bank Account
+// @Description Inserts a new checking, savings or cash account holding openingBalance on openingDt into the Database for a given user
+// @Param		userId path int true "User ID"
+// @Param		account body models.BankAccount true "The bank account to insert"
+// @Accept		json
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/bank-accounts [post]
+func (fmh *FinanceManagerHandler) SaveBankAccount(w http.ResponseWriter, r *http.Request) {
+	method := "bank_account_handler.SaveBankAccount"
+	klogger.Enter(method)
+
+	var payload models.BankAccount
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	// Read in account from payload
+	err = fmh.JSONUtil.ReadJSON(w, r, &payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.FailedToParseJsonBodyError, err)
+		return
+	}
+
+	payload.UserID = userId
+
+	err = payload.ValidateCanSaveBankAccount()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	_, err = fmh.DB.InsertBankAccount(payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "new bank account was saved successfully")
+}
+
+// UpdateBankAccount godoc
+// @title		Update Bank Account
+// @version 	1.0.0
+// @Tags 		Bank Accounts
+// @Summary 	Update Bank Account
+// @Description Updates an existing Bank Account for a user. The account's transactions are kept
+// @Param		userId path int true "User ID"
+// @Param		accountId path int true "ID of the Bank Account to update"
+// @Param		account body models.BankAccount true "The bank account to update"
+// @Accept		json
+// @Produce 	json
+// @Success 	200 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/bank-accounts/{accountId} [put]
+func (fmh *FinanceManagerHandler) UpdateBankAccount(w http.ResponseWriter, r *http.Request) {
+	method := "bank_account_handler.UpdateBankAccount"
+	klogger.Enter(method)
+
+	var payload models.BankAccount
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	accountId, err1 := strconv.Atoi(chi.URLParam(r, "accountId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	// Read in account from payload
+	err = fmh.JSONUtil.ReadJSON(w, r, &payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.FailedToParseJsonBodyError, err)
+		return
+	}
+
+	// Validate that the account exists and belongs to the user
+	account, err := fmh.DB.GetBankAccountByID(accountId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if account.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.BankAccountBelongsToUser(account, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	payload.ID = accountId
+	payload.UserID = userId
+
+	err = payload.ValidateCanSaveBankAccount()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	err = fmh.DB.UpdateBankAccount(payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, "Bank account updated successfully")
+}
+
+// DeleteBankAccountById godoc
+// @title		Delete Bank Account by ID
+// @version 	1.0.0
+// @Tags 		Bank Accounts
+// @Summary 	Delete Bank Account by ID
+// @Description Deletes a user's Bank Account and its transactions by its ID. Incomes and bills posting to the account are unlinked from it
+// @Param		userId path int true "User ID"
+// @Param		accountId path int true "ID of the Bank Account"
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/bank-accounts/{accountId} [delete]
+func (fmh *FinanceManagerHandler) DeleteBankAccountById(w http.ResponseWriter, r *http.Request) {
+	method := "bank_account_handler.DeleteBankAccountById"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	accountId, err1 := strconv.Atoi(chi.URLParam(r, "accountId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	account, err := fmh.DB.GetBankAccountByID(accountId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if account.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.BankAccountBelongsToUser(account, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	// Stop posting incomes and bills to the account, then delete the account and its transactions
+	err = fmh.DB.UnlinkIncomesFromBankAccount(accountId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	err = fmh.DB.UnlinkBillsFromBankAccount(accountId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	err = fmh.DB.DeleteBankAccountTransactionsByBankAccountID(accountId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.FailedToDeleteEntityError, err)
+		return
+	}
+
+	err = fmh.DB.DeleteBankAccountByID(accountId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.FailedToDeleteEntityError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "Bank account deleted successfully")
+}
+
+// Function validateBankAccountLink validates that the bank account an income or bill posts to exists and belongs to the user.
+// Returns the http status to respond with when it does not
+func (fmh *FinanceManagerHandler) validateBankAccountLink(accountId int, userId int) (int, error) {
+	method := "bank_account_handler.validateBankAccountLink"
+	klogger.Enter(method)
+
+	if accountId == 0 {
+		klogger.Exit(method)
+		return http.StatusOK, nil
+	}
+
+	account, err := fmh.DB.GetBankAccountByID(accountId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return http.StatusInternalServerError, errors.New(constants.GenericServerError)
+	}
+
+	if account.ID == 0 {
+		err = errors.New("linked bank account does not exist")
+		klogger.ExitError(method, err.Error())
+		return http.StatusBadRequest, err
+	}
+
+	err = fmh.Validator.BankAccountBelongsToUser(account, userId)
+	if err != nil {
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return http.StatusForbidden, err
+	}
+
+	klogger.Exit(method)
+	return http.StatusOK, nil
+}
@@ -0,0 +1,177 @@
+package fmhandler
+
+import (
+	"encoding/json"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"finance-manager-backend/test"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllUserBankAccounts_403(t *testing.T) {
+	method := "bank_account_handler_test.TestGetAllUserBankAccounts_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/bank-accounts", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetBankAccountById_400(t *testing.T) {
+	method := "bank_account_handler_test.TestGetBankAccountById_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/bank-accounts/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetBankAccountById_403(t *testing.T) {
+	method := "bank_account_handler_test.TestGetBankAccountById_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/bank-accounts/1", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestDeleteBankAccountById_400(t *testing.T) {
+	method := "bank_account_handler_test.TestDeleteBankAccountById_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodDelete, "/users/2/bank-accounts/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestBankAccount_roundTrip(t *testing.T) {
+	method := "bank_account_handler_test.TestBankAccount_roundTrip"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	a := models.BankAccount{
+		Name:           "TestBankAccount",
+		Type:           constants.BankAccountTypeChecking,
+		OpeningBalance: 500,
+		OpeningDt:      time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	//Create
+	writer := MakeRequest(http.MethodPost, "/users/2/bank-accounts", a, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	var aDb models.BankAccount
+	p.GormDB.Where("name = ?", a.Name).First(&aDb)
+	assert.Greater(t, aDb.ID, 0)
+	assert.Equal(t, 2, aDb.UserID)
+	assert.Equal(t, a.OpeningBalance, aDb.OpeningBalance)
+
+	url := fmt.Sprintf("/users/2/bank-accounts/%d", aDb.ID)
+
+	p.GormDB.Create(&models.BankAccountTransaction{
+		BankAccountID: aDb.ID,
+		UserID:        2,
+		Type:          constants.TransactionTypeDeposit,
+		Amount:        250,
+		TransactionDt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	//Get all
+	writer = MakeRequest(http.MethodGet, "/users/2/bank-accounts", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var accounts []models.BankAccount
+	err := json.Unmarshal(writer.Body.Bytes(), &accounts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(accounts))
+
+	//Update
+	a.OpeningBalance = 1000
+	writer = MakeRequest(http.MethodPut, url, a, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	//Get by ID
+	writer = MakeRequest(http.MethodGet, url, nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var resp models.BankAccount
+	err = json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, aDb.ID, resp.ID)
+	assert.Equal(t, 1000.0, resp.OpeningBalance)
+	assert.Equal(t, 1, len(resp.Transactions))
+	assert.Equal(t, 1250.0, resp.Balance)
+
+	//Delete removes the account and its transactions
+	writer = MakeRequest(http.MethodDelete, url, nil, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	writer = MakeRequest(http.MethodGet, url, nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	var count int64
+	p.GormDB.Model(&models.BankAccountTransaction{}).Where("bank_account_id = ?", aDb.ID).Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	teardownBankAccountHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestSaveBankAccount_400(t *testing.T) {
+	method := "bank_account_handler_test.TestSaveBankAccount_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	//Malformed Object
+	writer := MakeRequest(http.MethodPost, "/users/2/bank-accounts", "{Bad", true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Invalid type
+	a := models.BankAccount{Name: "TestSaveBankAccount_400", Type: "brokerage", OpeningDt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	writer = MakeRequest(http.MethodPost, "/users/2/bank-accounts", a, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestBankAccount_404(t *testing.T) {
+	method := "bank_account_handler_test.TestBankAccount_404"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	a := models.BankAccount{Name: "TestBankAccount_404", Type: constants.BankAccountTypeChecking, OpeningDt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	writer := MakeRequest(http.MethodPut, "/users/2/bank-accounts/9999", a, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	writer = MakeRequest(http.MethodDelete, "/users/2/bank-accounts/9999", nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func teardownBankAccountHandlerTestData() {
+	p.GormDB.Exec("DELETE FROM bank_account_transactions")
+	p.GormDB.Exec("DELETE FROM bank_accounts")
+}
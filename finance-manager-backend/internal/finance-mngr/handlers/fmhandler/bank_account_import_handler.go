@@ -0,0 +1,170 @@
+package fmhandler
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/statementimport"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jon-kamis/klogger"
+)
+
+// ImportBankStatement godoc
+// @title		Import Bank Statement
+// @version 	1.0.0
+// @Tags 		Bank Accounts
+// @Summary 	Import Bank Statement
+// @Description Imports the transactions of an OFX, QFX or CSV statement exported by a bank to a Bank Account's ledger
+// @Description Transactions are identified by the FITID of OFX and QFX statements, or the idColumn of CSV statements, and otherwise by a hash of their date, amount, payee and memo. Transactions already imported to the account are skipped
+// @Description Each imported transaction suggests the income, bill or credit card it appears to be for. Accounts that import statements should not also be linked to incomes and bills, or their paydays and due dates are counted twice
+// @Description CSV columns are named by their header, or by their zero based index when noHeader is true. Either amountColumn, holding signed amounts, or debitColumn and creditColumn are required
+// @Param		userId path int true "User ID"
+// @Param		accountId path int true "ID of the Bank Account"
+// @Param		file formData file true "The statement to import"
+// @Param		format formData string false "One of ofx, qfx or csv. Default is the extension of the file"
+// @Param		dateColumn formData string false "CSV column holding the transaction date"
+// @Param		dateFormat formData string false "Go layout of CSV dates. Default is 01/02/2006"
+// @Param		amountColumn formData string false "CSV column holding signed amounts"
+// @Param		debitColumn formData string false "CSV column holding withdrawals"
+// @Param		creditColumn formData string false "CSV column holding deposits"
+// @Param		payeeColumn formData string false "CSV column holding the payee"
+// @Param		memoColumn formData string false "CSV column holding the memo"
+// @Param		idColumn formData string false "CSV column holding the bank's transaction id"
+// @Param		noHeader formData bool false "True if the CSV has no header row"
+// @Accept		multipart/form-data
+// @Produce 	json
+// @Success 	200 {object} models.StatementImportResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	422 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/bank-accounts/{accountId}/import [post]
+func (fmh *FinanceManagerHandler) ImportBankStatement(w http.ResponseWriter, r *http.Request) {
+	method := "bank_account_import_handler.ImportBankStatement"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	accountId, err1 := strconv.Atoi(chi.URLParam(r, "accountId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, constants.StatementImportMaxBytes)
+	err = r.ParseMultipartForm(constants.StatementImportMaxBytes)
+	if err != nil {
+		err = fmt.Errorf("request must be a multipart form no larger than %d bytes", constants.StatementImportMaxBytes)
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		err = errors.New("file is required")
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	defer file.Close()
+
+	format := strings.ToLower(r.FormValue("format"))
+	if format == "" {
+		format = strings.ToLower(strings.TrimPrefix(filepath.Ext(header.Filename), "."))
+	}
+
+	if !slices.Contains(constants.ValidStatementFormats, format) {
+		err = errors.New("format must be one of ofx, qfx or csv")
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	mapping := statementimport.CsvMapping{
+		DateColumn:   r.FormValue("dateColumn"),
+		AmountColumn: r.FormValue("amountColumn"),
+		DebitColumn:  r.FormValue("debitColumn"),
+		CreditColumn: r.FormValue("creditColumn"),
+		PayeeColumn:  r.FormValue("payeeColumn"),
+		MemoColumn:   r.FormValue("memoColumn"),
+		IdColumn:     r.FormValue("idColumn"),
+		DateFormat:   r.FormValue("dateFormat"),
+		NoHeader:     r.FormValue("noHeader") == "true",
+	}
+
+	if format == constants.StatementFormatCSV {
+		err = mapping.Validate()
+		if err != nil {
+			fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+			klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+			return
+		}
+	}
+
+	account, err := fmh.DB.GetBankAccountByID(accountId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if account.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.BankAccountBelongsToUser(account, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	transactions, err := statementimport.Parse(format, file, mapping)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusUnprocessableEntity)
+		klogger.ExitError(method, constants.GenericUnprocessableEntityErrLog, err)
+		return
+	}
+
+	for i := range transactions {
+		transactions[i].BankAccountID = account.ID
+		transactions[i].UserID = account.UserID
+
+		err = transactions[i].ValidateCanSaveBankAccountTransaction()
+		if err != nil {
+			err = fmt.Errorf("transaction %d is invalid: %v", i+1, err)
+			fmh.JSONUtil.ErrorJSON(w, err, http.StatusUnprocessableEntity)
+			klogger.ExitError(method, constants.GenericUnprocessableEntityErrLog, err)
+			return
+		}
+	}
+
+	res, err := fmh.Service.ImportBankAccountTransactions(account, transactions)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, res)
+}
@@ -0,0 +1,177 @@
+package fmhandler
+
+import (
+	"bytes"
+	"encoding/json"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"finance-manager-backend/test"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportBankStatement_400(t *testing.T) {
+	method := "bank_account_import_handler_test.TestImportBankStatement_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodPost, "/users/2/bank-accounts/a/import", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Requests must be a multipart form
+	writer = MakeRequest(http.MethodPost, "/users/2/bank-accounts/1/import", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestImportBankStatement_403(t *testing.T) {
+	method := "bank_account_import_handler_test.TestImportBankStatement_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodPost, "/users/1/bank-accounts/1/import", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestImportBankStatement_ofx(t *testing.T) {
+	method := "bank_account_import_handler_test.TestImportBankStatement_ofx"
+	klogger.Enter(method)
+
+	a := setupImportHandlerTestData()
+	token := test.GetUserJWT(t)
+	url := fmt.Sprintf("/users/2/bank-accounts/%d/import", a.ID)
+
+	writer := makeImportRequest(t, url, "checking.ofx", nil, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var resp models.StatementImportResponse
+	err := json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, resp.Imported)
+	assert.Equal(t, 0, resp.Duplicates)
+
+	//Categorization rules are applied to imported transactions
+	for _, tr := range resp.Transactions {
+		if tr.Payee == "ACME CORP PAYROLL" {
+			assert.Equal(t, "Paycheck", tr.Category)
+		}
+	}
+
+	var tarr []models.BankAccountTransaction
+	p.GormDB.Where("bank_account_id = ?", a.ID).Find(&tarr)
+	assert.Equal(t, 4, len(tarr))
+
+	//Importing the statement again skips the transactions already imported
+	writer = makeImportRequest(t, url, "checking.ofx", nil, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	err = json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, resp.Imported)
+	assert.Equal(t, 4, resp.Duplicates)
+
+	teardownImportHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestImportBankStatement_csv(t *testing.T) {
+	method := "bank_account_import_handler_test.TestImportBankStatement_csv"
+	klogger.Enter(method)
+
+	a := setupImportHandlerTestData()
+	token := test.GetUserJWT(t)
+	url := fmt.Sprintf("/users/2/bank-accounts/%d/import", a.ID)
+
+	fields := map[string]string{
+		"dateColumn":   "Posting Date",
+		"amountColumn": "Amount",
+		"payeeColumn":  "Description",
+	}
+
+	writer := makeImportRequest(t, url, "checking.csv", fields, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var resp models.StatementImportResponse
+	err := json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, resp.Imported)
+
+	//A csv mapping is required
+	writer = makeImportRequest(t, url, "checking.csv", nil, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	teardownImportHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestImportBankStatement_404(t *testing.T) {
+	method := "bank_account_import_handler_test.TestImportBankStatement_404"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := makeImportRequest(t, "/users/2/bank-accounts/9999/import", "checking.ofx", nil, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	klogger.Exit(method)
+}
+
+// Function makeImportRequest posts a statement fixture to url as a multipart form along with fields
+func makeImportRequest(t *testing.T, url string, fixture string, fields map[string]string, token string) *httptest.ResponseRecorder {
+	f, err := os.Open(filepath.Join("../../../../test/fixtures/statements", fixture))
+	if err != nil {
+		t.Fatalf("failed to open fixture %s: %v", fixture, err)
+	}
+
+	defer f.Close()
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+
+	fw, _ := mw.CreateFormFile("file", fixture)
+	io.Copy(fw, f)
+
+	for k, v := range fields {
+		mw.WriteField(k, v)
+	}
+
+	mw.Close()
+
+	request, _ := http.NewRequest(http.MethodPost, url, &body)
+	request.Header.Add("Content-Type", mw.FormDataContentType())
+	request.Header.Add("Authorization", "Bearer "+token)
+
+	writer := httptest.NewRecorder()
+	app.Routes().ServeHTTP(writer, request)
+	return writer
+}
+
+func setupImportHandlerTestData() models.BankAccount {
+	a := models.BankAccount{UserID: 2, Name: "TestImport", Type: constants.BankAccountTypeChecking, OpeningDt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), CreateDt: time.Now()}
+	p.GormDB.Create(&a)
+
+	p.GormDB.Create(&models.CategorizationRule{UserID: 2, Name: "Payroll", PayeePattern: "payroll", Category: "Paycheck", CreateDt: time.Now()})
+
+	return a
+}
+
+func teardownImportHandlerTestData() {
+	p.GormDB.Exec("DELETE FROM bank_account_transactions")
+	p.GormDB.Exec("DELETE FROM bank_accounts")
+	p.GormDB.Exec("DELETE FROM categorization_rules")
+}
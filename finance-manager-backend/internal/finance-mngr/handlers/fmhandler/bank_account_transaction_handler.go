@@ -0,0 +1,282 @@
+package fmhandler
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jon-kamis/klogger"
+)
+
+// GetAllBankAccountTransactions godoc
+// @title		Get All Bank Account Transactions
+// @version 	1.0.0
+// @Tags 		Bank Accounts
+// @Summary 	Get All Bank Account Transactions
+// @Description Returns the transaction ledger of a Bank Account in the order the transactions were made
+// @Param		userId path int true "User ID"
+// @Param		accountId path int true "ID of the Bank Account"
+// @Produce 	json
+// @Success 	200 {array} models.BankAccountTransaction
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/bank-accounts/{accountId}/transactions [get]
+func (fmh *FinanceManagerHandler) GetAllBankAccountTransactions(w http.ResponseWriter, r *http.Request) {
+	method := "bank_account_transaction_handler.GetAllBankAccountTransactions"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	accountId, err1 := strconv.Atoi(chi.URLParam(r, "accountId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	account, err := fmh.DB.GetBankAccountByID(accountId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if account.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.BankAccountBelongsToUser(account, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	transactions, err := fmh.DB.GetAllBankAccountTransactionsByBankAccountID(accountId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	account.LoadTransactions(transactions)
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, account.Transactions)
+}
+
+// SaveBankAccountTransaction godoc
+// @title		Insert Bank Account Transaction
+// @version 	1.0.0
+// @Tags 		Bank Accounts
+// @Summary 	Insert Bank Account Transaction
+// @Description Records a deposit to or withdrawal from a Bank Account. Amounts are always positive, and a category may be given by hand
+// @Description Transfers are recorded with a type of transfer-out or transfer-in and the other account as transferAccountId, and post to both accounts
+// @Param		userId path int true "User ID"
+// @Param		accountId path int true "ID of the Bank Account"
+// @Param		transaction body models.BankAccountTransaction true "The transaction to insert"
+// @Accept		json
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/bank-accounts/{accountId}/transactions [post]
+func (fmh *FinanceManagerHandler) SaveBankAccountTransaction(w http.ResponseWriter, r *http.Request) {
+	method := "bank_account_transaction_handler.SaveBankAccountTransaction"
+	klogger.Enter(method)
+
+	var payload models.BankAccountTransaction
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	accountId, err1 := strconv.Atoi(chi.URLParam(r, "accountId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	// Read in transaction from payload
+	err = fmh.JSONUtil.ReadJSON(w, r, &payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.FailedToParseJsonBodyError, err)
+		return
+	}
+
+	account, err := fmh.DB.GetBankAccountByID(accountId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if account.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.BankAccountBelongsToUser(account, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	payload.BankAccountID = accountId
+	payload.UserID = userId
+
+	err = payload.ValidateCanSaveBankAccountTransaction()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	if !payload.IsTransfer() {
+		_, err = fmh.DB.InsertBankAccountTransaction(payload)
+		if err != nil {
+			fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return
+		}
+
+		klogger.Exit(method)
+		fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "new bank account transaction was saved successfully")
+		return
+	}
+
+	// Transfers post to both accounts, so the other account must belong to the user as well
+	status, err := fmh.validateBankAccountLink(payload.TransferAccountID, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, status)
+		klogger.ExitError(method, err.Error())
+		return
+	}
+
+	out, in := payload.NewTransfer()
+
+	for _, t := range []models.BankAccountTransaction{out, in} {
+		_, err = fmh.DB.InsertBankAccountTransaction(t)
+		if err != nil {
+			fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return
+		}
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "new bank account transfer was saved successfully")
+}
+
+// DeleteBankAccountTransactionById godoc
+// @title		Delete Bank Account Transaction by ID
+// @version 	1.0.0
+// @Tags 		Bank Accounts
+// @Summary 	Delete Bank Account Transaction by ID
+// @Description Deletes a transaction from the ledger of a Bank Account belonging to a given user. Deleting either side of a transfer deletes both
+// @Param		userId path int true "User ID"
+// @Param		accountId path int true "ID of the Bank Account"
+// @Param		transactionId path int true "ID of the Bank Account Transaction"
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/bank-accounts/{accountId}/transactions/{transactionId} [delete]
+func (fmh *FinanceManagerHandler) DeleteBankAccountTransactionById(w http.ResponseWriter, r *http.Request) {
+	method := "bank_account_transaction_handler.DeleteBankAccountTransactionById"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	accountId, err1 := strconv.Atoi(chi.URLParam(r, "accountId"))
+	transactionId, err2 := strconv.Atoi(chi.URLParam(r, "transactionId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil || err2 != nil {
+		err = errors.New(constants.ProcessIdError)
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err)
+		return
+	}
+
+	t, err := fmh.DB.GetBankAccountTransactionByID(transactionId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if t.ID == 0 || t.BankAccountID != accountId {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.BankAccountTransactionBelongsToUser(t, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	// Delete the other side of a transfer along with it
+	if t.IsTransfer() {
+		others, err := fmh.DB.GetAllBankAccountTransactionsByBankAccountID(t.TransferAccountID)
+		if err != nil {
+			fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return
+		}
+
+		if pair := t.FindTransferPair(others); pair != nil {
+			err = fmh.DB.DeleteBankAccountTransactionByID(pair.ID)
+			if err != nil {
+				fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+				klogger.ExitError(method, constants.FailedToDeleteEntityError, err)
+				return
+			}
+		}
+	}
+
+	err = fmh.DB.DeleteBankAccountTransactionByID(transactionId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.FailedToDeleteEntityError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "Bank account transaction deleted successfully")
+}
@@ -0,0 +1,190 @@
+package fmhandler
+
+import (
+	"encoding/json"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"finance-manager-backend/test"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllBankAccountTransactions_400(t *testing.T) {
+	method := "bank_account_transaction_handler_test.TestGetAllBankAccountTransactions_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/bank-accounts/a/transactions", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetAllBankAccountTransactions_403(t *testing.T) {
+	method := "bank_account_transaction_handler_test.TestGetAllBankAccountTransactions_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/bank-accounts/1/transactions", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestDeleteBankAccountTransactionById_400(t *testing.T) {
+	method := "bank_account_transaction_handler_test.TestDeleteBankAccountTransactionById_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodDelete, "/users/2/bank-accounts/1/transactions/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestBankAccountTransaction_roundTrip(t *testing.T) {
+	method := "bank_account_transaction_handler_test.TestBankAccountTransaction_roundTrip"
+	klogger.Enter(method)
+
+	a, _ := setupBankAccountTransactionHandlerTestData()
+	token := test.GetUserJWT(t)
+	url := fmt.Sprintf("/users/2/bank-accounts/%d/transactions", a.ID)
+
+	tr := models.BankAccountTransaction{
+		Type:          constants.TransactionTypeWithdrawal,
+		Amount:        42.5,
+		TransactionDt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+		Description:   "groceries",
+	}
+
+	//Create
+	writer := MakeRequest(http.MethodPost, url, tr, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	//Get
+	writer = MakeRequest(http.MethodGet, url, nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var resp []models.BankAccountTransaction
+	err := json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp))
+	assert.Equal(t, a.ID, resp[0].BankAccountID)
+	assert.Equal(t, 2, resp[0].UserID)
+	assert.Equal(t, tr.Amount, resp[0].Amount)
+	assert.Equal(t, tr.Description, resp[0].Description)
+
+	//Delete
+	writer = MakeRequest(http.MethodDelete, fmt.Sprintf("%s/%d", url, resp[0].ID), nil, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	var count int64
+	p.GormDB.Model(&models.BankAccountTransaction{}).Where("bank_account_id = ?", a.ID).Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	teardownBankAccountTransactionHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestBankAccountTransaction_transfer(t *testing.T) {
+	method := "bank_account_transaction_handler_test.TestBankAccountTransaction_transfer"
+	klogger.Enter(method)
+
+	a, b := setupBankAccountTransactionHandlerTestData()
+	token := test.GetUserJWT(t)
+
+	tr := models.BankAccountTransaction{
+		Type:              constants.TransactionTypeTransferOut,
+		Amount:            100,
+		TransactionDt:     time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+		TransferAccountID: b.ID,
+	}
+
+	//Transfers post to both accounts
+	writer := MakeRequest(http.MethodPost, fmt.Sprintf("/users/2/bank-accounts/%d/transactions", a.ID), tr, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	var in models.BankAccountTransaction
+	p.GormDB.Where("bank_account_id = ?", b.ID).First(&in)
+	assert.Equal(t, constants.TransactionTypeTransferIn, in.Type)
+	assert.Equal(t, a.ID, in.TransferAccountID)
+
+	//Deleting one side deletes both
+	writer = MakeRequest(http.MethodDelete, fmt.Sprintf("/users/2/bank-accounts/%d/transactions/%d", b.ID, in.ID), nil, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	var count int64
+	p.GormDB.Model(&models.BankAccountTransaction{}).Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	teardownBankAccountTransactionHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestSaveBankAccountTransaction_400(t *testing.T) {
+	method := "bank_account_transaction_handler_test.TestSaveBankAccountTransaction_400"
+	klogger.Enter(method)
+
+	a, _ := setupBankAccountTransactionHandlerTestData()
+	token := test.GetUserJWT(t)
+	url := fmt.Sprintf("/users/2/bank-accounts/%d/transactions", a.ID)
+
+	//Malformed Object
+	writer := MakeRequest(http.MethodPost, url, "{Bad", true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Negative amount
+	tr := models.BankAccountTransaction{Type: constants.TransactionTypeDeposit, Amount: -5, TransactionDt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)}
+	writer = MakeRequest(http.MethodPost, url, tr, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Transfer to the same account
+	tr = models.BankAccountTransaction{Type: constants.TransactionTypeTransferOut, Amount: 5, TransactionDt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), TransferAccountID: a.ID}
+	writer = MakeRequest(http.MethodPost, url, tr, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	teardownBankAccountTransactionHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestBankAccountTransaction_404(t *testing.T) {
+	method := "bank_account_transaction_handler_test.TestBankAccountTransaction_404"
+	klogger.Enter(method)
+
+	a, _ := setupBankAccountTransactionHandlerTestData()
+	token := test.GetUserJWT(t)
+
+	//Account does not exist
+	tr := models.BankAccountTransaction{Type: constants.TransactionTypeDeposit, Amount: 5, TransactionDt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)}
+	writer := MakeRequest(http.MethodPost, "/users/2/bank-accounts/9999/transactions", tr, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	//Transaction does not exist
+	writer = MakeRequest(http.MethodDelete, fmt.Sprintf("/users/2/bank-accounts/%d/transactions/9999", a.ID), nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	teardownBankAccountTransactionHandlerTestData()
+	klogger.Exit(method)
+}
+
+func setupBankAccountTransactionHandlerTestData() (models.BankAccount, models.BankAccount) {
+	a := models.BankAccount{UserID: 2, Name: "TestChecking", Type: constants.BankAccountTypeChecking, OpeningDt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), CreateDt: time.Now()}
+	b := models.BankAccount{UserID: 2, Name: "TestSavings", Type: constants.BankAccountTypeSavings, OpeningDt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), CreateDt: time.Now()}
+
+	p.GormDB.Create(&a)
+	p.GormDB.Create(&b)
+	return a, b
+}
+
+func teardownBankAccountTransactionHandlerTestData() {
+	p.GormDB.Exec("DELETE FROM bank_account_transactions")
+	p.GormDB.Exec("DELETE FROM bank_accounts")
+}
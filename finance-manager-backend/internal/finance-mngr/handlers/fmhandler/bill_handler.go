@@ -110,6 +110,9 @@ func (fmh *FinanceManagerHandler) GetBillById(w http.ResponseWriter, r *http.Req
 // @Tags 		Bills
 // @Summary 	Insert Bill
 // @Description Inserts a new Bill into the Database for a given user
+// @Description Available frequencies are 'weekly', 'bi-weekly', 'monthly', 'quarterly' and 'annual'. Bills without a frequency are monthly
+// @Description A due date is required for bills that are not monthly and is used as the anchor date for their recurrence
+// @Description Bills with an accountId and a due date are paid from that bank account on each due date
 // @Param		userId path int true "User ID"
 // @Param		bill body models.Bill true "The bill to insert"
 // @Accept		json
@@ -152,6 +155,13 @@ func (fmh *FinanceManagerHandler) SaveBill(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	status, err := fmh.validateBankAccountLink(payload.AccountID, id)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, status)
+		klogger.ExitError(method, err.Error())
+		return
+	}
+
 	_, err = fmh.DB.InsertBill(payload)
 	if err != nil {
 		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
@@ -175,6 +185,7 @@ func (fmh *FinanceManagerHandler) SaveBill(w http.ResponseWriter, r *http.Reques
 // @Accept		json
 // @Produce 	json
 // @Success 	200 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
 // @Failure 	403 {object} jsonutils.JSONResponse
 // @Failure 	404 {object} jsonutils.JSONResponse
 // @Failure 	500 {object} jsonutils.JSONResponse
@@ -223,8 +234,22 @@ func (fmh *FinanceManagerHandler) UpdateBill(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	payload.UserID = userId
+
 	//Validate the Bill object
-	payload.ValidateCanSaveBill()
+	err = payload.ValidateCanSaveBill()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, "request is invalid: %v", err)
+		return
+	}
+
+	status, err := fmh.validateBankAccountLink(payload.AccountID, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, status)
+		klogger.ExitError(method, err.Error())
+		return
+	}
 
 	// Update the bill
 	err = fmh.DB.UpdateBill(payload)
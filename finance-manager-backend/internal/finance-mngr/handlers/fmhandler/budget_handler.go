@@ -0,0 +1,459 @@
+package fmhandler
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jon-kamis/klogger"
+)
+
+// GetAllUserBudgets godoc
+// @title		Get All User Budgets
+// @version 	1.0.0
+// @Tags 		Budgets
+// @Summary 	Get All User Budgets
+// @Description Returns an array of Budget objects belonging to a given user ordered by category
+// @Param		userId path int true "User ID"
+// @Produce 	json
+// @Success 	200 {array} models.Budget
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/budgets [get]
+func (fmh *FinanceManagerHandler) GetAllUserBudgets(w http.ResponseWriter, r *http.Request) {
+	method := "budget_handler.GetAllUserBudgets"
+	klogger.Enter(method)
+
+	//Read ID from url
+	id, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	budgets, err := fmh.DB.GetAllUserBudgets(id)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, budgets)
+}
+
+// GetUserBudgetStatuses godoc
+// @title		Get Budget Statuses
+// @version 	1.0.0
+// @Tags 		Budgets
+// @Summary 	Get Budget Statuses
+// @Description Compares each of a user's budgets to their planned and actual spending for a month
+// @Description Planned spending is the cost of the bill or loan a fixed budget is linked to. Actual spending is the net amount withdrawn in the budget's category, and is only reported when the user has bank account transactions during the month
+// @Description Budgets that roll over add the part of each month's limit that was not spent to the next month's available amount
+// @Param		userId path int true "User ID"
+// @Param		date query string false "A date in the month to compare in YYYY-MM-DD format. Default is today"
+// @Produce 	json
+// @Success 	200 {array} models.BudgetStatus
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/budgets/status [get]
+func (fmh *FinanceManagerHandler) GetUserBudgetStatuses(w http.ResponseWriter, r *http.Request) {
+	method := "budget_handler.GetUserBudgetStatuses"
+	klogger.Enter(method)
+
+	//Read ID from url
+	id, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	dateStr := r.URL.Query().Get("date")
+	date := fmUtil.GetStartOfDay(time.Now())
+
+	if dateStr != "" {
+		date, err = time.Parse(constants.DateParamFormat, dateStr)
+
+		if err != nil {
+			err = errors.New("date param must be a date in YYYY-MM-DD format")
+			fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+			klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+			return
+		}
+	}
+
+	statuses, err := fmh.Service.GetUserBudgetStatuses(id, date)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, statuses)
+}
+
+// GetBudgetById godoc
+// @title		Get Budget by ID
+// @version 	1.0.0
+// @Tags 		Budgets
+// @Summary 	Get Budget by ID
+// @Description Returns a Budget by its ID for a given user
+// @Param		userId path int true "User ID"
+// @Param		budgetId path int true "ID of the Budget"
+// @Produce 	json
+// @Success 	200 {object} models.Budget
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/budgets/{budgetId} [get]
+func (fmh *FinanceManagerHandler) GetBudgetById(w http.ResponseWriter, r *http.Request) {
+	method := "budget_handler.GetBudgetById"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	budgetId, err1 := strconv.Atoi(chi.URLParam(r, "budgetId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	budget, err := fmh.DB.GetBudgetByID(budgetId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if budget.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.BudgetBelongsToUser(budget, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, budget)
+}
+
+// SaveBudget godoc
+// @title		Insert Budget
+// @version 	1.0.0
+// @Tags 		Budgets
+// @Summary 	Insert Budget
+// @Description Inserts a new Budget into the Database for a given user. Users may have one budget per category
+// @Description Budgets linked to a bill or loan by source and sourceId are fixed. Their category defaults to the name of the bill or loan, and a limit of 0 defaults to its cost each month
+// @Description startDt is the first month of the budget and defaults to the current month
+// @Param		userId path int true "User ID"
+// @Param		budget body models.Budget true "The budget to insert"
+// @Accept		json
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/budgets [post]
+func (fmh *FinanceManagerHandler) SaveBudget(w http.ResponseWriter, r *http.Request) {
+	method := "budget_handler.SaveBudget"
+	klogger.Enter(method)
+
+	var payload models.Budget
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	// Read in budget from payload
+	err = fmh.JSONUtil.ReadJSON(w, r, &payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.FailedToParseJsonBodyError, err)
+		return
+	}
+
+	payload.UserID = userId
+
+	status, err := fmh.prepareBudget(&payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, status)
+		klogger.ExitError(method, err.Error())
+		return
+	}
+
+	_, err = fmh.DB.InsertBudget(payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "new budget was saved successfully")
+}
+
+// UpdateBudget godoc
+// @title		Update Budget
+// @version 	1.0.0
+// @Tags 		Budgets
+// @Summary 	Update Budget
+// @Description Updates an existing Budget for a user
+// @Param		userId path int true "User ID"
+// @Param		budgetId path int true "ID of the Budget to update"
+// @Param		budget body models.Budget true "The budget to update"
+// @Accept		json
+// @Produce 	json
+// @Success 	200 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/budgets/{budgetId} [put]
+func (fmh *FinanceManagerHandler) UpdateBudget(w http.ResponseWriter, r *http.Request) {
+	method := "budget_handler.UpdateBudget"
+	klogger.Enter(method)
+
+	var payload models.Budget
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	budgetId, err1 := strconv.Atoi(chi.URLParam(r, "budgetId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	// Read in budget from payload
+	err = fmh.JSONUtil.ReadJSON(w, r, &payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.FailedToParseJsonBodyError, err)
+		return
+	}
+
+	// Validate that the budget exists and belongs to the user
+	budget, err := fmh.DB.GetBudgetByID(budgetId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if budget.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.BudgetBelongsToUser(budget, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	payload.ID = budgetId
+	payload.UserID = userId
+
+	if payload.StartDt.IsZero() {
+		payload.StartDt = budget.StartDt
+	}
+
+	status, err := fmh.prepareBudget(&payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, status)
+		klogger.ExitError(method, err.Error())
+		return
+	}
+
+	err = fmh.DB.UpdateBudget(payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, "Budget updated successfully")
+}
+
+// DeleteBudgetById godoc
+// @title		Delete Budget by ID
+// @version 	1.0.0
+// @Tags 		Budgets
+// @Summary 	Delete Budget by ID
+// @Description Deletes a user's Budget by its ID
+// @Param		userId path int true "User ID"
+// @Param		budgetId path int true "ID of the Budget"
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/budgets/{budgetId} [delete]
+func (fmh *FinanceManagerHandler) DeleteBudgetById(w http.ResponseWriter, r *http.Request) {
+	method := "budget_handler.DeleteBudgetById"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	budgetId, err1 := strconv.Atoi(chi.URLParam(r, "budgetId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	budget, err := fmh.DB.GetBudgetByID(budgetId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if budget.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.BudgetBelongsToUser(budget, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	err = fmh.DB.DeleteBudgetByID(budgetId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.FailedToDeleteEntityError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "Budget deleted successfully")
+}
+
+// Function prepareBudget validates that the bill or loan a budget is linked to exists and belongs to the user, defaults the budget's
+// category and start date, and validates that the budget can be saved without duplicating the category of another of the user's budgets.
+// Returns the http status to respond with when it cannot
+func (fmh *FinanceManagerHandler) prepareBudget(b *models.Budget) (int, error) {
+	method := "budget_handler.prepareBudget"
+	klogger.Enter(method)
+
+	var err error
+	ownerId := 0
+	name := ""
+
+	switch b.Source {
+	case constants.BudgetSourceBill:
+		bill, err1 := fmh.DB.GetBillByID(b.SourceID)
+		ownerId, name, err = bill.UserID, bill.Name, err1
+	case constants.BudgetSourceLoan:
+		l, err1 := fmh.DB.GetLoanByID(b.SourceID)
+		ownerId, name, err = l.UserID, l.Name, err1
+	}
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return http.StatusInternalServerError, errors.New(constants.GenericServerError)
+	}
+
+	if b.Source != "" && b.SourceID > 0 {
+		if ownerId == 0 {
+			err = fmt.Errorf("linked %s does not exist", b.Source)
+			klogger.ExitError(method, err.Error())
+			return http.StatusBadRequest, err
+		}
+
+		if ownerId != b.UserID {
+			err = errors.New("forbidden")
+			klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+			return http.StatusForbidden, err
+		}
+	}
+
+	//Fixed budgets are named for their bill or loan unless they are given a category
+	b.Category = strings.ToLower(strings.TrimSpace(b.Category))
+	if b.Category == "" {
+		b.Category = strings.ToLower(strings.TrimSpace(name))
+	}
+
+	if b.StartDt.IsZero() {
+		b.StartDt = time.Now()
+	}
+
+	b.StartDt = fmUtil.GetMonthBeginDate(b.StartDt)
+
+	err = b.ValidateCanSaveBudget()
+	if err != nil {
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return http.StatusBadRequest, err
+	}
+
+	budgets, err := fmh.DB.GetAllUserBudgets(b.UserID)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return http.StatusInternalServerError, errors.New(constants.GenericServerError)
+	}
+
+	for _, e := range budgets {
+		if e.ID != b.ID && e.Category == b.Category {
+			err = fmt.Errorf("a budget already exists for category %s", b.Category)
+			klogger.ExitError(method, err.Error())
+			return http.StatusBadRequest, err
+		}
+	}
+
+	klogger.Exit(method)
+	return http.StatusOK, nil
+}
@@ -0,0 +1,272 @@
+package fmhandler
+
+import (
+	"encoding/json"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"finance-manager-backend/test"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllUserBudgets_403(t *testing.T) {
+	method := "budget_handler_test.TestGetAllUserBudgets_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/budgets", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetUserBudgetStatuses_400(t *testing.T) {
+	method := "budget_handler_test.TestGetUserBudgetStatuses_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/budgets/status?date=invalid", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetUserBudgetStatuses_403(t *testing.T) {
+	method := "budget_handler_test.TestGetUserBudgetStatuses_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/budgets/status", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetBudgetById_400(t *testing.T) {
+	method := "budget_handler_test.TestGetBudgetById_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/budgets/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetBudgetById_403(t *testing.T) {
+	method := "budget_handler_test.TestGetBudgetById_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/budgets/1", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestSaveBudget_403(t *testing.T) {
+	method := "budget_handler_test.TestSaveBudget_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodPost, "/users/1/budgets", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestUpdateBudget_400(t *testing.T) {
+	method := "budget_handler_test.TestUpdateBudget_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodPut, "/users/2/budgets/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestUpdateBudget_403(t *testing.T) {
+	method := "budget_handler_test.TestUpdateBudget_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodPut, "/users/1/budgets/1", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestDeleteBudgetById_400(t *testing.T) {
+	method := "budget_handler_test.TestDeleteBudgetById_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodDelete, "/users/2/budgets/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestDeleteBudgetById_403(t *testing.T) {
+	method := "budget_handler_test.TestDeleteBudgetById_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodDelete, "/users/1/budgets/1", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestBudget_roundTrip(t *testing.T) {
+	method := "budget_handler_test.TestBudget_roundTrip"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	b := models.Budget{
+		Category: " Groceries ",
+		Limit:    300,
+		Rollover: true,
+		StartDt:  time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	//Create
+	writer := MakeRequest(http.MethodPost, "/users/2/budgets", b, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	//Get
+	writer = MakeRequest(http.MethodGet, "/users/2/budgets", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var resp []models.Budget
+	err := json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp))
+	assert.Equal(t, "groceries", resp[0].Category)
+	assert.Equal(t, b.Limit, resp[0].Limit)
+	assert.True(t, resp[0].Rollover)
+	assert.True(t, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).Equal(resp[0].StartDt))
+
+	url := fmt.Sprintf("/users/2/budgets/%d", resp[0].ID)
+
+	writer = MakeRequest(http.MethodGet, url, nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	//Spending 100 in January and 250 in February rolls 200 and then 250 over into March
+	p.GormDB.Create(&models.BankAccountTransaction{BankAccountID: 1, UserID: 2, Type: constants.TransactionTypeWithdrawal, Amount: 100, Category: "groceries", TransactionDt: time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC)})
+	p.GormDB.Create(&models.BankAccountTransaction{BankAccountID: 1, UserID: 2, Type: constants.TransactionTypeWithdrawal, Amount: 250, Category: "groceries", TransactionDt: time.Date(2023, 2, 10, 0, 0, 0, 0, time.UTC)})
+
+	writer = MakeRequest(http.MethodGet, "/users/2/budgets/status?date=2023-03-15", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var statuses []models.BudgetStatus
+	err = json.Unmarshal(writer.Body.Bytes(), &statuses)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(statuses))
+	assert.Equal(t, 250.0, statuses[0].RolledOver)
+	assert.Equal(t, 550.0, statuses[0].Available)
+	assert.Nil(t, statuses[0].Actual)
+	assert.Equal(t, 550.0, statuses[0].Remaining)
+	assert.False(t, statuses[0].Overspent)
+
+	//February is compared against its own spending
+	writer = MakeRequest(http.MethodGet, "/users/2/budgets/status?date=2023-02-01", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	err = json.Unmarshal(writer.Body.Bytes(), &statuses)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(statuses))
+	assert.Equal(t, 200.0, statuses[0].RolledOver)
+	assert.Equal(t, 250.0, *statuses[0].Actual)
+	assert.Equal(t, 250.0, statuses[0].Remaining)
+
+	//Update
+	resp[0].Limit = 400
+	writer = MakeRequest(http.MethodPut, url, resp[0], true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	writer = MakeRequest(http.MethodGet, url, nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var budget models.Budget
+	err = json.Unmarshal(writer.Body.Bytes(), &budget)
+	assert.Nil(t, err)
+	assert.Equal(t, 400.0, budget.Limit)
+
+	//Delete
+	writer = MakeRequest(http.MethodDelete, url, nil, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	var count int64
+	p.GormDB.Model(&models.Budget{}).Where("user_id = ?", 2).Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	teardownBudgetHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestSaveBudget_400(t *testing.T) {
+	method := "budget_handler_test.TestSaveBudget_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	//Malformed Object
+	writer := MakeRequest(http.MethodPost, "/users/2/budgets", "{Bad", true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//No category
+	writer = MakeRequest(http.MethodPost, "/users/2/budgets", models.Budget{Limit: 300}, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Duplicate category
+	b := models.Budget{Category: "groceries", Limit: 300}
+	writer = MakeRequest(http.MethodPost, "/users/2/budgets", b, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	writer = MakeRequest(http.MethodPost, "/users/2/budgets", b, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	teardownBudgetHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestBudget_404(t *testing.T) {
+	method := "budget_handler_test.TestBudget_404"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/budgets/9999", nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	writer = MakeRequest(http.MethodPut, "/users/2/budgets/9999", models.Budget{Category: "groceries", Limit: 300}, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	writer = MakeRequest(http.MethodDelete, "/users/2/budgets/9999", nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func teardownBudgetHandlerTestData() {
+	p.GormDB.Exec("DELETE FROM bank_account_transactions")
+	p.GormDB.Exec("DELETE FROM budgets")
+}
@@ -0,0 +1,106 @@
+package fmhandler
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jon-kamis/klogger"
+)
+
+// GetUserCalendar godoc
+// @title		Get User Calendar
+// @version 	1.0.0
+// @Tags 		Calendar
+// @Summary 	Get User Calendar
+// @Description Returns every income payday, bill due date, loan payment and credit card minimum payment expected between two dates
+// @Description Each event includes the projected balance after it occurs. Credit cards and bills without a due date are due monthly on the day they were created
+// @Description Loans are due monthly on the day of their most recent payment. Loan and credit card payments are projected from their current balances
+// @Param		userId path int true "User ID"
+// @Param		from query string false "First date of the calendar in YYYY-MM-DD format. Default is today"
+// @Param		to query string false "Last date of the calendar in YYYY-MM-DD format. Default is one month after from"
+// @Param		balance query number false "The balance to project events from. Default is 0"
+// @Produce 	json
+// @Success 	200 {object} models.Calendar
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/calendar [get]
+func (fmh *FinanceManagerHandler) GetUserCalendar(w http.ResponseWriter, r *http.Request) {
+	method := "calendar_handler.GetUserCalendar"
+	klogger.Enter(method)
+
+	//Read ID from url
+	id, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	fromStr := r.URL.Query().Get("from")
+	toStr := r.URL.Query().Get("to")
+	balanceStr := r.URL.Query().Get("balance")
+
+	cal := models.Calendar{
+		From: fmUtil.GetStartOfDay(time.Now()),
+	}
+
+	if fromStr != "" {
+		cal.From, err = time.Parse(constants.DateParamFormat, fromStr)
+
+		if err != nil {
+			err = errors.New("from param must be a date in YYYY-MM-DD format")
+			fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+			klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+			return
+		}
+	}
+
+	if toStr != "" {
+		cal.To, err = time.Parse(constants.DateParamFormat, toStr)
+
+		if err != nil {
+			err = errors.New("to param must be a date in YYYY-MM-DD format")
+			fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+			klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+			return
+		}
+	} else {
+		cal.To = fmUtil.AddMonths(cal.From, constants.CalendarDefaultMonths)
+	}
+
+	if balanceStr != "" {
+		cal.StartingBalance, err = strconv.ParseFloat(balanceStr, 64)
+
+		if err != nil {
+			err = errors.New("balance param must be a number")
+			fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+			klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+			return
+		}
+	}
+
+	err = cal.ValidateCanPerformCalc()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	cal, err = fmh.Service.GetUserCalendar(id, cal, time.Now())
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, "failed to build user calendar:\n%v", err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, cal)
+}
@@ -0,0 +1,50 @@
+package fmhandler
+
+import (
+	"finance-manager-backend/test"
+	"net/http"
+	"testing"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUserCalendar_400(t *testing.T) {
+	method := "calendar_handler_test.TestGetUserCalendar_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	//Dates must be formatted correctly
+	writer := MakeRequest(http.MethodGet, "/users/2/calendar?from=01-01-2024", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	writer = MakeRequest(http.MethodGet, "/users/2/calendar?to=invalid", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Balance must be a number
+	writer = MakeRequest(http.MethodGet, "/users/2/calendar?balance=invalid", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//To cannot be before from
+	writer = MakeRequest(http.MethodGet, "/users/2/calendar?from=2024-02-01&to=2024-01-01", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Range cannot exceed the maximum number of days
+	writer = MakeRequest(http.MethodGet, "/users/2/calendar?from=2024-01-01&to=2026-01-01", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetUserCalendar_403(t *testing.T) {
+	method := "calendar_handler_test.TestGetUserCalendar_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/calendar", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
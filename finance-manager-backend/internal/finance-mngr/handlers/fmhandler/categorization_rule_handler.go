@@ -0,0 +1,423 @@
+package fmhandler
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jon-kamis/klogger"
+)
+
+// GetAllUserCategorizationRules godoc
+// @title		Get All User Categorization Rules
+// @version 	1.0.0
+// @Tags 		Categorization Rules
+// @Summary 	Get All User Categorization Rules
+// @Description Returns an array of Categorization Rule objects belonging to a given user in the order they are applied
+// @Param		userId path int true "User ID"
+// @Produce 	json
+// @Success 	200 {array} models.CategorizationRule
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/categorization-rules [get]
+func (fmh *FinanceManagerHandler) GetAllUserCategorizationRules(w http.ResponseWriter, r *http.Request) {
+	method := "categorization_rule_handler.GetAllUserCategorizationRules"
+	klogger.Enter(method)
+
+	//Read ID from url
+	id, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	rules, err := fmh.DB.GetAllUserCategorizationRules(id)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, rules)
+}
+
+// GetCategorizationRuleById godoc
+// @title		Get Categorization Rule by ID
+// @version 	1.0.0
+// @Tags 		Categorization Rules
+// @Summary 	Get Categorization Rule by ID
+// @Description Returns a Categorization Rule by its ID for a given user
+// @Param		userId path int true "User ID"
+// @Param		ruleId path int true "ID of the Categorization Rule"
+// @Produce 	json
+// @Success 	200 {object} models.CategorizationRule
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/categorization-rules/{ruleId} [get]
+func (fmh *FinanceManagerHandler) GetCategorizationRuleById(w http.ResponseWriter, r *http.Request) {
+	method := "categorization_rule_handler.GetCategorizationRuleById"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	ruleId, err1 := strconv.Atoi(chi.URLParam(r, "ruleId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	rule, err := fmh.DB.GetCategorizationRuleByID(ruleId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if rule.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.CategorizationRuleBelongsToUser(rule, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, rule)
+}
+
+// SaveCategorizationRule godoc
+// @title		Insert Categorization Rule
+// @version 	1.0.0
+// @Tags 		Categorization Rules
+// @Summary 	Insert Categorization Rule
+// @Description Inserts a new Categorization Rule into the Database for a given user
+// @Description Rules match transactions whose payee matches the payeePattern regular expression without regard to case, whose amount is between minAmount and maxAmount and that were made in accountId. Criteria that are not set match every transaction
+// @Description Matched transactions are given the rule's category and linked to the income, bill or credit-card named by source and sourceId when they are set and the transaction is not already linked. Only the matching rule with the lowest priority is applied
+// @Param		userId path int true "User ID"
+// @Param		rule body models.CategorizationRule true "The categorization rule to insert"
+// @Accept		json
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/categorization-rules [post]
+func (fmh *FinanceManagerHandler) SaveCategorizationRule(w http.ResponseWriter, r *http.Request) {
+	method := "categorization_rule_handler.SaveCategorizationRule"
+	klogger.Enter(method)
+
+	var payload models.CategorizationRule
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	// Read in rule from payload
+	err = fmh.JSONUtil.ReadJSON(w, r, &payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.FailedToParseJsonBodyError, err)
+		return
+	}
+
+	payload.UserID = userId
+
+	err = payload.ValidateCanSaveCategorizationRule()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	status, err := fmh.validateCategorizationRuleLinks(payload, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, status)
+		klogger.ExitError(method, err.Error())
+		return
+	}
+
+	_, err = fmh.DB.InsertCategorizationRule(payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "new categorization rule was saved successfully")
+}
+
+// UpdateCategorizationRule godoc
+// @title		Update Categorization Rule
+// @version 	1.0.0
+// @Tags 		Categorization Rules
+// @Summary 	Update Categorization Rule
+// @Description Updates an existing Categorization Rule for a user. Transactions it has already categorized are not changed until the rules are applied again
+// @Param		userId path int true "User ID"
+// @Param		ruleId path int true "ID of the Categorization Rule to update"
+// @Param		rule body models.CategorizationRule true "The categorization rule to update"
+// @Accept		json
+// @Produce 	json
+// @Success 	200 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/categorization-rules/{ruleId} [put]
+func (fmh *FinanceManagerHandler) UpdateCategorizationRule(w http.ResponseWriter, r *http.Request) {
+	method := "categorization_rule_handler.UpdateCategorizationRule"
+	klogger.Enter(method)
+
+	var payload models.CategorizationRule
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	ruleId, err1 := strconv.Atoi(chi.URLParam(r, "ruleId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	// Read in rule from payload
+	err = fmh.JSONUtil.ReadJSON(w, r, &payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.FailedToParseJsonBodyError, err)
+		return
+	}
+
+	// Validate that the rule exists and belongs to the user
+	rule, err := fmh.DB.GetCategorizationRuleByID(ruleId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if rule.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.CategorizationRuleBelongsToUser(rule, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	payload.ID = ruleId
+	payload.UserID = userId
+
+	err = payload.ValidateCanSaveCategorizationRule()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	status, err := fmh.validateCategorizationRuleLinks(payload, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, status)
+		klogger.ExitError(method, err.Error())
+		return
+	}
+
+	err = fmh.DB.UpdateCategorizationRule(payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, "Categorization rule updated successfully")
+}
+
+// DeleteCategorizationRuleById godoc
+// @title		Delete Categorization Rule by ID
+// @version 	1.0.0
+// @Tags 		Categorization Rules
+// @Summary 	Delete Categorization Rule by ID
+// @Description Deletes a user's Categorization Rule by its ID. Transactions it has categorized keep their category
+// @Param		userId path int true "User ID"
+// @Param		ruleId path int true "ID of the Categorization Rule"
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/categorization-rules/{ruleId} [delete]
+func (fmh *FinanceManagerHandler) DeleteCategorizationRuleById(w http.ResponseWriter, r *http.Request) {
+	method := "categorization_rule_handler.DeleteCategorizationRuleById"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	ruleId, err1 := strconv.Atoi(chi.URLParam(r, "ruleId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	rule, err := fmh.DB.GetCategorizationRuleByID(ruleId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if rule.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.CategorizationRuleBelongsToUser(rule, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	err = fmh.DB.DeleteCategorizationRuleByID(ruleId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.FailedToDeleteEntityError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "Categorization rule deleted successfully")
+}
+
+// ApplyCategorizationRules godoc
+// @title		Apply Categorization Rules
+// @version 	1.0.0
+// @Tags 		Categorization Rules
+// @Summary 	Apply Categorization Rules
+// @Description Re-runs a user's Categorization Rules against all of their bank account transactions. Rules are also applied to transactions as they are imported
+// @Param		userId path int true "User ID"
+// @Produce 	json
+// @Success 	200 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/categorization-rules/apply [post]
+func (fmh *FinanceManagerHandler) ApplyCategorizationRules(w http.ResponseWriter, r *http.Request) {
+	method := "categorization_rule_handler.ApplyCategorizationRules"
+	klogger.Enter(method)
+
+	//Read ID from url
+	id, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	updated, err := fmh.Service.ApplyCategorizationRules(id)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, fmt.Sprintf("%d transactions were categorized", updated))
+}
+
+// Function validateCategorizationRuleLinks validates that the bank account a rule matches on and the income, bill or credit card it links
+// transactions to exist and belong to the user. Returns the http status to respond with when they do not
+func (fmh *FinanceManagerHandler) validateCategorizationRuleLinks(rule models.CategorizationRule, userId int) (int, error) {
+	method := "categorization_rule_handler.validateCategorizationRuleLinks"
+	klogger.Enter(method)
+
+	status, err := fmh.validateBankAccountLink(rule.AccountID, userId)
+	if err != nil {
+		klogger.ExitError(method, err.Error())
+		return status, err
+	}
+
+	ownerId := 0
+
+	switch rule.Source {
+	case constants.TransactionSourceIncome:
+		i, err1 := fmh.DB.GetIncomeByID(rule.SourceID)
+		ownerId, err = i.UserID, err1
+	case constants.TransactionSourceBill:
+		b, err1 := fmh.DB.GetBillByID(rule.SourceID)
+		ownerId, err = b.UserID, err1
+	case constants.TransactionSourceCreditCard:
+		cc, err1 := fmh.DB.GetCreditCardByID(rule.SourceID)
+		ownerId, err = cc.UserID, err1
+	default:
+		klogger.Exit(method)
+		return http.StatusOK, nil
+	}
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return http.StatusInternalServerError, errors.New(constants.GenericServerError)
+	}
+
+	if ownerId == 0 {
+		err = fmt.Errorf("linked %s does not exist", rule.Source)
+		klogger.ExitError(method, err.Error())
+		return http.StatusBadRequest, err
+	}
+
+	if ownerId != userId {
+		err = errors.New("forbidden")
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return http.StatusForbidden, err
+	}
+
+	klogger.Exit(method)
+	return http.StatusOK, nil
+}
@@ -0,0 +1,250 @@
+package fmhandler
+
+import (
+	"encoding/json"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"finance-manager-backend/test"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllUserCategorizationRules_403(t *testing.T) {
+	method := "categorization_rule_handler_test.TestGetAllUserCategorizationRules_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/categorization-rules", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetCategorizationRuleById_400(t *testing.T) {
+	method := "categorization_rule_handler_test.TestGetCategorizationRuleById_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/categorization-rules/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetCategorizationRuleById_403(t *testing.T) {
+	method := "categorization_rule_handler_test.TestGetCategorizationRuleById_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/categorization-rules/1", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestSaveCategorizationRule_403(t *testing.T) {
+	method := "categorization_rule_handler_test.TestSaveCategorizationRule_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodPost, "/users/1/categorization-rules", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestUpdateCategorizationRule_400(t *testing.T) {
+	method := "categorization_rule_handler_test.TestUpdateCategorizationRule_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodPut, "/users/2/categorization-rules/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestUpdateCategorizationRule_403(t *testing.T) {
+	method := "categorization_rule_handler_test.TestUpdateCategorizationRule_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodPut, "/users/1/categorization-rules/1", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestDeleteCategorizationRuleById_400(t *testing.T) {
+	method := "categorization_rule_handler_test.TestDeleteCategorizationRuleById_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodDelete, "/users/2/categorization-rules/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestDeleteCategorizationRuleById_403(t *testing.T) {
+	method := "categorization_rule_handler_test.TestDeleteCategorizationRuleById_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodDelete, "/users/1/categorization-rules/1", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestApplyCategorizationRules_403(t *testing.T) {
+	method := "categorization_rule_handler_test.TestApplyCategorizationRules_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodPost, "/users/1/categorization-rules/apply", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestCategorizationRule_roundTrip(t *testing.T) {
+	method := "categorization_rule_handler_test.TestCategorizationRule_roundTrip"
+	klogger.Enter(method)
+
+	setupCategorizationRuleHandlerTestData()
+	token := test.GetUserJWT(t)
+
+	rule := models.CategorizationRule{
+		Name:         "Groceries",
+		PayeePattern: "whole foods",
+		Category:     "groceries",
+	}
+
+	//Create
+	writer := MakeRequest(http.MethodPost, "/users/2/categorization-rules", rule, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	//Get
+	writer = MakeRequest(http.MethodGet, "/users/2/categorization-rules", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var resp []models.CategorizationRule
+	err := json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp))
+	assert.Equal(t, 2, resp[0].UserID)
+	assert.Equal(t, rule.PayeePattern, resp[0].PayeePattern)
+	assert.Equal(t, rule.Category, resp[0].Category)
+
+	url := fmt.Sprintf("/users/2/categorization-rules/%d", resp[0].ID)
+
+	//Re-running the rules categorizes the matching transaction
+	writer = MakeRequest(http.MethodPost, "/users/2/categorization-rules/apply", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+	assert.Contains(t, writer.Body.String(), "1 transactions were categorized")
+
+	writer = MakeRequest(http.MethodGet, "/users/2/summary/categories?from=2023-01-01&to=2023-01-31", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var totals []models.MonthlyCategoryTotals
+	err = json.Unmarshal(writer.Body.Bytes(), &totals)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(totals))
+	assert.Equal(t, 2, len(totals[0].Totals))
+	assert.Equal(t, "groceries", totals[0].Totals[0].Category)
+	assert.Equal(t, 80.0, totals[0].Totals[0].Spent)
+	assert.Equal(t, constants.UncategorizedCategory, totals[0].Totals[1].Category)
+	assert.Equal(t, 40.0, totals[0].Totals[1].Spent)
+
+	//Rules that change nothing do not update any transactions
+	writer = MakeRequest(http.MethodPost, "/users/2/categorization-rules/apply", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+	assert.Contains(t, writer.Body.String(), "0 transactions were categorized")
+
+	//Update
+	resp[0].PayeePattern = "shell"
+	resp[0].Category = "fuel"
+	writer = MakeRequest(http.MethodPut, url, resp[0], true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	writer = MakeRequest(http.MethodPost, "/users/2/categorization-rules/apply", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	writer = MakeRequest(http.MethodGet, "/users/2/summary/categories?from=2023-01-01&to=2023-01-31", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	err = json.Unmarshal(writer.Body.Bytes(), &totals)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(totals[0].Totals))
+	assert.Equal(t, "fuel", totals[0].Totals[0].Category)
+	assert.Equal(t, "groceries", totals[0].Totals[1].Category)
+
+	//Delete
+	writer = MakeRequest(http.MethodDelete, url, nil, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	var count int64
+	p.GormDB.Model(&models.CategorizationRule{}).Where("user_id = ?", 2).Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	teardownCategorizationRuleHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestSaveCategorizationRule_400(t *testing.T) {
+	method := "categorization_rule_handler_test.TestSaveCategorizationRule_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	//Malformed Object
+	writer := MakeRequest(http.MethodPost, "/users/2/categorization-rules", "{Bad", true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Invalid payee pattern
+	rule := models.CategorizationRule{Name: "Bad", PayeePattern: "(", Category: "groceries"}
+	writer = MakeRequest(http.MethodPost, "/users/2/categorization-rules", rule, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestCategorizationRule_404(t *testing.T) {
+	method := "categorization_rule_handler_test.TestCategorizationRule_404"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/categorization-rules/9999", nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	writer = MakeRequest(http.MethodDelete, "/users/2/categorization-rules/9999", nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func setupCategorizationRuleHandlerTestData() {
+	p.GormDB.Create(&models.BankAccountTransaction{BankAccountID: 1, UserID: 2, Type: constants.TransactionTypeWithdrawal, Amount: 80, Payee: "WHOLE FOODS #12", TransactionDt: time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC)})
+	p.GormDB.Create(&models.BankAccountTransaction{BankAccountID: 1, UserID: 2, Type: constants.TransactionTypeWithdrawal, Amount: 40, Payee: "SHELL OIL", TransactionDt: time.Date(2023, 1, 12, 0, 0, 0, 0, time.UTC)})
+}
+
+func teardownCategorizationRuleHandlerTestData() {
+	p.GormDB.Exec("DELETE FROM bank_account_transactions")
+	p.GormDB.Exec("DELETE FROM categorization_rules")
+}
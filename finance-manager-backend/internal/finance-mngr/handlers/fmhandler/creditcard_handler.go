@@ -319,3 +319,90 @@ func (fmh *FinanceManagerHandler) UpdateCreditCard(w http.ResponseWriter, r *htt
 	klogger.Exit(method)
 	fmh.JSONUtil.WriteJSON(w, http.StatusOK, constants.SuccessMessage)
 }
+
+// GetCreditCardProjection godoc
+// @title		Get Credit Card Payoff Projection
+// @version 	1.0.0
+// @Tags 		Credit Cards
+// @Summary 	Get Credit Card Payoff Projection
+// @Description Projects paying off a Credit Card with only minimum payments and optionally with a fixed monthly payment
+// @Description Interest is accrued monthly from the card's APR and the minimum payment is recalculated as the balance falls
+// @Param		userId path int true "User ID"
+// @Param		ccId path int true "ID of the Credit Card"
+// @Param		payment query number false "A fixed monthly payment to compare against paying only the minimum"
+// @Produce 	json
+// @Success 	200 {object} models.CreditCardProjection
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	422 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/credit-cards/{ccId}/projection [get]
+func (fmh *FinanceManagerHandler) GetCreditCardProjection(w http.ResponseWriter, r *http.Request) {
+	method := "creditcard_handler.GetCreditCardProjection"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	ccId, err1 := strconv.Atoi(chi.URLParam(r, "ccId"))
+
+	if err != nil {
+		klogger.ExitError(method, constants.ProcessUserIdError, err)
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		return
+	}
+
+	if err1 != nil {
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		return
+	}
+
+	var payment float64
+	paymentStr := r.URL.Query().Get("payment")
+
+	if paymentStr != "" {
+		payment, err = strconv.ParseFloat(paymentStr, 64)
+
+		if err != nil || payment < 0 {
+			err = errors.New("payment must be a positive number")
+			fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+			klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+			return
+		}
+	}
+
+	cc, err := fmh.DB.GetCreditCardByID(ccId)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if cc.ID == 0 {
+		err = errors.New(constants.EntityNotFoundError)
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError, err)
+		return
+	}
+
+	err = fmh.Validator.CreditCardBelongsToUser(cc, userId)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.UserForbiddenToViewOtherUserDataError, err)
+		return
+	}
+
+	p, err := cc.CalcProjection(payment)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusUnprocessableEntity)
+		klogger.ExitError(method, constants.GenericUnprocessableEntityErrLog, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, p)
+}
@@ -1,6 +1,7 @@
 package fmhandler
 
 import (
+	"encoding/json"
 	"errors"
 	"finance-manager-backend/internal/finance-mngr/models"
 	"finance-manager-backend/test"
@@ -408,3 +409,71 @@ func TestUpdateCreditCard_404(t *testing.T) {
 
 	klogger.Exit(method)
 }
+
+func TestGetCreditCardProjection_200(t *testing.T) {
+	method := "creditcard_handler_test.TestGetCreditCardProjection_200"
+	klogger.Enter(method)
+
+	setup()
+	token := test.GetUserJWT(t)
+	var resp models.CreditCardProjection
+
+	writer := MakeRequest(http.MethodGet, "/users/2/credit-cards/3/projection?payment=100", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	err := json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Greater(t, resp.MinimumPayment.Months, 0)
+	assert.NotNil(t, resp.FixedPayment)
+
+	tearDown()
+	klogger.Exit(method)
+}
+
+func TestGetCreditCardProjection_400(t *testing.T) {
+	method := "creditcard_handler_test.TestGetCreditCardProjection_400"
+	klogger.Enter(method)
+
+	setup()
+	token := test.GetUserJWT(t)
+
+	//Invalid ID
+	writer := MakeRequest(http.MethodGet, "/users/2/credit-cards/a/projection", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Invalid payment
+	writer = MakeRequest(http.MethodGet, "/users/2/credit-cards/3/projection?payment=a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	tearDown()
+	klogger.Exit(method)
+}
+
+func TestGetCreditCardProjection_403(t *testing.T) {
+	method := "creditcard_handler_test.TestGetCreditCardProjection_403"
+	klogger.Enter(method)
+
+	setup()
+	token := test.GetUserJWT(t)
+
+	//Credit Card belongs to other user
+	writer := MakeRequest(http.MethodGet, "/users/2/credit-cards/1/projection", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	tearDown()
+	klogger.Exit(method)
+}
+
+func TestGetCreditCardProjection_404(t *testing.T) {
+	method := "creditcard_handler_test.TestGetCreditCardProjection_404"
+	klogger.Enter(method)
+
+	setup()
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/credit-cards/4/projection", nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	tearDown()
+	klogger.Exit(method)
+}
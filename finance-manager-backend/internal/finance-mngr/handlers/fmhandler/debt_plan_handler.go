@@ -0,0 +1,116 @@
+package fmhandler
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jon-kamis/klogger"
+)
+
+// GetDebtPlan godoc
+// @title		Get Debt Payoff Plan
+// @version 	1.0.0
+// @Tags 		Debt Plan
+// @Summary 	Get Debt Payoff Plan
+// @Description Simulates paying off all of a user's loans and credit cards with a fixed monthly budget
+// @Description Payments freed up by paid off debts are rolled into the next debt chosen by the strategy
+// @Param		userId path int true "User ID"
+// @Param		strategy query string false "The payoff strategy. Available values are 'avalanche' and 'snowball'. Default is 'avalanche'"
+// @Param		budget query number true "The total monthly amount available for debt payments"
+// @Produce 	json
+// @Success 	200 {object} models.DebtPlan
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	422 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/debt-plan [get]
+func (fmh *FinanceManagerHandler) GetDebtPlan(w http.ResponseWriter, r *http.Request) {
+	method := "debt_plan_handler.GetDebtPlan"
+	klogger.Enter(method)
+
+	//Read ID from url
+	id, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	strategy := r.URL.Query().Get("strategy")
+	budgetStr := r.URL.Query().Get("budget")
+
+	if strategy == "" {
+		strategy = constants.DebtPlanStrategyAvalanche
+	}
+
+	budget, err := strconv.ParseFloat(budgetStr, 64)
+
+	if err != nil {
+		err = errors.New("budget param is required and must be a number")
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	plan := models.DebtPlan{
+		Strategy: strategy,
+		Budget:   budget,
+	}
+
+	err = plan.ValidateCanPerformCalc()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	loans, err := fmh.DB.GetAllUserLoans(id, "")
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, "failed to retrieve user loans:\n%v", err)
+		return
+	}
+
+	payments, err := fmh.DB.GetAllUserLoanPayments(id)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, "failed to retrieve user loan payments:\n%v", err)
+		return
+	}
+
+	for _, l := range loans {
+		l.LoadPayments(payments)
+	}
+
+	ccs, err := fmh.DB.GetAllUserCreditCards(id, "")
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, "failed to retrieve user credit cards:\n%v", err)
+		return
+	}
+
+	err = plan.LoadLoans(loans)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusUnprocessableEntity)
+		klogger.ExitError(method, constants.GenericUnprocessableEntityErrLog, err)
+		return
+	}
+
+	plan.LoadCreditCards(ccs)
+
+	err = plan.Calculate(time.Now())
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusUnprocessableEntity)
+		klogger.ExitError(method, constants.GenericUnprocessableEntityErrLog, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, plan)
+}
@@ -0,0 +1,39 @@
+package fmhandler
+
+import (
+	"finance-manager-backend/test"
+	"net/http"
+	"testing"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDebtPlan_400(t *testing.T) {
+	method := "debt_plan_handler_test.TestGetDebtPlan_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	//Budget is required
+	writer := MakeRequest(http.MethodGet, "/users/2/debt-plan", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Strategy must be valid
+	writer = MakeRequest(http.MethodGet, "/users/2/debt-plan?budget=500&strategy=invalid", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetDebtPlan_403(t *testing.T) {
+	method := "debt_plan_handler_test.TestGetDebtPlan_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/debt-plan?budget=500", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
@@ -18,6 +18,7 @@ import (
 // @Tags 		Incomes
 // @Summary 	Get All User Incomes
 // @Description Returns an array of Income objects belonging to a given user
+// @Description Each income includes a paystub breaking a single paycheck down from gross pay to net pay
 // @Param		userId path int true "User ID"
 // @Param		search query string false "Search for incomes by name"
 // @Produce 	json
@@ -48,10 +49,33 @@ func (fmh *FinanceManagerHandler) GetAllUserIncomes(w http.ResponseWriter, r *ht
 		return
 	}
 
+	versions, err := fmh.DB.GetAllUserIncomeVersions(id)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New("unexpected error occured when fetching incomes"), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	hours, err := fmh.DB.GetAllUserIncomeHours(id)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New("unexpected error occured when fetching incomes"), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
 	for _, i := range incomes {
+		i.LoadVersions(versions)
+		i.LoadLoggedHours(hours)
 		i.PopulateEmptyValues(time.Now())
 	}
 
+	err = fmh.Service.CalcUserIncomeTaxes(incomes)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusUnprocessableEntity)
+		klogger.ExitError(method, constants.GenericUnprocessableEntityErrLog, err)
+		return
+	}
+
 	klogger.Exit(method)
 	fmh.JSONUtil.WriteJSON(w, http.StatusOK, incomes)
 }
@@ -62,6 +86,7 @@ func (fmh *FinanceManagerHandler) GetAllUserIncomes(w http.ResponseWriter, r *ht
 // @Tags 		Incomes
 // @Summary 	Get Income by ID
 // @Description Returns an Income object belonging to a given user
+// @Description The income includes a paystub breaking a single paycheck down from gross pay to net pay
 // @Param		userId path int true "User ID"
 // @Param		incomeId path int true "the ID of the Income"
 // @Produce 	json
@@ -111,13 +136,53 @@ func (fmh *FinanceManagerHandler) GetIncomeById(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	err = income.PopulateEmptyValues(time.Now())
+	//Taxes are calculated on all of the user's incomes together
+	incomes, err := fmh.DB.GetAllUserIncomes(userId, "")
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.UnexpectedSQLError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	versions, err := fmh.DB.GetAllUserIncomeVersions(userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.UnexpectedSQLError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	hours, err := fmh.DB.GetAllUserIncomeHours(userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.UnexpectedSQLError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	for _, i := range incomes {
+		i.LoadVersions(versions)
+		i.LoadLoggedHours(hours)
+
+		err = i.PopulateEmptyValues(time.Now())
+		if err != nil {
+			fmh.JSONUtil.ErrorJSON(w, err, http.StatusUnprocessableEntity)
+			klogger.ExitError(method, constants.GenericUnprocessableEntityErrLog, err)
+			return
+		}
+	}
+
+	err = fmh.Service.CalcUserIncomeTaxes(incomes)
 	if err != nil {
 		fmh.JSONUtil.ErrorJSON(w, err, http.StatusUnprocessableEntity)
 		klogger.ExitError(method, constants.GenericUnprocessableEntityErrLog, err)
 		return
 	}
 
+	for _, i := range incomes {
+		if i.ID == incomeId {
+			income = *i
+		}
+	}
+
 	klogger.Exit(method)
 	fmh.JSONUtil.WriteJSON(w, http.StatusOK, income)
 }
@@ -128,6 +193,11 @@ func (fmh *FinanceManagerHandler) GetIncomeById(w http.ResponseWriter, r *http.R
 // @Tags 		Incomes
 // @Summary 	Insert Income
 // @Description Inserts a new Income into the Database for a given user
+// @Description Available frequencies are 'weekly', 'bi-weekly', 'semi-monthly', 'monthly', 'quarterly' and 'annual'. Semi-monthly incomes are paid on the 15th and last day of each month
+// @Description Paydays that fall on a weekend are paid the Friday before
+// @Description Incomes with an end date are not paid after it. Use income versions to record changes to the pay rate
+// @Description Hourly incomes pay hours past regularHoursCap in a paycheck at overtimeMultiplier times the rate (1.5 if not set). Use income hours to log the hours actually worked
+// @Description Incomes with an accountId deposit their net pay to that bank account on each payday
 // @Param		userId path int true "User ID"
 // @Param		income body models.Income true "The income to insert"
 // @Accept		json
@@ -171,6 +241,21 @@ func (fmh *FinanceManagerHandler) SaveIncome(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	status, err := fmh.validateBankAccountLink(payload.AccountID, id)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, status)
+		klogger.ExitError(method, err.Error())
+		return
+	}
+
+	//Incomes cannot be saved with a state the tax engine does not support
+	err = fmh.Service.CalcIncomeTaxes(&payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
 	_, err = fmh.DB.InsertIncome(payload)
 	if err != nil {
 		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
@@ -188,6 +273,11 @@ func (fmh *FinanceManagerHandler) SaveIncome(w http.ResponseWriter, r *http.Requ
 // @Tags 		Incomes
 // @Summary 	Update Income
 // @Description Updates an existing Income for a user
+// @Description Available frequencies are 'weekly', 'bi-weekly', 'semi-monthly', 'monthly', 'quarterly' and 'annual'. Semi-monthly incomes are paid on the 15th and last day of each month
+// @Description Paydays that fall on a weekend are paid the Friday before
+// @Description Incomes with an end date are not paid after it. Use income versions to record changes to the pay rate
+// @Description Hourly incomes pay hours past regularHoursCap in a paycheck at overtimeMultiplier times the rate (1.5 if not set). Use income hours to log the hours actually worked
+// @Description Incomes with an accountId deposit their net pay to that bank account on each payday
 // @Param		userId path int true "User ID"
 // @Param		incomeId path int true "ID of the income to update"
 // @Param		income body models.Income true "The income to update"
@@ -248,6 +338,21 @@ func (fmh *FinanceManagerHandler) UpdateIncome(w http.ResponseWriter, r *http.Re
 	//Validate the Income object
 	payload.ValidateCanSaveIncome()
 
+	status, err := fmh.validateBankAccountLink(payload.AccountID, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, status)
+		klogger.ExitError(method, err.Error())
+		return
+	}
+
+	//Incomes cannot be saved with a state the tax engine does not support
+	err = fmh.Service.CalcIncomeTaxes(&payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
 	// Update the loan
 	err = fmh.DB.UpdateIncome(payload)
 	if err != nil {
@@ -309,7 +414,29 @@ func (fmh *FinanceManagerHandler) DeleteIncomeById(w http.ResponseWriter, r *htt
 		return
 	}
 
-	// Delete the loan
+	// Delete the income, its versions and its logged hours
+	err = fmh.DB.DeleteIncomeVersionsByIncomeID(incomeId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
+		klogger.ExitError(method, constants.FailedToDeleteEntityError, err)
+		return
+	}
+
+	err = fmh.DB.DeleteIncomeHoursByIncomeID(incomeId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
+		klogger.ExitError(method, constants.FailedToDeleteEntityError, err)
+		return
+	}
+
+	// Savings goals linked to the income are contributed to monthly once it is deleted
+	err = fmh.DB.UnlinkSavingsGoalsFromIncome(incomeId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
+		klogger.ExitError(method, constants.FailedToDeleteEntityError, err)
+		return
+	}
+
 	err = fmh.DB.DeleteIncomeByID(incomeId)
 	if err != nil {
 		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
@@ -0,0 +1,247 @@
+package fmhandler
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jon-kamis/klogger"
+)
+
+// GetAllIncomeHours godoc
+// @title		Get All Income Hours
+// @version 	1.0.0
+// @Tags 		Income Hours
+// @Summary 	Get All Income Hours
+// @Description Returns the hours logged for each pay period of an hourly Income in the order of their pay dates
+// @Param		userId path int true "User ID"
+// @Param		incomeId path int true "ID of the Income"
+// @Produce 	json
+// @Success 	200 {array} models.IncomeHours
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/incomes/{incomeId}/hours [get]
+func (fmh *FinanceManagerHandler) GetAllIncomeHours(w http.ResponseWriter, r *http.Request) {
+	method := "income_hours_handler.GetAllIncomeHours"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	incomeId, err1 := strconv.Atoi(chi.URLParam(r, "incomeId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	income, err := fmh.DB.GetIncomeByID(incomeId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if income.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.IncomeBelongsToUser(income, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	hours, err := fmh.DB.GetAllIncomeHoursByIncomeID(incomeId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	income.LoadLoggedHours(hours)
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, income.LoggedHours)
+}
+
+// SaveIncomeHours godoc
+// @title		Insert Income Hours
+// @version 	1.0.0
+// @Tags 		Income Hours
+// @Summary 	Insert Income Hours
+// @Description Logs the hours actually worked in the pay period of an hourly Income ending on a given payday
+// @Description Paychecks are paid for their logged hours, and paychecks without logged hours are projected from the average of the most recent pay periods logged before them
+// @Param		userId path int true "User ID"
+// @Param		incomeId path int true "ID of the Income"
+// @Param		hours body models.IncomeHours true "The hours to log"
+// @Accept		json
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/incomes/{incomeId}/hours [post]
+func (fmh *FinanceManagerHandler) SaveIncomeHours(w http.ResponseWriter, r *http.Request) {
+	method := "income_hours_handler.SaveIncomeHours"
+	klogger.Enter(method)
+
+	var payload models.IncomeHours
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	incomeId, err1 := strconv.Atoi(chi.URLParam(r, "incomeId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	// Read in hours from payload
+	err = fmh.JSONUtil.ReadJSON(w, r, &payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.FailedToParseJsonBodyError, err)
+		return
+	}
+
+	income, err := fmh.DB.GetIncomeByID(incomeId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if income.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.IncomeBelongsToUser(income, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	hours, err := fmh.DB.GetAllIncomeHoursByIncomeID(incomeId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	income.LoadLoggedHours(hours)
+
+	payload.IncomeID = incomeId
+	payload.UserID = userId
+
+	err = payload.ValidateCanSaveIncomeHours(income)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	_, err = fmh.DB.InsertIncomeHours(payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "income hours were logged successfully")
+}
+
+// DeleteIncomeHoursById godoc
+// @title		Delete Income Hours by ID
+// @version 	1.0.0
+// @Tags 		Income Hours
+// @Summary 	Delete Income Hours by ID
+// @Description Deletes the hours logged for a pay period of an Income belonging to a given user. The paycheck is projected from the pay periods logged before it in their place
+// @Param		userId path int true "User ID"
+// @Param		incomeId path int true "ID of the Income"
+// @Param		hoursId path int true "ID of the logged Income Hours"
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/incomes/{incomeId}/hours/{hoursId} [delete]
+func (fmh *FinanceManagerHandler) DeleteIncomeHoursById(w http.ResponseWriter, r *http.Request) {
+	method := "income_hours_handler.DeleteIncomeHoursById"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	incomeId, err1 := strconv.Atoi(chi.URLParam(r, "incomeId"))
+	hoursId, err2 := strconv.Atoi(chi.URLParam(r, "hoursId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil || err2 != nil {
+		err = errors.New(constants.ProcessIdError)
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err)
+		return
+	}
+
+	h, err := fmh.DB.GetIncomeHoursByID(hoursId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if h.ID == 0 || h.IncomeID != incomeId {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.IncomeHoursBelongsToUser(h, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	err = fmh.DB.DeleteIncomeHoursByID(hoursId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "Income hours deleted successfully")
+}
@@ -0,0 +1,167 @@
+package fmhandler
+
+import (
+	"encoding/json"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"finance-manager-backend/test"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllIncomeHours_400(t *testing.T) {
+	method := "income_hours_handler_test.TestGetAllIncomeHours_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/incomes/a/hours", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetAllIncomeHours_403(t *testing.T) {
+	method := "income_hours_handler_test.TestGetAllIncomeHours_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/incomes/1/hours", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestDeleteIncomeHoursById_400(t *testing.T) {
+	method := "income_hours_handler_test.TestDeleteIncomeHoursById_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodDelete, "/users/2/incomes/1/hours/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestIncomeHours_roundTrip(t *testing.T) {
+	method := "income_hours_handler_test.TestIncomeHours_roundTrip"
+	klogger.Enter(method)
+
+	i := setupIncomeHoursHandlerTestData()
+	token := test.GetUserJWT(t)
+	url := fmt.Sprintf("/users/2/incomes/%d/hours", i.ID)
+
+	h := models.IncomeHours{
+		PayDt: time.Date(2023, 1, 20, 0, 0, 0, 0, time.UTC),
+		Hours: 85.5,
+	}
+
+	//Create
+	writer := MakeRequest(http.MethodPost, url, h, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	//Hours are only logged once per pay date
+	writer = MakeRequest(http.MethodPost, url, h, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Get
+	writer = MakeRequest(http.MethodGet, url, nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var resp []models.IncomeHours
+	err := json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp))
+	assert.Equal(t, i.ID, resp[0].IncomeID)
+	assert.Equal(t, 2, resp[0].UserID)
+	assert.Equal(t, h.Hours, resp[0].Hours)
+	assert.True(t, h.PayDt.Equal(resp[0].PayDt))
+
+	//Delete
+	writer = MakeRequest(http.MethodDelete, fmt.Sprintf("%s/%d", url, resp[0].ID), nil, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	var count int64
+	p.GormDB.Model(&models.IncomeHours{}).Where("income_id = ?", i.ID).Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	teardownIncomeHoursHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestSaveIncomeHours_400(t *testing.T) {
+	method := "income_hours_handler_test.TestSaveIncomeHours_400"
+	klogger.Enter(method)
+
+	i := setupIncomeHoursHandlerTestData()
+	token := test.GetUserJWT(t)
+	url := fmt.Sprintf("/users/2/incomes/%d/hours", i.ID)
+
+	//Malformed Object
+	writer := MakeRequest(http.MethodPost, url, "{Bad", true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Not a payday of the income
+	h := models.IncomeHours{PayDt: time.Date(2023, 1, 13, 0, 0, 0, 0, time.UTC), Hours: 80}
+	writer = MakeRequest(http.MethodPost, url, h, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Negative hours
+	h = models.IncomeHours{PayDt: time.Date(2023, 1, 20, 0, 0, 0, 0, time.UTC), Hours: -1}
+	writer = MakeRequest(http.MethodPost, url, h, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	teardownIncomeHoursHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestIncomeHours_404(t *testing.T) {
+	method := "income_hours_handler_test.TestIncomeHours_404"
+	klogger.Enter(method)
+
+	i := setupIncomeHoursHandlerTestData()
+	token := test.GetUserJWT(t)
+
+	//Income does not exist
+	writer := MakeRequest(http.MethodGet, "/users/2/incomes/9999/hours", nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	h := models.IncomeHours{PayDt: time.Date(2023, 1, 20, 0, 0, 0, 0, time.UTC), Hours: 80}
+	writer = MakeRequest(http.MethodPost, "/users/2/incomes/9999/hours", h, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	//Hours do not exist
+	writer = MakeRequest(http.MethodDelete, fmt.Sprintf("/users/2/incomes/%d/hours/9999", i.ID), nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	teardownIncomeHoursHandlerTestData()
+	klogger.Exit(method)
+}
+
+func setupIncomeHoursHandlerTestData() models.Income {
+	i := models.Income{
+		UserID:    2,
+		Name:      "TestIncomeHours",
+		Type:      constants.IncomeTypeHourly,
+		Rate:      20,
+		Hours:     80,
+		Frequency: constants.IncomeFreqBiWeekly,
+		StartDt:   time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC),
+		CreateDt:  time.Now(),
+	}
+
+	p.GormDB.Create(&i)
+	return i
+}
+
+func teardownIncomeHoursHandlerTestData() {
+	p.GormDB.Exec("DELETE FROM income_hours")
+	p.GormDB.Exec("DELETE FROM incomes")
+}
@@ -0,0 +1,248 @@
+package fmhandler
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jon-kamis/klogger"
+)
+
+// GetAllIncomeVersions godoc
+// @title		Get All Income Versions
+// @version 	1.0.0
+// @Tags 		Income Versions
+// @Summary 	Get All Income Versions
+// @Description Returns the pay rate changes of an Income in the order they take effect
+// @Description Each version expires when the next begins, and the last version expires on the income's end date
+// @Param		userId path int true "User ID"
+// @Param		incomeId path int true "ID of the Income"
+// @Produce 	json
+// @Success 	200 {array} models.IncomeVersion
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/incomes/{incomeId}/versions [get]
+func (fmh *FinanceManagerHandler) GetAllIncomeVersions(w http.ResponseWriter, r *http.Request) {
+	method := "income_version_handler.GetAllIncomeVersions"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	incomeId, err1 := strconv.Atoi(chi.URLParam(r, "incomeId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	income, err := fmh.DB.GetIncomeByID(incomeId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if income.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.IncomeBelongsToUser(income, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	versions, err := fmh.DB.GetAllIncomeVersionsByIncomeID(incomeId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	income.LoadVersions(versions)
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, income.Versions)
+}
+
+// SaveIncomeVersion godoc
+// @title		Insert Income Version
+// @version 	1.0.0
+// @Tags 		Income Versions
+// @Summary 	Insert Income Version
+// @Description Records a change to the pay rate of an Income, such as a raise, that takes effect on a given date
+// @Description Paychecks on or after the effective date are paid at the new rate. Versions without hours keep the hours of the income
+// @Param		userId path int true "User ID"
+// @Param		incomeId path int true "ID of the Income"
+// @Param		version body models.IncomeVersion true "The income version to insert"
+// @Accept		json
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/incomes/{incomeId}/versions [post]
+func (fmh *FinanceManagerHandler) SaveIncomeVersion(w http.ResponseWriter, r *http.Request) {
+	method := "income_version_handler.SaveIncomeVersion"
+	klogger.Enter(method)
+
+	var payload models.IncomeVersion
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	incomeId, err1 := strconv.Atoi(chi.URLParam(r, "incomeId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	// Read in version from payload
+	err = fmh.JSONUtil.ReadJSON(w, r, &payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.FailedToParseJsonBodyError, err)
+		return
+	}
+
+	income, err := fmh.DB.GetIncomeByID(incomeId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if income.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.IncomeBelongsToUser(income, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	versions, err := fmh.DB.GetAllIncomeVersionsByIncomeID(incomeId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	income.LoadVersions(versions)
+
+	payload.IncomeID = incomeId
+	payload.UserID = userId
+
+	err = payload.ValidateCanSaveIncomeVersion(income)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	_, err = fmh.DB.InsertIncomeVersion(payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "new income version was saved successfully")
+}
+
+// DeleteIncomeVersionById godoc
+// @title		Delete Income Version by ID
+// @version 	1.0.0
+// @Tags 		Income Versions
+// @Summary 	Delete Income Version by ID
+// @Description Deletes a pay rate change of an Income belonging to a given user. The previous version remains in effect in its place
+// @Param		userId path int true "User ID"
+// @Param		incomeId path int true "ID of the Income"
+// @Param		versionId path int true "ID of the Income Version"
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/incomes/{incomeId}/versions/{versionId} [delete]
+func (fmh *FinanceManagerHandler) DeleteIncomeVersionById(w http.ResponseWriter, r *http.Request) {
+	method := "income_version_handler.DeleteIncomeVersionById"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	incomeId, err1 := strconv.Atoi(chi.URLParam(r, "incomeId"))
+	versionId, err2 := strconv.Atoi(chi.URLParam(r, "versionId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil || err2 != nil {
+		err = errors.New(constants.ProcessIdError)
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err)
+		return
+	}
+
+	v, err := fmh.DB.GetIncomeVersionByID(versionId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if v.ID == 0 || v.IncomeID != incomeId {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.IncomeVersionBelongsToUser(v, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	err = fmh.DB.DeleteIncomeVersionByID(versionId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "Income version deleted successfully")
+}
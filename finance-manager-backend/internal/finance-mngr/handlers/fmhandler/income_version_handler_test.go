@@ -0,0 +1,162 @@
+package fmhandler
+
+import (
+	"encoding/json"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"finance-manager-backend/test"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllIncomeVersions_400(t *testing.T) {
+	method := "income_version_handler_test.TestGetAllIncomeVersions_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/incomes/a/versions", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetAllIncomeVersions_403(t *testing.T) {
+	method := "income_version_handler_test.TestGetAllIncomeVersions_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/incomes/1/versions", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestDeleteIncomeVersionById_400(t *testing.T) {
+	method := "income_version_handler_test.TestDeleteIncomeVersionById_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodDelete, "/users/2/incomes/1/versions/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestIncomeVersion_roundTrip(t *testing.T) {
+	method := "income_version_handler_test.TestIncomeVersion_roundTrip"
+	klogger.Enter(method)
+
+	i := setupIncomeVersionHandlerTestData()
+	token := test.GetUserJWT(t)
+	url := fmt.Sprintf("/users/2/incomes/%d/versions", i.ID)
+
+	v := models.IncomeVersion{
+		Rate:        25,
+		Hours:       40,
+		EffectiveDt: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	//Create
+	writer := MakeRequest(http.MethodPost, url, v, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	//A second version effective on the same date is rejected
+	writer = MakeRequest(http.MethodPost, url, v, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Get
+	writer = MakeRequest(http.MethodGet, url, nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var resp []models.IncomeVersion
+	err := json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp))
+	assert.Equal(t, i.ID, resp[0].IncomeID)
+	assert.Equal(t, 2, resp[0].UserID)
+	assert.Equal(t, v.Rate, resp[0].Rate)
+	assert.True(t, v.EffectiveDt.Equal(resp[0].EffectiveDt))
+
+	//Delete
+	writer = MakeRequest(http.MethodDelete, fmt.Sprintf("%s/%d", url, resp[0].ID), nil, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	var count int64
+	p.GormDB.Model(&models.IncomeVersion{}).Where("income_id = ?", i.ID).Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	teardownIncomeVersionHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestSaveIncomeVersion_400(t *testing.T) {
+	method := "income_version_handler_test.TestSaveIncomeVersion_400"
+	klogger.Enter(method)
+
+	i := setupIncomeVersionHandlerTestData()
+	token := test.GetUserJWT(t)
+	url := fmt.Sprintf("/users/2/incomes/%d/versions", i.ID)
+
+	//Malformed Object
+	writer := MakeRequest(http.MethodPost, url, "{Bad", true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Effective before the income starts
+	v := models.IncomeVersion{Rate: 25, EffectiveDt: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)}
+	writer = MakeRequest(http.MethodPost, url, v, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	teardownIncomeVersionHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestIncomeVersion_404(t *testing.T) {
+	method := "income_version_handler_test.TestIncomeVersion_404"
+	klogger.Enter(method)
+
+	i := setupIncomeVersionHandlerTestData()
+	token := test.GetUserJWT(t)
+
+	//Income does not exist
+	writer := MakeRequest(http.MethodGet, "/users/2/incomes/9999/versions", nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	v := models.IncomeVersion{Rate: 25, EffectiveDt: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)}
+	writer = MakeRequest(http.MethodPost, "/users/2/incomes/9999/versions", v, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	//Version does not exist
+	writer = MakeRequest(http.MethodDelete, fmt.Sprintf("/users/2/incomes/%d/versions/9999", i.ID), nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	teardownIncomeVersionHandlerTestData()
+	klogger.Exit(method)
+}
+
+func setupIncomeVersionHandlerTestData() models.Income {
+	i := models.Income{
+		UserID:    2,
+		Name:      "TestIncomeVersion",
+		Type:      constants.IncomeTypeSalary,
+		Rate:      50000,
+		Frequency: constants.IncomeFreqBiWeekly,
+		StartDt:   time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC),
+		CreateDt:  time.Now(),
+	}
+
+	p.GormDB.Create(&i)
+	return i
+}
+
+func teardownIncomeVersionHandlerTestData() {
+	p.GormDB.Exec("DELETE FROM income_versions")
+	p.GormDB.Exec("DELETE FROM incomes")
+}
@@ -17,6 +17,8 @@ import (
 // @Tags 		Loans
 // @Summary 	Calculate Loan Values
 // @Description Performs calculations on a loan and returns the loan with updated values.
+// @Description Prepayments on the loan are applied to the payment schedule and the interest and months saved by them are returned.
+// @Description Loans with a rate schedule are re-amortized over their remaining term each time the rate changes.
 // @Description Does not Persist values
 // @Param		userId path int true "User ID"
 // @Param		loanId path int true "Loan ID. Will also accept 'new' for unsaved loan"
@@ -67,6 +69,7 @@ func (fmh *FinanceManagerHandler) CalculateLoan(w http.ResponseWriter, r *http.R
 // @Tags 		Loans
 // @Summary 	Compare Loan Payments
 // @Description Performs calculations on a loan and a Persisted loan with an Id, then returns a list comparing the two
+// @Description If the new loan has no total, it is treated as the persisted loan with the new loan's prepayments applied
 // @Description Does not Persist values
 // @Param		userId path int true "User ID"
 // @Param		loanId path int true "The ID of the persisted loan to compare against"
@@ -129,6 +132,13 @@ func (fmh *FinanceManagerHandler) CompareLoanPayments(w http.ResponseWriter, r *
 		return
 	}
 
+	// A payload without loan values is a prepayment plan for the persisted loan
+	if payload.Total <= 0 {
+		pp := payload.Prepayments
+		payload = loan
+		payload.Prepayments = pp
+	}
+
 	err = loan.PerformCalc()
 	err1 = payload.PerformCalc()
 
@@ -153,6 +163,105 @@ func (fmh *FinanceManagerHandler) CompareLoanPayments(w http.ResponseWriter, r *
 	fmh.JSONUtil.WriteJSON(w, http.StatusOK, cs)
 }
 
+// RefinanceLoan godoc
+// @title		Refinance Loan
+// @version 	1.0.0
+// @Tags 		Loans
+// @Summary 	Refinance Loan
+// @Description Compares the remaining balance of a persisted loan against refinancing it with a new rate and term
+// @Description Returns the break even month, the lifetime interest delta, the monthly payment change and a month by month comparison
+// @Description Does not Persist values
+// @Param		userId path int true "User ID"
+// @Param		loanId path int true "The ID of the persisted loan to refinance"
+// @Param		refinance body models.LoanRefinance true "The new rate, term, closing costs and cash out of the refinance"
+// @Accept		json
+// @Produce 	json
+// @Success 	200 {object} models.LoanRefinance
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	422 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/loans/{loanId}/refinance [post]
+func (fmh *FinanceManagerHandler) RefinanceLoan(w http.ResponseWriter, r *http.Request) {
+	method := "loan_handler.RefinanceLoan"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	loanId, err1 := strconv.Atoi(chi.URLParam(r, "loanId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	// Read in refinance terms from payload
+	var payload models.LoanRefinance
+	err = fmh.JSONUtil.ReadJSON(w, r, &payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.FailedToParseJsonBodyError, err)
+		return
+	}
+
+	err = payload.ValidateCanPerformCalc()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	loan, err := fmh.DB.GetLoanByID(loanId)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if loan.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	// Validate that this loan belongs to the given user
+	err = fmh.Validator.LoanBelongsToUser(loan, userId)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	payments, err := fmh.DB.GetAllLoanPaymentsByLoanID(loanId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	loan.LoadPayments(payments)
+
+	err = payload.Calculate(loan)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusUnprocessableEntity)
+		klogger.ExitError(method, constants.GenericUnprocessableEntityErrLog, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, payload)
+}
+
 // GetAllUserLoans godoc
 // @title		Get All User Loans
 // @version 	1.0.0
@@ -199,6 +308,7 @@ func (fmh *FinanceManagerHandler) GetAllUserLoans(w http.ResponseWriter, r *http
 // @Tags 		Loans
 // @Summary 	Get Loan by ID
 // @Description Returns a Loan object belonging to a given user
+// @Description The loan's balance and payment history are derived from the payments recorded against it
 // @Param		userId path int true "User ID"
 // @Param		loanId path int true "the ID of the Loan"
 // @Produce 	json
@@ -248,6 +358,15 @@ func (fmh *FinanceManagerHandler) GetLoanById(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	payments, err := fmh.DB.GetAllLoanPaymentsByLoanID(loanId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	loan.LoadPayments(payments)
+
 	klogger.Exit(method)
 	fmh.JSONUtil.WriteJSON(w, http.StatusOK, loan)
 }
@@ -307,7 +426,14 @@ func (fmh *FinanceManagerHandler) DeleteLoanById(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// Delete the loan
+	// Delete the loan and its payments
+	err = fmh.DB.DeleteLoanPaymentsByLoanID(loanId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
 	err = fmh.DB.DeleteLoanByID(loanId)
 	if err != nil {
 		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
@@ -464,6 +590,8 @@ func (fmh *FinanceManagerHandler) UpdateLoan(w http.ResponseWriter, r *http.Requ
 // @Tags 		Loans
 // @Summary 	Get User Loan Summary
 // @Description Gets a summary of all loans for a user
+// @Description Balances are the remaining balance of each loan after its recorded payments
+// @Description Count and monthly cost only include loans that are not paid off, and totalCount includes all loans
 // @Param		userId path int true "User ID"
 // @Accept		json
 // @Produce 	json
@@ -494,15 +622,32 @@ func (fmh *FinanceManagerHandler) GetLoanSummary(w http.ResponseWriter, r *http.
 		return
 	}
 
+	payments, err := fmh.DB.GetAllUserLoanPayments(id)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New("unexpected error occured when fetching loan payments"), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
 	monthlyCost := float64(0)
 	totalBalance := float64(0)
 	var summary models.LoansSummary
 
-	summary.Count = len(loans)
+	summary.TotalCount = len(loans)
 
 	for _, l := range loans {
+		l.LoadPayments(payments)
+		balance := l.GetRemainingBalance()
+
+		//Paid off loans no longer cost anything each month
+		if balance <= 0.009 {
+			continue
+		}
+
+		summary.Count++
 		monthlyCost += l.MonthlyPayment
-		totalBalance += l.Total
+		totalBalance += balance
 	}
 
 	summary.MonthlyCost = monthlyCost
@@ -0,0 +1,49 @@
+package fmhandler
+
+import (
+	"finance-manager-backend/internal/finance-mngr/models"
+	"finance-manager-backend/test"
+	"net/http"
+	"testing"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefinanceLoan_400(t *testing.T) {
+	method := "loan_handler_test.TestRefinanceLoan_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+	r := models.LoanRefinance{
+		InterestRate: 4,
+		LoanTerm:     60,
+	}
+
+	//Invalid ID
+	writer := MakeRequest(http.MethodPost, "/users/2/loans/a/refinance", r, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Term is required
+	r.LoanTerm = 0
+	writer = MakeRequest(http.MethodPost, "/users/2/loans/1/refinance", r, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestRefinanceLoan_403(t *testing.T) {
+	method := "loan_handler_test.TestRefinanceLoan_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+	r := models.LoanRefinance{
+		InterestRate: 4,
+		LoanTerm:     60,
+	}
+
+	writer := MakeRequest(http.MethodPost, "/users/1/loans/1/refinance", r, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
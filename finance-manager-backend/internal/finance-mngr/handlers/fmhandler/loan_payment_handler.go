@@ -0,0 +1,316 @@
+package fmhandler
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jon-kamis/klogger"
+)
+
+// GetAllLoanPayments godoc
+// @title		Get All Loan Payments
+// @version 	1.0.0
+// @Tags 		Loan Payments
+// @Summary 	Get All Loan Payments
+// @Description Returns the payments, late fees and extra principal payments recorded against a Loan in the order they were made
+// @Description The principal, interest and remaining balance of each payment are derived from the loan's payment history
+// @Param		userId path int true "User ID"
+// @Param		loanId path int true "ID of the Loan"
+// @Produce 	json
+// @Success 	200 {array} models.LoanPayment
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/loans/{loanId}/payments [get]
+func (fmh *FinanceManagerHandler) GetAllLoanPayments(w http.ResponseWriter, r *http.Request) {
+	method := "loan_payment_handler.GetAllLoanPayments"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	loanId, err1 := strconv.Atoi(chi.URLParam(r, "loanId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	loan, err := fmh.DB.GetLoanByID(loanId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if loan.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.LoanBelongsToUser(loan, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	payments, err := fmh.DB.GetAllLoanPaymentsByLoanID(loanId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	loan.LoadPayments(payments)
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, payments)
+}
+
+// SaveLoanPayment godoc
+// @title		Insert Loan Payment
+// @version 	1.0.0
+// @Tags 		Loan Payments
+// @Summary 	Insert Loan Payment
+// @Description Records a payment, late fee or extra principal payment against a Loan
+// @Description Available types are 'payment', 'late-fee' and 'extra-principal'
+// @Param		userId path int true "User ID"
+// @Param		loanId path int true "ID of the Loan"
+// @Param		payment body models.LoanPayment true "The loan payment to insert"
+// @Accept		json
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/loans/{loanId}/payments [post]
+func (fmh *FinanceManagerHandler) SaveLoanPayment(w http.ResponseWriter, r *http.Request) {
+	method := "loan_payment_handler.SaveLoanPayment"
+	klogger.Enter(method)
+
+	var payload models.LoanPayment
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	loanId, err1 := strconv.Atoi(chi.URLParam(r, "loanId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	// Read in payment from payload
+	err = fmh.JSONUtil.ReadJSON(w, r, &payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.FailedToParseJsonBodyError, err)
+		return
+	}
+
+	loan, err := fmh.DB.GetLoanByID(loanId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if loan.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.LoanBelongsToUser(loan, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	payload.LoanID = loanId
+	payload.UserID = userId
+
+	err = payload.ValidateCanSaveLoanPayment()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	_, err = fmh.DB.InsertLoanPayment(payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "new loan payment was saved successfully")
+}
+
+// DeleteLoanPaymentById godoc
+// @title		Delete Loan Payment by ID
+// @version 	1.0.0
+// @Tags 		Loan Payments
+// @Summary 	Delete Loan Payment by ID
+// @Description Deletes a payment recorded against a Loan belonging to a given user
+// @Param		userId path int true "User ID"
+// @Param		loanId path int true "ID of the Loan"
+// @Param		paymentId path int true "ID of the Loan Payment"
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/loans/{loanId}/payments/{paymentId} [delete]
+func (fmh *FinanceManagerHandler) DeleteLoanPaymentById(w http.ResponseWriter, r *http.Request) {
+	method := "loan_payment_handler.DeleteLoanPaymentById"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	loanId, err1 := strconv.Atoi(chi.URLParam(r, "loanId"))
+	paymentId, err2 := strconv.Atoi(chi.URLParam(r, "paymentId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil || err2 != nil {
+		err = errors.New(constants.ProcessIdError)
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err)
+		return
+	}
+
+	p, err := fmh.DB.GetLoanPaymentByID(paymentId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if p.ID == 0 || p.LoanID != loanId {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.LoanPaymentBelongsToUser(p, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	err = fmh.DB.DeleteLoanPaymentByID(paymentId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "Loan payment deleted successfully")
+}
+
+// CompareLoanActualPayments godoc
+// @title		Compare Actual Loan Payments
+// @version 	1.0.0
+// @Tags 		Loan Payments
+// @Summary 	Compare Actual Loan Payments
+// @Description Compares the scheduled amortization of a Loan against the payments recorded against it
+// @Description Scheduled values are returned as the original values and actual values are returned as the new values
+// @Param		userId path int true "User ID"
+// @Param		loanId path int true "ID of the Loan"
+// @Produce 	json
+// @Success 	200 {array} models.PaymentScheduleComparisonItem
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	422 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/loans/{loanId}/payment-comparison [get]
+func (fmh *FinanceManagerHandler) CompareLoanActualPayments(w http.ResponseWriter, r *http.Request) {
+	method := "loan_payment_handler.CompareLoanActualPayments"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	loanId, err1 := strconv.Atoi(chi.URLParam(r, "loanId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	loan, err := fmh.DB.GetLoanByID(loanId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if loan.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.LoanBelongsToUser(loan, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	payments, err := fmh.DB.GetAllLoanPaymentsByLoanID(loanId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	err = loan.PerformCalc()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusUnprocessableEntity)
+		klogger.ExitError(method, constants.GenericUnprocessableEntityErrLog, err)
+		return
+	}
+
+	loan.LoadPayments(payments)
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, loan.CompareActualPayments())
+}
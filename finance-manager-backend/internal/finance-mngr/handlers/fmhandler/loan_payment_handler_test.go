@@ -0,0 +1,194 @@
+package fmhandler
+
+import (
+	"encoding/json"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"finance-manager-backend/test"
+	"fmt"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllLoanPayments_400(t *testing.T) {
+	method := "loan_payment_handler_test.TestGetAllLoanPayments_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/loans/a/payments", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetAllLoanPayments_403(t *testing.T) {
+	method := "loan_payment_handler_test.TestGetAllLoanPayments_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/loans/1/payments", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestDeleteLoanPaymentById_400(t *testing.T) {
+	method := "loan_payment_handler_test.TestDeleteLoanPaymentById_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodDelete, "/users/2/loans/1/payments/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestCompareLoanActualPayments_403(t *testing.T) {
+	method := "loan_payment_handler_test.TestCompareLoanActualPayments_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/loans/1/payment-comparison", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestLoanPayment_roundTrip(t *testing.T) {
+	method := "loan_payment_handler_test.TestLoanPayment_roundTrip"
+	klogger.Enter(method)
+
+	l := setupLoanPaymentHandlerTestData()
+	token := test.GetUserJWT(t)
+	url := fmt.Sprintf("/users/2/loans/%d/payments", l.ID)
+
+	lp := models.LoanPayment{
+		Type:      constants.LoanPaymentTypePayment,
+		Amount:    500,
+		PaymentDt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	//Create
+	writer := MakeRequest(http.MethodPost, url, lp, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	//Get
+	writer = MakeRequest(http.MethodGet, url, nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var resp []models.LoanPayment
+	err := json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp))
+	assert.Equal(t, l.ID, resp[0].LoanID)
+	assert.Equal(t, 2, resp[0].UserID)
+	assert.Equal(t, lp.Amount, resp[0].Amount)
+
+	//The first month's interest at 12% is paid before principal
+	assert.Equal(t, 100.0, resp[0].Interest)
+	assert.Equal(t, 400.0, resp[0].Principal)
+	assert.Equal(t, 9600.0, resp[0].RemainingBalance)
+
+	//The payment counts toward the loan summary
+	writer = MakeRequest(http.MethodGet, "/users/2/loans-summary", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var summary models.LoansSummary
+	err = json.Unmarshal(writer.Body.Bytes(), &summary)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, summary.Count)
+	assert.Equal(t, 9600.0, math.Round(summary.TotalBalance*100)/100)
+
+	//The payment is compared against the first scheduled month
+	writer = MakeRequest(http.MethodGet, fmt.Sprintf("/users/2/loans/%d/payment-comparison", l.ID), nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var comparison []models.PaymentScheduleComparisonItem
+	err = json.Unmarshal(writer.Body.Bytes(), &comparison)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(comparison))
+
+	//Delete
+	writer = MakeRequest(http.MethodDelete, fmt.Sprintf("%s/%d", url, resp[0].ID), nil, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	var count int64
+	p.GormDB.Model(&models.LoanPayment{}).Where("loan_id = ?", l.ID).Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	teardownLoanPaymentHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestSaveLoanPayment_400(t *testing.T) {
+	method := "loan_payment_handler_test.TestSaveLoanPayment_400"
+	klogger.Enter(method)
+
+	l := setupLoanPaymentHandlerTestData()
+	token := test.GetUserJWT(t)
+	url := fmt.Sprintf("/users/2/loans/%d/payments", l.ID)
+
+	//Malformed Object
+	writer := MakeRequest(http.MethodPost, url, "{Bad", true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Invalid type
+	lp := models.LoanPayment{Type: "refund", Amount: 500, PaymentDt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)}
+	writer = MakeRequest(http.MethodPost, url, lp, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	teardownLoanPaymentHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestLoanPayment_404(t *testing.T) {
+	method := "loan_payment_handler_test.TestLoanPayment_404"
+	klogger.Enter(method)
+
+	l := setupLoanPaymentHandlerTestData()
+	token := test.GetUserJWT(t)
+
+	//Loan does not exist
+	lp := models.LoanPayment{Type: constants.LoanPaymentTypePayment, Amount: 500, PaymentDt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)}
+	writer := MakeRequest(http.MethodPost, "/users/2/loans/9999/payments", lp, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	writer = MakeRequest(http.MethodGet, "/users/2/loans/9999/payments", nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	//Payment does not exist
+	writer = MakeRequest(http.MethodDelete, fmt.Sprintf("/users/2/loans/%d/payments/9999", l.ID), nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	teardownLoanPaymentHandlerTestData()
+	klogger.Exit(method)
+}
+
+func setupLoanPaymentHandlerTestData() models.Loan {
+	l := models.Loan{
+		UserID:       2,
+		Name:         "TestLoanPayment",
+		Total:        10000,
+		InterestRate: 12,
+		LoanTerm:     60,
+		CreateDt:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	l.PerformPaymentCalc()
+	l.ID, _ = fmh.DB.InsertLoan(l)
+	return l
+}
+
+func teardownLoanPaymentHandlerTestData() {
+	p.GormDB.Exec("DELETE FROM loan_payments")
+	p.GormDB.Exec("DELETE FROM loans")
+}
@@ -0,0 +1,237 @@
+package fmhandler
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jon-kamis/klogger"
+)
+
+// GetAllSavingsGoalContributions godoc
+// @title		Get All Savings Goal Contributions
+// @version 	1.0.0
+// @Tags 		Savings Goals
+// @Summary 	Get All Savings Goal Contributions
+// @Description Returns the contribution ledger of a Savings Goal in the order the contributions were made
+// @Param		userId path int true "User ID"
+// @Param		goalId path int true "ID of the Savings Goal"
+// @Produce 	json
+// @Success 	200 {array} models.SavingsGoalContribution
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/savings-goals/{goalId}/contributions [get]
+func (fmh *FinanceManagerHandler) GetAllSavingsGoalContributions(w http.ResponseWriter, r *http.Request) {
+	method := "savings_goal_contribution_handler.GetAllSavingsGoalContributions"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	goalId, err1 := strconv.Atoi(chi.URLParam(r, "goalId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	goal, err := fmh.DB.GetSavingsGoalByID(goalId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if goal.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.SavingsGoalBelongsToUser(goal, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	contributions, err := fmh.DB.GetAllSavingsGoalContributionsBySavingsGoalID(goalId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	goal.LoadContributions(contributions)
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, goal.Contributions)
+}
+
+// SaveSavingsGoalContribution godoc
+// @title		Insert Savings Goal Contribution
+// @version 	1.0.0
+// @Tags 		Savings Goals
+// @Summary 	Insert Savings Goal Contribution
+// @Description Records an amount set aside toward a Savings Goal. Withdrawals from the goal are recorded as negative amounts
+// @Param		userId path int true "User ID"
+// @Param		goalId path int true "ID of the Savings Goal"
+// @Param		contribution body models.SavingsGoalContribution true "The contribution to insert"
+// @Accept		json
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/savings-goals/{goalId}/contributions [post]
+func (fmh *FinanceManagerHandler) SaveSavingsGoalContribution(w http.ResponseWriter, r *http.Request) {
+	method := "savings_goal_contribution_handler.SaveSavingsGoalContribution"
+	klogger.Enter(method)
+
+	var payload models.SavingsGoalContribution
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	goalId, err1 := strconv.Atoi(chi.URLParam(r, "goalId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	// Read in contribution from payload
+	err = fmh.JSONUtil.ReadJSON(w, r, &payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.FailedToParseJsonBodyError, err)
+		return
+	}
+
+	goal, err := fmh.DB.GetSavingsGoalByID(goalId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if goal.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.SavingsGoalBelongsToUser(goal, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	payload.SavingsGoalID = goalId
+	payload.UserID = userId
+
+	err = payload.ValidateCanSaveSavingsGoalContribution()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	_, err = fmh.DB.InsertSavingsGoalContribution(payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "new savings goal contribution was saved successfully")
+}
+
+// DeleteSavingsGoalContributionById godoc
+// @title		Delete Savings Goal Contribution by ID
+// @version 	1.0.0
+// @Tags 		Savings Goals
+// @Summary 	Delete Savings Goal Contribution by ID
+// @Description Deletes a contribution from the ledger of a Savings Goal belonging to a given user
+// @Param		userId path int true "User ID"
+// @Param		goalId path int true "ID of the Savings Goal"
+// @Param		contributionId path int true "ID of the Savings Goal Contribution"
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/savings-goals/{goalId}/contributions/{contributionId} [delete]
+func (fmh *FinanceManagerHandler) DeleteSavingsGoalContributionById(w http.ResponseWriter, r *http.Request) {
+	method := "savings_goal_contribution_handler.DeleteSavingsGoalContributionById"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	goalId, err1 := strconv.Atoi(chi.URLParam(r, "goalId"))
+	contributionId, err2 := strconv.Atoi(chi.URLParam(r, "contributionId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil || err2 != nil {
+		err = errors.New(constants.ProcessIdError)
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err)
+		return
+	}
+
+	c, err := fmh.DB.GetSavingsGoalContributionByID(contributionId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if c.ID == 0 || c.SavingsGoalID != goalId {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.SavingsGoalContributionBelongsToUser(c, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	err = fmh.DB.DeleteSavingsGoalContributionByID(contributionId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "Savings goal contribution deleted successfully")
+}
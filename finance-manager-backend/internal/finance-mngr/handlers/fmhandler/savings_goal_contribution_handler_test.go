@@ -0,0 +1,159 @@
+package fmhandler
+
+import (
+	"encoding/json"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"finance-manager-backend/test"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllSavingsGoalContributions_400(t *testing.T) {
+	method := "savings_goal_contribution_handler_test.TestGetAllSavingsGoalContributions_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/savings-goals/a/contributions", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetAllSavingsGoalContributions_403(t *testing.T) {
+	method := "savings_goal_contribution_handler_test.TestGetAllSavingsGoalContributions_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/savings-goals/1/contributions", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestDeleteSavingsGoalContributionById_400(t *testing.T) {
+	method := "savings_goal_contribution_handler_test.TestDeleteSavingsGoalContributionById_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodDelete, "/users/2/savings-goals/1/contributions/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestSavingsGoalContribution_roundTrip(t *testing.T) {
+	method := "savings_goal_contribution_handler_test.TestSavingsGoalContribution_roundTrip"
+	klogger.Enter(method)
+
+	g := setupSavingsGoalContributionHandlerTestData()
+	token := test.GetUserJWT(t)
+	url := fmt.Sprintf("/users/2/savings-goals/%d/contributions", g.ID)
+
+	c := models.SavingsGoalContribution{
+		Amount:         250,
+		ContributionDt: time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	//Create
+	writer := MakeRequest(http.MethodPost, url, c, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	//Get
+	writer = MakeRequest(http.MethodGet, url, nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var resp []models.SavingsGoalContribution
+	err := json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp))
+	assert.Equal(t, g.ID, resp[0].SavingsGoalID)
+	assert.Equal(t, 2, resp[0].UserID)
+	assert.Equal(t, c.Amount, resp[0].Amount)
+
+	//The contribution counts toward the goal
+	writer = MakeRequest(http.MethodGet, fmt.Sprintf("/users/2/savings-goals/%d", g.ID), nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var goal models.SavingsGoal
+	err = json.Unmarshal(writer.Body.Bytes(), &goal)
+	assert.Nil(t, err)
+	assert.Equal(t, c.Amount, goal.Saved)
+
+	//Delete
+	writer = MakeRequest(http.MethodDelete, fmt.Sprintf("%s/%d", url, resp[0].ID), nil, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	var count int64
+	p.GormDB.Model(&models.SavingsGoalContribution{}).Where("savings_goal_id = ?", g.ID).Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	teardownSavingsGoalContributionHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestSaveSavingsGoalContribution_400(t *testing.T) {
+	method := "savings_goal_contribution_handler_test.TestSaveSavingsGoalContribution_400"
+	klogger.Enter(method)
+
+	g := setupSavingsGoalContributionHandlerTestData()
+	token := test.GetUserJWT(t)
+	url := fmt.Sprintf("/users/2/savings-goals/%d/contributions", g.ID)
+
+	//Malformed Object
+	writer := MakeRequest(http.MethodPost, url, "{Bad", true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//No amount
+	c := models.SavingsGoalContribution{ContributionDt: time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)}
+	writer = MakeRequest(http.MethodPost, url, c, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	teardownSavingsGoalContributionHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestSavingsGoalContribution_404(t *testing.T) {
+	method := "savings_goal_contribution_handler_test.TestSavingsGoalContribution_404"
+	klogger.Enter(method)
+
+	g := setupSavingsGoalContributionHandlerTestData()
+	token := test.GetUserJWT(t)
+
+	//Goal does not exist
+	c := models.SavingsGoalContribution{Amount: 250, ContributionDt: time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)}
+	writer := MakeRequest(http.MethodPost, "/users/2/savings-goals/9999/contributions", c, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	//Contribution does not exist
+	writer = MakeRequest(http.MethodDelete, fmt.Sprintf("/users/2/savings-goals/%d/contributions/9999", g.ID), nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	teardownSavingsGoalContributionHandlerTestData()
+	klogger.Exit(method)
+}
+
+func setupSavingsGoalContributionHandlerTestData() models.SavingsGoal {
+	g := models.SavingsGoal{
+		UserID:   2,
+		Name:     "TestSavingsGoalContribution",
+		Target:   1200,
+		Deadline: time.Now().AddDate(1, 0, 0),
+		CreateDt: time.Now(),
+	}
+
+	p.GormDB.Create(&g)
+	return g
+}
+
+func teardownSavingsGoalContributionHandlerTestData() {
+	p.GormDB.Exec("DELETE FROM savings_goal_contributions")
+	p.GormDB.Exec("DELETE FROM savings_goals")
+}
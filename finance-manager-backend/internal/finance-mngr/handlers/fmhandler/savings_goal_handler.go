@@ -0,0 +1,433 @@
+package fmhandler
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jon-kamis/klogger"
+)
+
+// GetAllUserSavingsGoals godoc
+// @title		Get All User Savings Goals
+// @version 	1.0.0
+// @Tags 		Savings Goals
+// @Summary 	Get All User Savings Goals
+// @Description Returns an array of Savings Goal objects belonging to a given user in the order of their deadlines
+// @Description Each goal includes its contributions, the amount required from each pay to reach the target by the deadline, and whether it is on track
+// @Param		userId path int true "User ID"
+// @Param		search query string false "Search for savings goals by name"
+// @Produce 	json
+// @Success 	200 {array} models.SavingsGoal
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	422 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/savings-goals [get]
+func (fmh *FinanceManagerHandler) GetAllUserSavingsGoals(w http.ResponseWriter, r *http.Request) {
+	method := "savings_goal_handler.GetAllUserSavingsGoals"
+	klogger.Enter(method)
+
+	//Read ID from url
+	search := r.URL.Query().Get("search")
+	id, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	goals, err := fmh.DB.GetAllUserSavingsGoals(id, search)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	contributions, err := fmh.DB.GetAllUserSavingsGoalContributions(id)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	incomes, err := fmh.DB.GetAllUserIncomes(id, "")
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	for _, g := range goals {
+		g.LoadContributions(contributions)
+		g.LoadIncome(incomes)
+
+		err = g.CalcProgress(time.Now())
+		if err != nil {
+			fmh.JSONUtil.ErrorJSON(w, err, http.StatusUnprocessableEntity)
+			klogger.ExitError(method, constants.GenericUnprocessableEntityErrLog, err)
+			return
+		}
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, goals)
+}
+
+// GetSavingsGoalById godoc
+// @title		Get Savings Goal by ID
+// @version 	1.0.0
+// @Tags 		Savings Goals
+// @Summary 	Get Savings Goal by ID
+// @Description Returns a Savings Goal by its ID for a given user
+// @Description The goal includes its contributions, the amount required from each pay to reach the target by the deadline, and whether it is on track
+// @Param		userId path int true "User ID"
+// @Param		goalId path int true "ID of the Savings Goal"
+// @Produce 	json
+// @Success 	200 {object} models.SavingsGoal
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	422 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/savings-goals/{goalId} [get]
+func (fmh *FinanceManagerHandler) GetSavingsGoalById(w http.ResponseWriter, r *http.Request) {
+	method := "savings_goal_handler.GetSavingsGoalById"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	goalId, err1 := strconv.Atoi(chi.URLParam(r, "goalId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	goal, err := fmh.DB.GetSavingsGoalByID(goalId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if goal.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.SavingsGoalBelongsToUser(goal, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	contributions, err := fmh.DB.GetAllSavingsGoalContributionsBySavingsGoalID(goalId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	incomes, err := fmh.DB.GetAllUserIncomes(userId, "")
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	goal.LoadContributions(contributions)
+	goal.LoadIncome(incomes)
+
+	err = goal.CalcProgress(time.Now())
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusUnprocessableEntity)
+		klogger.ExitError(method, constants.GenericUnprocessableEntityErrLog, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, goal)
+}
+
+// SaveSavingsGoal godoc
+// @title		Insert Savings Goal
+// @version 	1.0.0
+// @Tags 		Savings Goals
+// @Summary 	Insert Savings Goal
+// @Description Inserts a new Savings Goal into the Database for a given user
+// @Description contributionPerPay is set aside from each paycheck of the linked income. Goals without a linked income are contributed to on the first of each month
+// @Param		userId path int true "User ID"
+// @Param		goal body models.SavingsGoal true "The savings goal to insert"
+// @Accept		json
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/savings-goals [post]
+func (fmh *FinanceManagerHandler) SaveSavingsGoal(w http.ResponseWriter, r *http.Request) {
+	method := "savings_goal_handler.SaveSavingsGoal"
+	klogger.Enter(method)
+
+	var payload models.SavingsGoal
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	// Read in goal from payload
+	err = fmh.JSONUtil.ReadJSON(w, r, &payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.FailedToParseJsonBodyError, err)
+		return
+	}
+
+	payload.UserID = userId
+
+	err = payload.ValidateCanSaveSavingsGoal()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	status, err := fmh.validateSavingsGoalIncome(payload, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, status)
+		klogger.ExitError(method, err.Error())
+		return
+	}
+
+	_, err = fmh.DB.InsertSavingsGoal(payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "new savings goal was saved successfully")
+}
+
+// UpdateSavingsGoal godoc
+// @title		Update Savings Goal
+// @version 	1.0.0
+// @Tags 		Savings Goals
+// @Summary 	Update Savings Goal
+// @Description Updates an existing Savings Goal for a user. The goal's contributions are kept
+// @Param		userId path int true "User ID"
+// @Param		goalId path int true "ID of the Savings Goal to update"
+// @Param		goal body models.SavingsGoal true "The savings goal to update"
+// @Accept		json
+// @Produce 	json
+// @Success 	200 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/savings-goals/{goalId} [put]
+func (fmh *FinanceManagerHandler) UpdateSavingsGoal(w http.ResponseWriter, r *http.Request) {
+	method := "savings_goal_handler.UpdateSavingsGoal"
+	klogger.Enter(method)
+
+	var payload models.SavingsGoal
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	goalId, err1 := strconv.Atoi(chi.URLParam(r, "goalId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	// Read in goal from payload
+	err = fmh.JSONUtil.ReadJSON(w, r, &payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.FailedToParseJsonBodyError, err)
+		return
+	}
+
+	// Validate that the goal exists and belongs to the user
+	goal, err := fmh.DB.GetSavingsGoalByID(goalId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if goal.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.SavingsGoalBelongsToUser(goal, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	payload.ID = goalId
+	payload.UserID = userId
+
+	err = payload.ValidateCanSaveSavingsGoal()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	status, err := fmh.validateSavingsGoalIncome(payload, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, status)
+		klogger.ExitError(method, err.Error())
+		return
+	}
+
+	err = fmh.DB.UpdateSavingsGoal(payload)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, "Savings goal updated successfully")
+}
+
+// DeleteSavingsGoalById godoc
+// @title		Delete Savings Goal by ID
+// @version 	1.0.0
+// @Tags 		Savings Goals
+// @Summary 	Delete Savings Goal by ID
+// @Description Deletes a user's Savings Goal and its contributions by its ID
+// @Param		userId path int true "User ID"
+// @Param		goalId path int true "ID of the Savings Goal"
+// @Produce 	json
+// @Success 	202 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/savings-goals/{goalId} [delete]
+func (fmh *FinanceManagerHandler) DeleteSavingsGoalById(w http.ResponseWriter, r *http.Request) {
+	method := "savings_goal_handler.DeleteSavingsGoalById"
+	klogger.Enter(method)
+
+	//Read ID from url
+	userId, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+	goalId, err1 := strconv.Atoi(chi.URLParam(r, "goalId"))
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	if err1 != nil {
+		fmh.JSONUtil.ErrorJSON(w, err1, http.StatusBadRequest)
+		klogger.ExitError(method, constants.ProcessIdError, err1)
+		return
+	}
+
+	goal, err := fmh.DB.GetSavingsGoalByID(goalId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	if goal.ID == 0 {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.EntityNotFoundError), http.StatusNotFound)
+		klogger.ExitError(method, constants.EntityNotFoundError)
+		return
+	}
+
+	err = fmh.Validator.SavingsGoalBelongsToUser(goal, userId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	// Delete the goal and its contributions
+	err = fmh.DB.DeleteSavingsGoalContributionsBySavingsGoalID(goalId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.FailedToDeleteEntityError, err)
+		return
+	}
+
+	err = fmh.DB.DeleteSavingsGoalByID(goalId)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.FailedToDeleteEntityError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusAccepted, "Savings goal deleted successfully")
+}
+
+// Function validateSavingsGoalIncome validates that the income linked to a savings goal exists and belongs to the user.
+// Returns the http status to respond with when it does not
+func (fmh *FinanceManagerHandler) validateSavingsGoalIncome(g models.SavingsGoal, userId int) (int, error) {
+	method := "savings_goal_handler.validateSavingsGoalIncome"
+	klogger.Enter(method)
+
+	if g.IncomeID == 0 {
+		klogger.Exit(method)
+		return http.StatusOK, nil
+	}
+
+	income, err := fmh.DB.GetIncomeByID(g.IncomeID)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return http.StatusInternalServerError, errors.New(constants.GenericServerError)
+	}
+
+	if income.ID == 0 {
+		err = errors.New("linked income does not exist")
+		klogger.ExitError(method, err.Error())
+		return http.StatusBadRequest, err
+	}
+
+	err = fmh.Validator.IncomeBelongsToUser(income, userId)
+	if err != nil {
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return http.StatusForbidden, err
+	}
+
+	klogger.Exit(method)
+	return http.StatusOK, nil
+}
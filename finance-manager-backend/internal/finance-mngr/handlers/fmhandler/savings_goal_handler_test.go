@@ -0,0 +1,163 @@
+package fmhandler
+
+import (
+	"encoding/json"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"finance-manager-backend/test"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllUserSavingsGoals_403(t *testing.T) {
+	method := "savings_goal_handler_test.TestGetAllUserSavingsGoals_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/savings-goals", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetSavingsGoalById_400(t *testing.T) {
+	method := "savings_goal_handler_test.TestGetSavingsGoalById_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/savings-goals/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetSavingsGoalById_403(t *testing.T) {
+	method := "savings_goal_handler_test.TestGetSavingsGoalById_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/savings-goals/1", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestDeleteSavingsGoalById_400(t *testing.T) {
+	method := "savings_goal_handler_test.TestDeleteSavingsGoalById_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodDelete, "/users/2/savings-goals/a", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestSavingsGoal_roundTrip(t *testing.T) {
+	method := "savings_goal_handler_test.TestSavingsGoal_roundTrip"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	g := models.SavingsGoal{
+		Name:               "TestSavingsGoal",
+		Target:             1200,
+		Deadline:           time.Now().AddDate(1, 0, 0),
+		ContributionPerPay: 100,
+	}
+
+	//Create
+	writer := MakeRequest(http.MethodPost, "/users/2/savings-goals", g, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	var gDb models.SavingsGoal
+	p.GormDB.Where("name = ?", g.Name).First(&gDb)
+	assert.Greater(t, gDb.ID, 0)
+	assert.Equal(t, 2, gDb.UserID)
+	assert.Equal(t, g.Target, gDb.Target)
+
+	url := fmt.Sprintf("/users/2/savings-goals/%d", gDb.ID)
+
+	//Get all
+	writer = MakeRequest(http.MethodGet, "/users/2/savings-goals", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var goals []models.SavingsGoal
+	err := json.Unmarshal(writer.Body.Bytes(), &goals)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(goals))
+
+	//Update
+	g.Target = 2400
+	writer = MakeRequest(http.MethodPut, url, g, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	//Get by ID
+	writer = MakeRequest(http.MethodGet, url, nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var resp models.SavingsGoal
+	err = json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, gDb.ID, resp.ID)
+	assert.Equal(t, 2400.0, resp.Target)
+	assert.Equal(t, 2400.0, resp.Remaining)
+
+	//Delete
+	writer = MakeRequest(http.MethodDelete, url, nil, true, token)
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+
+	writer = MakeRequest(http.MethodGet, url, nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	teardownSavingsGoalHandlerTestData()
+	klogger.Exit(method)
+}
+
+func TestSaveSavingsGoal_400(t *testing.T) {
+	method := "savings_goal_handler_test.TestSaveSavingsGoal_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	//Malformed Object
+	writer := MakeRequest(http.MethodPost, "/users/2/savings-goals", "{Bad", true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//No target
+	g := models.SavingsGoal{Name: "TestSaveSavingsGoal_400", Deadline: time.Now().AddDate(1, 0, 0)}
+	writer = MakeRequest(http.MethodPost, "/users/2/savings-goals", g, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestSavingsGoal_404(t *testing.T) {
+	method := "savings_goal_handler_test.TestSavingsGoal_404"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	g := models.SavingsGoal{Name: "TestSavingsGoal_404", Target: 1200, Deadline: time.Now().AddDate(1, 0, 0)}
+
+	writer := MakeRequest(http.MethodPut, "/users/2/savings-goals/9999", g, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	writer = MakeRequest(http.MethodDelete, "/users/2/savings-goals/9999", nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func teardownSavingsGoalHandlerTestData() {
+	p.GormDB.Exec("DELETE FROM savings_goal_contributions")
+	p.GormDB.Exec("DELETE FROM savings_goals")
+}
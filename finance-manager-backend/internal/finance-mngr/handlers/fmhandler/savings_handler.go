@@ -14,9 +14,11 @@ import (
 // @Tags 		Savings
 // @Summary 	Calculate Savings Request
 // @Description Performs calculation on request and returns a result without saving
+// @Description When an apy is supplied the per pay amount and projected savings include the interest earned before the deadline
 // @Param		request body restmodels.SavingsCalculationRequest true "the request to calculate"
 // @Produce 	json
-// @Success 	200 {object} models.Income
+// @Success 	200 {object} restmodels.SavingsCalculationResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
 // @Failure 	403 {object} jsonutils.JSONResponse
 // @Failure 	404 {object} jsonutils.JSONResponse
 // @Failure 	500 {object} jsonutils.JSONResponse
@@ -5,7 +5,10 @@ import (
 	"finance-manager-backend/internal/finance-mngr/constants"
 	"finance-manager-backend/internal/finance-mngr/models"
 	"finance-manager-backend/internal/finance-mngr/models/restmodels"
+	"fmt"
+	"math"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -13,7 +16,10 @@ import (
 	"github.com/jon-kamis/klogger"
 )
 
-// Loads a stock from the remote API
+// errStockNotFound is returned by loadStock when the remote API has no data for a ticker
+var errStockNotFound = errors.New(constants.StockNotFoundError)
+
+// Loads a stock from the remote API. Returns errStockNotFound if the API has no data for ticker
 func (fmh *FinanceManagerHandler) loadStock(ticker string) error {
 	method := "stocks_handler.loadStock"
 	klogger.Enter(method)
@@ -39,6 +45,11 @@ func (fmh *FinanceManagerHandler) loadStock(ticker string) error {
 		return err
 	}
 
+	if len(sl) == 0 {
+		klogger.ExitError(method, errStockNotFound.Error())
+		return errStockNotFound
+	}
+
 	_, err = fmh.DB.InsertStock(sl[len(sl)-1])
 
 	if err != nil {
@@ -53,6 +64,43 @@ func (fmh *FinanceManagerHandler) loadStock(ticker string) error {
 		return err
 	}
 
+	//The stock can still be used without its splits and dividends, which are loaded again when the stock is next updated
+	err = fmh.loadStockCorporateActions(ticker)
+
+	if err != nil {
+		klogger.Error(method, "failed to load corporate actions for %s:\n%v", ticker, err)
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function loadStockCorporateActions fetches the splits and dividends of a stock and saves those that have not already been saved
+func (fmh *FinanceManagerHandler) loadStockCorporateActions(ticker string) error {
+	method := "stocks_handler.loadStockCorporateActions"
+	klogger.Enter(method)
+
+	sarr, err := fmh.ExternalService.FetchStockSplitsWithTicker(ticker)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedExternalCallError, err)
+		return err
+	}
+
+	darr, err := fmh.ExternalService.FetchStockDividendsWithTicker(ticker)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedExternalCallError, err)
+		return err
+	}
+
+	_, err = fmh.Service.IngestStockCorporateActions(ticker, sarr, darr)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
 	klogger.Exit(method)
 	return nil
 }
@@ -107,6 +155,12 @@ func (fmh *FinanceManagerHandler) SaveUserStock(w http.ResponseWriter, r *http.R
 	//Fetch or Load the requested stock
 	err = fmh.loadStock(payload.Ticker)
 
+	if errors.Is(err, errStockNotFound) {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusNotFound)
+		klogger.ExitError(method, constants.GenericNotFoundErrorLog, err)
+		return
+	}
+
 	if err != nil {
 		rerr := errors.New(constants.GenericServerError)
 		fmh.JSONUtil.ErrorJSON(w, rerr, http.StatusInternalServerError)
@@ -122,6 +176,14 @@ func (fmh *FinanceManagerHandler) SaveUserStock(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	//Quantities are entered as they were on the effective date, so they must be split by any split after it
+	err = fmh.Service.ApplyStockSplits(payload.Ticker)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
 	klogger.Exit(method)
 	fmh.JSONUtil.WriteJSON(w, http.StatusOK, constants.SuccessMessage)
 }
@@ -132,11 +194,14 @@ func (fmh *FinanceManagerHandler) SaveUserStock(w http.ResponseWriter, r *http.R
 // @Tags 		Stocks
 // @Summary 	Modify User Stock
 // @Description Modifies a user's stock. This is an add or remove operation and can be used to post new stock
+// @Description Each operation is recorded as a buy or sell transaction at price per share with its fees. Each buy opens a lot, and each sell is taken from the open lots chosen by lotMethod, or from the lots it selects when lotMethod is specific-id
+// @Description Amounts are the number of shares on the date of the operation. Shares held across a later split of the stock are split on its execution date
 // @Param		userId path int true "ID of the user to modify stocks for"
 // @Param		request body restmodels.ModifyStockRequest true "The request to process"
 // @Accept		json
 // @Produce 	json
 // @Success 	200 {object} jsonutils.JSONResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
 // @Failure 	403 {object} jsonutils.JSONResponse
 // @Failure 	404 {object} jsonutils.JSONResponse
 // @Failure 	500 {object} jsonutils.JSONResponse
@@ -177,6 +242,12 @@ func (fmh *FinanceManagerHandler) ModifyStockOperation(w http.ResponseWriter, r
 	//Load stock if required
 	err = fmh.loadStock(p.Ticker)
 
+	if errors.Is(err, errStockNotFound) {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusNotFound)
+		klogger.ExitError(method, constants.GenericNotFoundErrorLog, err)
+		return
+	}
+
 	if err != nil {
 		rerr := errors.New(constants.GenericServerError)
 		fmh.JSONUtil.ErrorJSON(w, rerr, http.StatusInternalServerError)
@@ -184,6 +255,34 @@ func (fmh *FinanceManagerHandler) ModifyStockOperation(w http.ResponseWriter, r
 		return
 	}
 
+	//Sales must be taken from lots that have the shares they select
+	t := models.NewStockTransaction(uId, p)
+
+	tarr, err := fmh.DB.GetAllUserStockTransactions(uId, p.Ticker)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	sarr, err := fmh.DB.GetStockSplitsByTicker(p.Ticker)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	//The sale is validated as the last transaction of its day so it can be taken from lots bought earlier that day
+	pending := t
+	pending.ID = math.MaxInt
+
+	_, _, err = models.BuildStockLots(append(tarr, &pending), sarr)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
 	//Prior user stock
 	var usp models.UserStock
 
@@ -201,26 +300,20 @@ func (fmh *FinanceManagerHandler) ModifyStockOperation(w http.ResponseWriter, r
 		return
 	}
 
-	//Update usp if it exists
-	if usp.ID != 0 {
-		err = fmh.DB.UpdateUserStock(usp)
-
-		if err != nil {
-			fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
-			klogger.ExitError(method, constants.FailedToUpdateEntityError, err)
-			return
-		}
+	//Update usp if it exists, save the new user stock created by the operation if its quantity is greater than 0 and record the transaction together
+	_, err = fmh.DB.SaveStockOperation(usp, us, t)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
+		klogger.ExitError(method, constants.FailedToSaveEntityError, err)
+		return
 	}
 
-	//Save new user stock created by operation if quantity is greater than 0
-	if us.Quantity > 0 {
-		_, err = fmh.DB.InsertUserStock(us)
-
-		if err != nil {
-			fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
-			klogger.ExitError(method, constants.FailedToSaveEntityError, err)
-			return
-		}
+	//Quantities are entered as they were on the date of the operation, so they must be split by any split after it
+	err = fmh.Service.ApplyStockSplits(p.Ticker)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
 	}
 
 	klogger.Exit(method)
@@ -284,6 +377,43 @@ func (fmh *FinanceManagerHandler) GetUserStocks(w http.ResponseWriter, r *http.R
 	klogger.Exit(method)
 }
 
+// GetUserStockTransactions godoc
+// @title		Get User Stock Transactions
+// @version 	1.0.0
+// @Tags 		Stocks
+// @Summary 	Get User Stock Transactions
+// @Description Gets the buy and sell transactions recorded by a user's stock operations in the order they were made. The ID of each buy is the ID of the lot it opened
+// @Param		userId path int true "The ID of the user to fetch stock transactions for"
+// @Param		ticker query string false "Only fetch the transactions of this ticker"
+// @Produce 	json
+// @Success 	200 {array} models.StockTransaction
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/stock-transactions [get]
+func (fmh *FinanceManagerHandler) GetUserStockTransactions(w http.ResponseWriter, r *http.Request) {
+	method := "stocks_handler.GetUserStockTransactions"
+	klogger.Enter(method)
+
+	//Read ID from url
+	uid, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	tarr, err := fmh.DB.GetAllUserStockTransactions(uid, r.URL.Query().Get("ticker"))
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, tarr)
+}
+
 // GetStockHistory godoc
 // @title		Get Stock History
 // @version 	2.1.0
@@ -399,11 +529,14 @@ func (fmh *FinanceManagerHandler) GetStockHistory(w http.ResponseWriter, r *http
 // @Tags 		Stocks
 // @Summary 	Get User Stock Portfolio History
 // @Description Gets History of a User's Stock Portfolio Balance
+// @Description Benchmark tickers are loaded if they are not already, normalized to the portfolio's starting value and compared to its return
 // @Param		userId path int true "The ID of the user to get Portfolio History for"
 // @Param		histLength query int false "The lenght of history to fetch. Available values are 'week', 'month', and 'year'. Default is 'week'"
+// @Param		benchmarks query string false "A comma-separated list of up to 5 benchmark tickers to compare the portfolio to, such as 'SPY,QQQ'"
 // @Accept		json
 // @Produce 	json
 // @Success 	200 {object} models.StockPortfolioHistoryResponse
+// @Failure 	400 {object} jsonutils.JSONResponse
 // @Failure 	403 {object} jsonutils.JSONResponse
 // @Failure 	404 {object} jsonutils.JSONResponse
 // @Failure 	500 {object} jsonutils.JSONResponse
@@ -415,10 +548,12 @@ func (fmh *FinanceManagerHandler) GetUserStockPortfolioHistory(w http.ResponseWr
 	var resp models.StockPortfolioHistoryResponse
 	var err error
 	var hl int
+	var bArr []string
 
 	//Read URL variables
 	id, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
 	hlStr := r.URL.Query().Get("histLength")
+	bStr := r.URL.Query().Get("benchmarks")
 
 	if err != nil {
 		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
@@ -441,31 +576,74 @@ func (fmh *FinanceManagerHandler) GetUserStockPortfolioHistory(w http.ResponseWr
 		hl = 7
 	}
 
+	for _, b := range strings.Split(bStr, ",") {
+		b = strings.ToUpper(strings.TrimSpace(b))
+
+		if b != "" && !slices.Contains(bArr, b) {
+			bArr = append(bArr, b)
+		}
+	}
+
+	if len(bArr) > constants.MaxBenchmarks {
+		err = errors.New(constants.BenchmarkLimitError)
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
 	//Load positions History object
 	h, err := fmh.Service.GetUserPortfolioBalanceHistory(id, hl)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.GenericServerError, err)
+		return
+	}
+
 	resp.Items = h
 	resp.Count = len(h)
 
-	//Get highest and lowest value
-	high := h[0].High
-	low := h[0].Low
+	if len(h) > 0 {
+		//Get highest and lowest value
+		high := h[0].High
+		low := h[0].Low
+
+		for _, i := range h {
+			if i.Low < low {
+				low = i.Low
+			}
+
+			if i.High > high {
+				high = i.High
+			}
+		}
+
+		resp.High = high
+		resp.Low = low
+		resp.Open = h[0].Open
+		resp.Close = h[len(h)-1].Close
+		resp.Delta = resp.Close - resp.Open
+		resp.DeltaPercentage = resp.Delta / resp.Open * 100
+	}
+
+	//Benchmarks are loaded the same way as stocks saved by users
+	for _, b := range bArr {
+		err = fmh.loadStock(b)
 
-	for _, i := range h {
-		if i.Low < low {
-			low = i.Low
+		if errors.Is(err, errStockNotFound) {
+			fmh.JSONUtil.ErrorJSON(w, fmt.Errorf("benchmark %s was not found", b), http.StatusNotFound)
+			klogger.ExitError(method, constants.GenericNotFoundErrorLog, err)
+			return
 		}
 
-		if i.High > high {
-			high = i.High
+		if err != nil {
+			fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+			klogger.ExitError(method, constants.UnexpectedExternalCallError, err)
+			return
 		}
 	}
 
-	resp.High = high
-	resp.Low = low
-	resp.Open = h[0].Open
-	resp.Close = h[len(h)-1].Close
-	resp.Delta = resp.Close - resp.Open
-	resp.DeltaPercentage = resp.Delta / resp.Open * 100
+	resp.Benchmarks, err = fmh.Service.GetUserPortfolioBenchmarks(id, h, bArr)
 
 	if err != nil {
 		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
@@ -476,3 +654,58 @@ func (fmh *FinanceManagerHandler) GetUserStockPortfolioHistory(w http.ResponseWr
 	fmh.JSONUtil.WriteJSON(w, http.StatusOK, resp)
 	klogger.Exit(method)
 }
+
+// GetUserStockPortfolioPerformance godoc
+// @title		Get User Stock Portfolio Performance
+// @version 	1.0.0
+// @Tags 		Stocks
+// @Summary 	Get User Stock Portfolio Performance
+// @Description Gets the returns of a user's stock portfolio and of each stock they have owned over each period up to today
+// @Description timeWeightedReturn is the percentage return with the effect of buying and selling shares removed, and moneyWeightedReturn is the annualized percentage internal rate of return of the period's cash flows
+// @Description Shares bought and sold are valued at the close of the day of the operation, and dividends paid are treated as received from the portfolio
+// @Param		userId path int true "The ID of the user to get portfolio performance for"
+// @Param		period query string false "The period to calculate returns over. Available values are '1M', '3M', 'YTD', '1Y' and 'inception'. Default is every period"
+// @Produce 	json
+// @Success 	200 {object} models.PortfolioPerformance
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/stock-portfolio/performance [get]
+func (fmh *FinanceManagerHandler) GetUserStockPortfolioPerformance(w http.ResponseWriter, r *http.Request) {
+	method := "stocks_handler.GetUserStockPortfolioPerformance"
+	klogger.Enter(method)
+
+	//Read ID from url
+	id, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	periods := constants.ValidPerformancePeriods
+	p := r.URL.Query().Get("period")
+
+	if p != "" {
+		if !slices.Contains(constants.ValidPerformancePeriods, p) {
+			err = errors.New(constants.PerformanceInvalidPeriodError)
+			fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+			klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+			return
+		}
+
+		periods = []string{p}
+	}
+
+	pp, err := fmh.Service.GetUserPortfolioPerformance(id, periods, time.Now())
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.GenericServerError, err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, pp)
+}
@@ -3,7 +3,10 @@ package fmhandler
 import (
 	"encoding/json"
 	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/enums/stockoperation"
 	"finance-manager-backend/internal/finance-mngr/models"
+	"finance-manager-backend/internal/finance-mngr/models/restmodels"
+	"finance-manager-backend/internal/finance-mngr/service/polygonservice"
 	"finance-manager-backend/test"
 	"net/http"
 	"testing"
@@ -50,6 +53,204 @@ func TestGetUserStocks_403(t *testing.T) {
 	klogger.Exit(method)
 }
 
+func TestGetUserStockTransactions_403(t *testing.T) {
+	method := "stocks_handler_test.TestGetUserStockTransactions_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWTWithId(t, 3)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/stock-transactions", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetUserStockPortfolioHistory_400(t *testing.T) {
+	method := "stocks_handler_test.TestGetUserStockPortfolioHistory_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/stock-portfolio-history?benchmarks=SPY,QQQ,DIA,IWM,VTI,VOO", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetUserStockPortfolioHistory_benchmarks(t *testing.T) {
+	method := "stocks_handler_test.TestGetUserStockPortfolioHistory_benchmarks"
+	klogger.Enter(method)
+
+	token := test.GetUserJWTWithId(t, 3)
+	var resp models.StockPortfolioHistoryResponse
+
+	d := time.Now()
+	d = time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.Local)
+
+	p.GormDB.Exec("DELETE FROM user_stocks WHERE user_id = 3")
+
+	//The portfolio rises 20% while the benchmark rises 10%
+	us := models.UserStock{
+		UserId:      3,
+		Ticker:      "TSTP",
+		Quantity:    2,
+		Type:        constants.UserStockTypeOwn,
+		EffectiveDt: d.Add(-3 * 24 * time.Hour),
+	}
+	fmh.DB.InsertUserStock(us)
+
+	b := models.Stock{Ticker: "TSTB", Close: 55, Date: d, CreateDt: time.Now(), LastUpdateDt: time.Now()}
+	p.GormDB.Create(&b)
+
+	pc := []float64{10, 11, 12}
+	bc := []float64{50, 50, 55}
+
+	for i := range pc {
+		sd := d.Add(time.Duration(i-2) * 24 * time.Hour)
+		p.GormDB.Create(&models.StockData{Ticker: "TSTP", Close: pc[i], Date: sd})
+		p.GormDB.Create(&models.StockData{Ticker: "TSTB", Close: bc[i], Date: sd})
+	}
+
+	writer := MakeRequest(http.MethodGet, "/users/3/stock-portfolio-history?benchmarks=tstb", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	err := json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, resp.Count)
+	assert.Equal(t, 1, len(resp.Benchmarks))
+
+	//The benchmark is normalized to the portfolio's starting value
+	bm := resp.Benchmarks[0]
+	assert.Equal(t, "TSTB", bm.Ticker)
+	assert.Equal(t, 3, len(bm.Items))
+	assert.Equal(t, 20.0, bm.Items[0].Value)
+	assert.Equal(t, 20.0, bm.Items[1].Value)
+	assert.Equal(t, 22.0, bm.Items[2].Value)
+
+	assert.Equal(t, 10.0, bm.Return)
+	assert.Equal(t, 20.0, bm.PortfolioReturn)
+	assert.Equal(t, 10.0, bm.ExcessReturn)
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM user_stocks WHERE user_id = 3")
+	p.GormDB.Exec("DELETE FROM stock_data WHERE ticker IN ('TSTP', 'TSTB')")
+	p.GormDB.Exec("DELETE FROM stocks WHERE ticker = 'TSTB'")
+
+	klogger.Exit(method)
+}
+
+func TestGetUserStockPortfolioHistory_404(t *testing.T) {
+	method := "stocks_handler_test.TestGetUserStockPortfolioHistory_404"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	//Tickers the remote API has no data for are not found
+	es := fmh.ExternalService
+	fmh.ExternalService = &notFoundStockService{}
+
+	writer := MakeRequest(http.MethodGet, "/users/2/stock-portfolio-history?benchmarks=NOTATICKER", nil, true, token)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	fmh.ExternalService = es
+
+	klogger.Exit(method)
+}
+
+func TestGetUserStockPortfolioHistory_403(t *testing.T) {
+	method := "stocks_handler_test.TestGetUserStockPortfolioHistory_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWTWithId(t, 3)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/stock-portfolio-history?benchmarks=SPY", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetUserStockPortfolioPerformance_400(t *testing.T) {
+	method := "stocks_handler_test.TestGetUserStockPortfolioPerformance_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/stock-portfolio/performance?period=invalid", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	writer = MakeRequest(http.MethodGet, "/users/2/stock-portfolio/performance?period=1m", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetUserStockPortfolioPerformance_403(t *testing.T) {
+	method := "stocks_handler_test.TestGetUserStockPortfolioPerformance_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWTWithId(t, 3)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/stock-portfolio/performance", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestModifyStockOperation_sameDaySpecificID(t *testing.T) {
+	method := "stocks_handler_test.TestModifyStockOperation_sameDaySpecificID"
+	klogger.Enter(method)
+
+	token := test.GetUserJWTWithId(t, 3)
+
+	d := time.Now().AddDate(0, 0, -1)
+	d = time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+
+	p.GormDB.Create(&models.Stock{Ticker: "TSTL", Close: 10, Date: d, CreateDt: time.Now(), LastUpdateDt: time.Now()})
+
+	buy := restmodels.ModifyStockRequest{
+		Ticker:    "TSTL",
+		Amount:    10,
+		Operation: stockoperation.Add,
+		Date:      d,
+		Price:     10,
+	}
+
+	writer := MakeRequest(http.MethodPost, "/users/3/stock-operation", buy, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	tarr, err := fmh.DB.GetAllUserStockTransactions(3, "TSTL")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tarr))
+
+	//A sale of the lot bought earlier the same day is taken from it
+	sell := restmodels.ModifyStockRequest{
+		Ticker:    "TSTL",
+		Amount:    4,
+		Operation: stockoperation.Remove,
+		Date:      d,
+		Price:     12,
+		LotMethod: constants.LotMethodSpecificID,
+		Lots:      []restmodels.StockLotSelection{{LotID: tarr[0].ID, Quantity: 4}},
+	}
+
+	writer = MakeRequest(http.MethodPost, "/users/3/stock-operation", sell, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	//The lot cannot be sold past what is left of it
+	sell.Amount = 7
+	sell.Lots[0].Quantity = 7
+	writer = MakeRequest(http.MethodPost, "/users/3/stock-operation", sell, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM stock_transactions WHERE ticker = 'TSTL'")
+	p.GormDB.Exec("DELETE FROM user_stocks WHERE ticker = 'TSTL'")
+	p.GormDB.Exec("DELETE FROM stock_data WHERE ticker = 'TSTL'")
+	p.GormDB.Exec("DELETE FROM stocks WHERE ticker = 'TSTL'")
+
+	klogger.Exit(method)
+}
+
 func setupStockHandlerTestData() {
 
 	s1 := models.Stock{
@@ -94,3 +295,12 @@ func teardownStockHandlertestData() {
 	p.GormDB.Delete(us1)
 
 }
+
+// Type notFoundStockService is a remote stock API that has no data for any ticker
+type notFoundStockService struct {
+	polygonservice.PolygonService
+}
+
+func (s *notFoundStockService) FetchStockWithTickerForPastYear(ticker string) ([]models.Stock, error) {
+	return nil, nil
+}
@@ -3,9 +3,12 @@ package fmhandler
 import (
 	"errors"
 	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
 	"finance-manager-backend/internal/finance-mngr/models"
+	"fmt"
 	"math"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -17,11 +20,17 @@ import (
 // @version 	1.0.0
 // @Tags 		Summary
 // @Summary 	Get Finance Summary
-// @Description Gets a summary of all financial data for a user
+// @Description Gets a summary of all financial data for a user for the current month
+// @Description Net worth is the balance of the user's bank accounts at the end of the month less their loan and credit card balances
+// @Description Actual spending is the total withdrawn from the user's bank accounts during the month, and categoryTotals break the month's transactions down by category to compare against the planned bills
+// @Description Budgets whose spending for the month is more than their limit and any amount rolled over into it are flagged in overspentBudgets
+// @Description When includeDividends is true the dividends paid to the user during the month are added to their income
 // @Param		userId path int true "User ID"
+// @Param		includeDividends query bool false "Whether to include stock dividends in income"
 // @Accept		json
 // @Produce 	json
 // @Success 	200 {object} models.Summary
+// @Failure 	400 {object} jsonutils.JSONResponse
 // @Failure 	403 {object} jsonutils.JSONResponse
 // @Failure 	404 {object} jsonutils.JSONResponse
 // @Failure 	500 {object} jsonutils.JSONResponse
@@ -40,49 +49,247 @@ func (fmh *FinanceManagerHandler) GetUserSummary(w http.ResponseWriter, r *http.
 		return
 	}
 
-	summary := models.Summary{}
+	includeDividends, err := parseIncludeDividendsParam(r)
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
 
-	loans, err := fmh.DB.GetAllUserLoans(id, "")
+	summary, err := fmh.Service.GetUserSummary(id, time.Now(), includeDividends)
 	if err != nil {
-		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
-		klogger.ExitError(method, "failed to retrieve user loans:\n%v", err)
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, "failed to build user summary:\n%v", err)
 		return
 	}
 
-	incomes, err := fmh.DB.GetAllUserIncomes(id, "")
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, summary)
+}
+
+// GetUserSummaryProjection godoc
+// @title		Get Finance Summary Projection
+// @version 	1.0.0
+// @Tags 		Summary
+// @Summary 	Get Finance Summary Projection
+// @Description Gets a summary of all financial data for a user for each of a number of months beginning with the current month
+// @Description Loan balances decline per their amortization and credit card balances per their minimum payments in each projected month
+// @Description When includeDividends is true the dividends paid to the user during each month are added to their income
+// @Param		userId path int true "User ID"
+// @Param		months query int true "The number of months to project"
+// @Param		includeDividends query bool false "Whether to include stock dividends in income"
+// @Accept		json
+// @Produce 	json
+// @Success 	200 {array} models.Summary
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	404 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/summary/projection [get]
+func (fmh *FinanceManagerHandler) GetUserSummaryProjection(w http.ResponseWriter, r *http.Request) {
+	method := "summary_handler.GetUserSummaryProjection"
+	klogger.Enter(method)
+
+	//Read ID from url
+	id, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
 	if err != nil {
 		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
-		klogger.ExitError(method, "failed to retrieve user incomes:\n%v", err)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+
+		return
+	}
+
+	months, err := strconv.Atoi(r.URL.Query().Get("months"))
+
+	if err != nil || months < 1 || months > constants.SummaryMaxMonths {
+		err = fmt.Errorf("months param must be a number between 1 and %d", constants.SummaryMaxMonths)
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
 		return
 	}
 
-	bills, err := fmh.DB.GetAllUserBills(id, "")
+	includeDividends, err := parseIncludeDividendsParam(r)
 	if err != nil {
-		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
-		klogger.ExitError(method, "failed to retrieve user bills:\n%v", err)
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
 		return
 	}
 
-	ccs, err := fmh.DB.GetAllUserCreditCards(id, "")
+	summaries, err := fmh.Service.GetUserSummaryProjection(id, time.Now(), months, includeDividends)
 	if err != nil {
-		fmh.JSONUtil.ErrorJSON(w, err, http.StatusInternalServerError)
-		klogger.ExitError(method, "failed to retrieve user credit cards:\n%v", err)
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, "failed to build user summaries:\n%v", err)
+		return
+	}
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, summaries)
+}
+
+// Function parseIncludeDividendsParam reads the includeDividends query param of a request, which defaults to false.
+// Returns an error when it is not a bool
+func parseIncludeDividendsParam(r *http.Request) (bool, error) {
+	method := "summary_handler.parseIncludeDividendsParam"
+	klogger.Enter(method)
+
+	if r.URL.Query().Get("includeDividends") == "" {
+		klogger.Exit(method)
+		return false, nil
+	}
+
+	includeDividends, err := strconv.ParseBool(r.URL.Query().Get("includeDividends"))
+	if err != nil {
+		err = errors.New("includeDividends param must be true or false")
+		klogger.ExitError(method, err.Error())
+		return false, err
+	}
+
+	klogger.Exit(method)
+	return includeDividends, nil
+}
+
+// GetUserSummaryHistory godoc
+// @title		Get Finance Summary History
+// @version 	1.0.0
+// @Tags 		Summary
+// @Summary 	Get Finance Summary History
+// @Description Gets the month end snapshots of a user's summary taken between two dates, ordered by date
+// @Param		userId path int true "User ID"
+// @Param		from query string false "First date of the history in YYYY-MM-DD format. Default is twelve months before to"
+// @Param		to query string false "Last date of the history in YYYY-MM-DD format. Default is today"
+// @Produce 	json
+// @Success 	200 {array} models.SummarySnapshot
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/summary/history [get]
+func (fmh *FinanceManagerHandler) GetUserSummaryHistory(w http.ResponseWriter, r *http.Request) {
+	method := "summary_handler.GetUserSummaryHistory"
+	klogger.Enter(method)
+
+	//Read ID from url
+	id, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
 		return
 	}
 
-	for _, i := range incomes {
-		i.PopulateEmptyValues(time.Now())
+	from, to, err := parseDateRangeParams(r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
 	}
 
-	summary.LoadLoans(loans)
-	summary.LoadIncomes(incomes)
-	summary.LoadBills(bills)
-	summary.LoadCreditCards(ccs)
+	snapshots, err := fmh.DB.GetAllUserSummarySnapshots(id, from, to)
 
-	summary.Finalize()
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, "failed to retrieve user summary snapshots:\n%v", err)
+		return
+	}
 
 	klogger.Exit(method)
-	fmh.JSONUtil.WriteJSON(w, http.StatusOK, summary)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, snapshots)
+}
+
+// GetUserCategoryTotals godoc
+// @title		Get Category Totals
+// @version 	1.0.0
+// @Tags 		Summary
+// @Summary 	Get Category Totals
+// @Description Totals a user's bank account transactions by category for each month between two dates. Transfers between accounts are not included
+// @Param		userId path int true "User ID"
+// @Param		from query string false "A date in the first month to total in YYYY-MM-DD format. Default is twelve months before to"
+// @Param		to query string false "A date in the last month to total in YYYY-MM-DD format. Default is today"
+// @Produce 	json
+// @Success 	200 {array} models.MonthlyCategoryTotals
+// @Failure 	400 {object} jsonutils.JSONResponse
+// @Failure 	403 {object} jsonutils.JSONResponse
+// @Failure 	500 {object} jsonutils.JSONResponse
+// @Router 		/users/{userId}/summary/categories [get]
+func (fmh *FinanceManagerHandler) GetUserCategoryTotals(w http.ResponseWriter, r *http.Request) {
+	method := "summary_handler.GetUserCategoryTotals"
+	klogger.Enter(method)
+
+	//Read ID from url
+	id, err := fmh.GetAndValidateUserId(chi.URLParam(r, "userId"), w, r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusForbidden)
+		klogger.ExitError(method, constants.EntityDoesNotBelongToUserError, err)
+		return
+	}
+
+	from, to, err := parseDateRangeParams(r)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, err, http.StatusBadRequest)
+		klogger.ExitError(method, constants.GenericBadRequestErrorLog, err)
+		return
+	}
+
+	transactions, err := fmh.DB.GetAllUserBankAccountTransactions(id)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, "failed to retrieve user bank account transactions:\n%v", err)
+		return
+	}
+
+	totals := models.GetMonthlyCategoryTotals(transactions, from, fmUtil.GetMonthEndDate(to))
+
+	klogger.Exit(method)
+	fmh.JSONUtil.WriteJSON(w, http.StatusOK, totals)
+}
+
+// Function parseDateRangeParams reads the from and to query params of a request in YYYY-MM-DD format. to defaults to today and from
+// to SummaryHistoryDefaultMonths months before to. Returns an error when either is not a date or to is before from
+func parseDateRangeParams(r *http.Request) (time.Time, time.Time, error) {
+	method := "summary_handler.parseDateRangeParams"
+	klogger.Enter(method)
+
+	fromStr := r.URL.Query().Get("from")
+	toStr := r.URL.Query().Get("to")
+
+	to := fmUtil.GetStartOfDay(time.Now())
+	var from time.Time
+	var err error
+
+	if toStr != "" {
+		to, err = time.Parse(constants.DateParamFormat, toStr)
+
+		if err != nil {
+			err = errors.New("to param must be a date in YYYY-MM-DD format")
+			klogger.ExitError(method, err.Error())
+			return from, to, err
+		}
+	}
+
+	if fromStr != "" {
+		from, err = time.Parse(constants.DateParamFormat, fromStr)
+
+		if err != nil {
+			err = errors.New("from param must be a date in YYYY-MM-DD format")
+			klogger.ExitError(method, err.Error())
+			return from, to, err
+		}
+	} else {
+		from = fmUtil.AddMonths(to, -1*constants.SummaryHistoryDefaultMonths)
+	}
+
+	if to.Before(from) {
+		err = errors.New("to date cannot be before from date")
+		klogger.ExitError(method, err.Error())
+		return from, to, err
+	}
+
+	klogger.Exit(method)
+	return from, to, nil
 }
 
 // GetUserStockPortfolioSummary godoc
@@ -91,6 +298,8 @@ func (fmh *FinanceManagerHandler) GetUserSummary(w http.ResponseWriter, r *http.
 // @Tags 		Summary
 // @Summary 	Get Stock Portfolio Summary
 // @Description Gets a summary of all stock data for a user
+// @Description Positions with recorded buys report their open lots, cost basis and unrealized gain split into short-term and long-term, and the summary reports realized gains by tax year
+// @Description Dividends paid to the user for the shares they held before each ex-dividend date are listed and totaled in dividendIncome
 // @Param		userId path int true "User ID"
 // @Accept		json
 // @Produce 	json
@@ -120,6 +329,38 @@ func (fmh *FinanceManagerHandler) GetUserStockPortfolioSummary(w http.ResponseWr
 		return
 	}
 
+	tarr, err := fmh.DB.GetAllUserStockTransactions(id, "")
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.UnexpectedSQLError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	sarr, err := fmh.DB.GetStockSplitsByTicker("")
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.UnexpectedSQLError), http.StatusInternalServerError)
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return
+	}
+
+	lots, gains, err := models.BuildStockLots(tarr, sarr)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, "failed to build user stock lots:\n%v", err)
+		return
+	}
+
+	dividends, err := fmh.Service.GetUserDividendPayments(id, time.Now())
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New(constants.GenericServerError), http.StatusInternalServerError)
+		klogger.ExitError(method, "failed to load user dividends:\n%v", err)
+		return
+	}
+
 	//Generate list of user positions
 	var pl []models.PortfolioPosition
 	var sum models.UserStockPortfolioSummary
@@ -144,10 +385,14 @@ func (fmh *FinanceManagerHandler) GetUserStockPortfolioSummary(w http.ResponseWr
 			AsOfDate: s.Date,
 		}
 
+		p.LoadLots(lots, gains, time.Now())
+		p.LoadDividends(dividends, time.Now())
 		pl = append(pl, p)
 	}
 
 	//Load positions into summary object
+	sum.LoadRealizedGains(gains)
+	sum.LoadDividends(dividends, time.Now())
 	sum.LoadPositions(pl)
 
 	fmh.JSONUtil.WriteJSON(w, http.StatusOK, sum)
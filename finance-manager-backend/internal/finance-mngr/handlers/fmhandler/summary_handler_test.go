@@ -91,3 +91,139 @@ func teardownStocktestData() {
 	p.GormDB.Delete(us1)
 
 }
+
+func TestGetUserSummary_400(t *testing.T) {
+	method := "summary_handler_test.TestGetUserSummary_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/summary?includeDividends=invalid", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetUserSummaryProjection_200(t *testing.T) {
+	method := "summary_handler_test.TestGetUserSummaryProjection_200"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+	var resp []models.Summary
+
+	writer := MakeRequest(http.MethodGet, "/users/2/summary/projection?months=3", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	err := json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(resp))
+
+	klogger.Exit(method)
+}
+
+func TestGetUserSummaryProjection_400(t *testing.T) {
+	method := "summary_handler_test.TestGetUserSummaryProjection_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/summary/projection", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	writer = MakeRequest(http.MethodGet, "/users/2/summary/projection?months=invalid", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	writer = MakeRequest(http.MethodGet, "/users/2/summary/projection?months=0", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	writer = MakeRequest(http.MethodGet, "/users/2/summary/projection?months=61", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	writer = MakeRequest(http.MethodGet, "/users/2/summary/projection?months=3&includeDividends=invalid", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetUserSummaryProjection_403(t *testing.T) {
+	method := "summary_handler_test.TestGetUserSummaryProjection_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/summary/projection?months=3", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetUserSummaryHistory_200(t *testing.T) {
+	method := "summary_handler_test.TestGetUserSummaryHistory_200"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+	var resp []models.SummarySnapshot
+
+	writer := MakeRequest(http.MethodGet, "/users/2/summary/history", nil, true, token)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	err := json.Unmarshal(writer.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(resp))
+
+	klogger.Exit(method)
+}
+
+func TestGetUserSummaryHistory_400(t *testing.T) {
+	method := "summary_handler_test.TestGetUserSummaryHistory_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/summary/history?from=invalid", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	writer = MakeRequest(http.MethodGet, "/users/2/summary/history?from=2024-02-01&to=2024-01-01", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetUserSummaryHistory_403(t *testing.T) {
+	method := "summary_handler_test.TestGetUserSummaryHistory_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/summary/history", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetUserCategoryTotals_400(t *testing.T) {
+	method := "summary_handler_test.TestGetUserCategoryTotals_400"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/2/summary/categories?from=invalid", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	writer = MakeRequest(http.MethodGet, "/users/2/summary/categories?from=2024-02-01&to=2024-01-01", nil, true, token)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+
+	klogger.Exit(method)
+}
+
+func TestGetUserCategoryTotals_403(t *testing.T) {
+	method := "summary_handler_test.TestGetUserCategoryTotals_403"
+	klogger.Enter(method)
+
+	token := test.GetUserJWT(t)
+
+	writer := MakeRequest(http.MethodGet, "/users/1/summary/categories", nil, true, token)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+
+	klogger.Exit(method)
+}
@@ -156,6 +156,78 @@ func (fmh *FinanceManagerHandler) DeleteUserById(w http.ResponseWriter, r *http.
 		return
 	}
 
+	err = fmh.DB.DeleteIncomeVersionsByUserID(id)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New("an unexpected error occured while attempting to delete the user"), http.StatusNotFound)
+		klogger.ExitError(method, "failed to delete user income versions:\n%v", err)
+		return
+	}
+
+	err = fmh.DB.DeleteSavingsGoalContributionsByUserID(id)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New("an unexpected error occured while attempting to delete the user"), http.StatusNotFound)
+		klogger.ExitError(method, "failed to delete user savings goal contributions:\n%v", err)
+		return
+	}
+
+	err = fmh.DB.DeleteSavingsGoalsByUserID(id)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New("an unexpected error occured while attempting to delete the user"), http.StatusNotFound)
+		klogger.ExitError(method, "failed to delete user savings goals:\n%v", err)
+		return
+	}
+
+	err = fmh.DB.DeleteStockTransactionsByUserID(id)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New("an unexpected error occured while attempting to delete the user"), http.StatusNotFound)
+		klogger.ExitError(method, "failed to delete user stock transactions:\n%v", err)
+		return
+	}
+
+	err = fmh.DB.DeleteBudgetsByUserID(id)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New("an unexpected error occured while attempting to delete the user"), http.StatusNotFound)
+		klogger.ExitError(method, "failed to delete user budgets:\n%v", err)
+		return
+	}
+
+	err = fmh.DB.DeleteCategorizationRulesByUserID(id)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New("an unexpected error occured while attempting to delete the user"), http.StatusNotFound)
+		klogger.ExitError(method, "failed to delete user categorization rules:\n%v", err)
+		return
+	}
+
+	err = fmh.DB.DeleteBankAccountTransactionsByUserID(id)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New("an unexpected error occured while attempting to delete the user"), http.StatusNotFound)
+		klogger.ExitError(method, "failed to delete user bank account transactions:\n%v", err)
+		return
+	}
+
+	err = fmh.DB.DeleteBankAccountsByUserID(id)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New("an unexpected error occured while attempting to delete the user"), http.StatusNotFound)
+		klogger.ExitError(method, "failed to delete user bank accounts:\n%v", err)
+		return
+	}
+
+	err = fmh.DB.DeleteIncomeHoursByUserID(id)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New("an unexpected error occured while attempting to delete the user"), http.StatusNotFound)
+		klogger.ExitError(method, "failed to delete user income hours:\n%v", err)
+		return
+	}
+
 	err = fmh.DB.DeleteIncomesByUserID(id)
 
 	if err != nil {
@@ -164,6 +236,22 @@ func (fmh *FinanceManagerHandler) DeleteUserById(w http.ResponseWriter, r *http.
 		return
 	}
 
+	err = fmh.DB.DeleteSummarySnapshotsByUserID(id)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New("an unexpected error occured while attempting to delete the user"), http.StatusNotFound)
+		klogger.ExitError(method, "failed to delete user summary snapshots:\n%v", err)
+		return
+	}
+
+	err = fmh.DB.DeleteLoanPaymentsByUserID(id)
+
+	if err != nil {
+		fmh.JSONUtil.ErrorJSON(w, errors.New("an unexpected error occured while attempting to delete the user"), http.StatusNotFound)
+		klogger.ExitError(method, "failed to delete user loan payments:\n%v", err)
+		return
+	}
+
 	err = fmh.DB.DeleteLoansByUserID(id)
 
 	if err != nil {
@@ -4,6 +4,77 @@ import "net/http"
 
 type Handler interface {
 
+	/*** Bank Accounts ***/
+
+	//Deletes a specific Bank Account and its transactions by its id for a given user
+	DeleteBankAccountById(w http.ResponseWriter, r *http.Request)
+
+	//Fetches all Bank Accounts with their balances for a given user and accepts a search parameter
+	GetAllUserBankAccounts(w http.ResponseWriter, r *http.Request)
+
+	//Fetches a specific Bank Account with its balance and transactions by its id for a given user
+	GetBankAccountById(w http.ResponseWriter, r *http.Request)
+
+	//Imports the transactions of an OFX, QFX or CSV bank statement to a specific Bank Account for a given user
+	ImportBankStatement(w http.ResponseWriter, r *http.Request)
+
+	//Inserts a new Bank Account into the database for a given user
+	SaveBankAccount(w http.ResponseWriter, r *http.Request)
+
+	//Updates a specific Bank Account by its id for a given user
+	UpdateBankAccount(w http.ResponseWriter, r *http.Request)
+
+	/*** Bank Account Transactions ***/
+
+	//Deletes a specific Bank Account Transaction, and the other side of a transfer, by its id for a given account
+	DeleteBankAccountTransactionById(w http.ResponseWriter, r *http.Request)
+
+	//Fetches all Bank Account Transactions for a given account
+	GetAllBankAccountTransactions(w http.ResponseWriter, r *http.Request)
+
+	//Inserts a new Bank Account Transaction, or both sides of a transfer, into the database for a given account
+	SaveBankAccountTransaction(w http.ResponseWriter, r *http.Request)
+
+	/*** Budgets ***/
+
+	//Deletes a specific Budget by its id for a given user
+	DeleteBudgetById(w http.ResponseWriter, r *http.Request)
+
+	//Fetches all Budgets for a given user
+	GetAllUserBudgets(w http.ResponseWriter, r *http.Request)
+
+	//Fetches a specific Budget by its id for a given user
+	GetBudgetById(w http.ResponseWriter, r *http.Request)
+
+	//Compares each Budget of a given user to their planned and actual spending for a month
+	GetUserBudgetStatuses(w http.ResponseWriter, r *http.Request)
+
+	//Inserts a new Budget into the database for a given user
+	SaveBudget(w http.ResponseWriter, r *http.Request)
+
+	//Updates a specific Budget by its id for a given user
+	UpdateBudget(w http.ResponseWriter, r *http.Request)
+
+	/*** Categorization Rules ***/
+
+	//Re-runs all Categorization Rules against the bank account transactions of a given user
+	ApplyCategorizationRules(w http.ResponseWriter, r *http.Request)
+
+	//Deletes a specific Categorization Rule by its id for a given user
+	DeleteCategorizationRuleById(w http.ResponseWriter, r *http.Request)
+
+	//Fetches all Categorization Rules for a given user in the order they are applied
+	GetAllUserCategorizationRules(w http.ResponseWriter, r *http.Request)
+
+	//Fetches a specific Categorization Rule by its id for a given user
+	GetCategorizationRuleById(w http.ResponseWriter, r *http.Request)
+
+	//Inserts a new Categorization Rule into the database for a given user
+	SaveCategorizationRule(w http.ResponseWriter, r *http.Request)
+
+	//Updates a specific Categorization Rule by its id for a given user
+	UpdateCategorizationRule(w http.ResponseWriter, r *http.Request)
+
 	/*** Bills ***/
 
 	//Deletes a specific bill object by its id for a given user
@@ -32,12 +103,25 @@ type Handler interface {
 	//Fetches a specific CreditCard for a given user
 	GetCreditCardById(w http.ResponseWriter, r *http.Request)
 
+	//Projects the payoff of a specific CreditCard for a given user
+	GetCreditCardProjection(w http.ResponseWriter, r *http.Request)
+
 	//Inserts a new CreditCard into the database for a given user
 	SaveCreditCard(w http.ResponseWriter, r *http.Request)
 
 	//Updates a specific CreditCard by its id for a given user
 	UpdateCreditCard(w http.ResponseWriter, r *http.Request)
 
+	/*** Debt Plan ***/
+
+	//Simulates paying off all Loans and CreditCards for a given user with a monthly budget
+	GetDebtPlan(w http.ResponseWriter, r *http.Request)
+
+	/*** Calendar ***/
+
+	//Returns a dated list of a user's expected incomes and expenses with a running projected balance
+	GetUserCalendar(w http.ResponseWriter, r *http.Request)
+
 	/*** Home ***/
 
 	//Returns API information as a heartbeat
@@ -60,6 +144,28 @@ type Handler interface {
 	//Updates a specific Income by its id for a given user
 	UpdateIncome(w http.ResponseWriter, r *http.Request)
 
+	/*** Income Versions ***/
+
+	//Deletes a specific Income Version by its id for a given income
+	DeleteIncomeVersionById(w http.ResponseWriter, r *http.Request)
+
+	//Fetches all Income Versions for a given income
+	GetAllIncomeVersions(w http.ResponseWriter, r *http.Request)
+
+	//Inserts a new Income Version into the database for a given income
+	SaveIncomeVersion(w http.ResponseWriter, r *http.Request)
+
+	/*** Income Hours ***/
+
+	//Deletes specific logged Income Hours by its id for a given income
+	DeleteIncomeHoursById(w http.ResponseWriter, r *http.Request)
+
+	//Fetches all logged Income Hours for a given income
+	GetAllIncomeHours(w http.ResponseWriter, r *http.Request)
+
+	//Logs the hours worked in a pay period for a given income
+	SaveIncomeHours(w http.ResponseWriter, r *http.Request)
+
 	/*** Loans ***/
 
 	//Performs a payment schedule calculation on a Loan object
@@ -80,12 +186,29 @@ type Handler interface {
 	//Generates a summary from all Loans for a specific user
 	GetLoanSummary(w http.ResponseWriter, r *http.Request)
 
+	//Compares a Loan's remaining balance against refinancing it and returns the break even month
+	RefinanceLoan(w http.ResponseWriter, r *http.Request)
+
 	//Inserts a new Loan into the database for a given user
 	SaveLoan(w http.ResponseWriter, r *http.Request)
 
 	//Updates a specific Loan by its id for a given user
 	UpdateLoan(w http.ResponseWriter, r *http.Request)
 
+	/*** Loan Payments ***/
+
+	//Compares the scheduled payments of a Loan against the payments recorded against it
+	CompareLoanActualPayments(w http.ResponseWriter, r *http.Request)
+
+	//Deletes a specific Loan Payment by its id for a given loan
+	DeleteLoanPaymentById(w http.ResponseWriter, r *http.Request)
+
+	//Fetches all Loan Payments for a given loan
+	GetAllLoanPayments(w http.ResponseWriter, r *http.Request)
+
+	//Inserts a new Loan Payment into the database for a given loan
+	SaveLoanPayment(w http.ResponseWriter, r *http.Request)
+
 	/*** Login ***/
 
 	//Validates supplied credentials then generates and returns a JWT TokenPair
@@ -110,6 +233,34 @@ type Handler interface {
 	//Calculates a savings request
 	CalcSavingsRequest(w http.ResponseWriter, r *http.Request)
 
+	/*** Savings Goals ***/
+
+	//Deletes a specific Savings Goal and its contributions by its id for a given user
+	DeleteSavingsGoalById(w http.ResponseWriter, r *http.Request)
+
+	//Fetches all Savings Goals for a given user and accepts a search parameter
+	GetAllUserSavingsGoals(w http.ResponseWriter, r *http.Request)
+
+	//Fetches a specific Savings Goal by its id for a given user
+	GetSavingsGoalById(w http.ResponseWriter, r *http.Request)
+
+	//Inserts a new Savings Goal into the database for a given user
+	SaveSavingsGoal(w http.ResponseWriter, r *http.Request)
+
+	//Updates a specific Savings Goal by its id for a given user
+	UpdateSavingsGoal(w http.ResponseWriter, r *http.Request)
+
+	/*** Savings Goal Contributions ***/
+
+	//Deletes a specific Savings Goal Contribution by its id for a given goal
+	DeleteSavingsGoalContributionById(w http.ResponseWriter, r *http.Request)
+
+	//Fetches all Savings Goal Contributions for a given goal
+	GetAllSavingsGoalContributions(w http.ResponseWriter, r *http.Request)
+
+	//Inserts a new Savings Goal Contribution into the database for a given goal
+	SaveSavingsGoalContribution(w http.ResponseWriter, r *http.Request)
+
 	/*** Registration ***/
 
 	//Validates and Inserts a new User into the database
@@ -125,6 +276,9 @@ type Handler interface {
 
 	GetUserStockPortfolioHistory(w http.ResponseWriter, r *http.Request)
 
+	//Gets the time-weighted and money-weighted returns of a user's stock portfolio and its positions
+	GetUserStockPortfolioPerformance(w http.ResponseWriter, r *http.Request)
+
 	/*** User Stocks ***/
 
 	//Saves New User Stocks object
@@ -138,6 +292,9 @@ type Handler interface {
 
 	ModifyStockOperation(w http.ResponseWriter, r *http.Request)
 
+	//Gets the buy and sell transactions recorded by a user's stock operations
+	GetUserStockTransactions(w http.ResponseWriter, r *http.Request)
+
 	/*** Users ***/
 
 	//Deletes a specific user by id
@@ -149,9 +306,18 @@ type Handler interface {
 	//Fetches a specific user object by id
 	GetUserByID(w http.ResponseWriter, r *http.Request)
 
+	//Fetches the monthly bank account transaction totals by category for a given user by id
+	GetUserCategoryTotals(w http.ResponseWriter, r *http.Request)
+
 	//Fetches a summary for a given user by id
 	GetUserSummary(w http.ResponseWriter, r *http.Request)
 
+	//Fetches a summary for each of a number of months for a given user by id
+	GetUserSummaryProjection(w http.ResponseWriter, r *http.Request)
+
+	//Fetches the month end summary snapshots for a given user by id
+	GetUserSummaryHistory(w http.ResponseWriter, r *http.Request)
+
 	/** User Roles **/
 
 	//Inserts a new UserRole into the database, granting access to a user
@@ -3,19 +3,37 @@ package jobs
 import (
 	"finance-manager-backend/internal/finance-mngr/application"
 	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"finance-manager-backend/internal/finance-mngr/models"
 	"time"
 
 	"github.com/jon-kamis/klogger"
 	"github.com/jon-kamis/klogger/pkg/loglevel"
 )
 
+// The most recent month end that summaries were snapshotted for
+var lastSummarySnapshotDt time.Time
+
+// The users whose summary could not be snapshotted for lastSummarySnapshotDt, which are retried until they succeed
+var failedSummarySnapshotUsers map[int]bool
+
+// The most recent day that scheduled bank account transactions were posted for
+var lastPostingDt time.Time
+
+// The users whose transactions could not be posted for lastPostingDt, which are retried until they succeed
+var failedPostingUsers map[int]bool
+
 func ScheduledMinuteJobs(tick *time.Ticker, app application.Application) {
 	method := "jobs.scheduleJobs"
 	klogger.Info(method, "started running in asynchronous thread")
 
 	updateStocks(time.Now(), app)
+	postScheduledTransactions(time.Now(), app)
+	snapshotSummaries(time.Now(), app)
 	for t := range tick.C {
 		updateStocks(t, app)
+		postScheduledTransactions(t, app)
+		snapshotSummaries(t, app)
 	}
 }
 
@@ -120,9 +138,184 @@ func updateStocks(t time.Time, app application.Application) {
 			return
 		}
 
+		//Save any new splits and dividends
+		updateCorporateActions(s.Ticker, app)
+
 	} else {
 		klogger.Trace(method, "oldest stock is up to date")
 	}
 
 	klogger.Exit(method)
 }
+
+// Function updateCorporateActions fetches the splits and dividends of a stock and saves those that have not already been saved.
+// Stock data and user stocks are adjusted for each new split
+func updateCorporateActions(ticker string, app application.Application) {
+	method := "jobs.updateCorporateActions"
+	klogger.Enter(method)
+
+	sarr, err := app.ExternalService.FetchStockSplitsWithTicker(ticker)
+
+	if err != nil {
+		klogger.Error(method, constants.UnexpectedExternalCallError, err)
+		klogger.Warn(method, "completed execution unsuccessfully")
+		return
+	}
+
+	darr, err := app.ExternalService.FetchStockDividendsWithTicker(ticker)
+
+	if err != nil {
+		klogger.Error(method, constants.UnexpectedExternalCallError, err)
+		klogger.Warn(method, "completed execution unsuccessfully")
+		return
+	}
+
+	n, err := app.Service.IngestStockCorporateActions(ticker, sarr, darr)
+
+	if err != nil {
+		klogger.Error(method, "failed to save corporate actions for %s:\n%v", ticker, err)
+		klogger.Warn(method, "completed execution unsuccessfully")
+		return
+	}
+
+	klogger.Debug(method, "saved %d corporate actions for %s", n, ticker)
+	klogger.Exit(method)
+}
+
+// Function snapshotSummaries saves a snapshot of each user's summary on the last day of each month.
+// Users that already have a snapshot for the day are skipped so the job can be safely rerun, and users that fail
+// are retried on the next run without stopping the snapshots of other users
+func snapshotSummaries(t time.Time, app application.Application) {
+	method := "jobs.snapshotSummaries"
+	klogger.Enter(method)
+
+	d := fmUtil.GetStartOfDay(t)
+
+	if d.AddDate(0, 0, 1).Month() == d.Month() {
+		klogger.Trace(method, "today is not the last day of the month")
+		klogger.Exit(method, loglevel.Trace)
+		return
+	}
+
+	retry := d.Equal(lastSummarySnapshotDt)
+
+	if retry && len(failedSummarySnapshotUsers) == 0 {
+		klogger.Trace(method, "summaries have already been snapshotted today")
+		klogger.Exit(method, loglevel.Trace)
+		return
+	}
+
+	users, err := app.DB.GetAllUsers("")
+
+	if err != nil {
+		klogger.Error(method, constants.UnexpectedSQLError, err)
+		klogger.Warn(method, "completed execution unsuccessfully")
+		return
+	}
+
+	failed := make(map[int]bool)
+	snapshotted := 0
+
+	for _, u := range users {
+		if retry && !failedSummarySnapshotUsers[u.ID] {
+			continue
+		}
+
+		ss, err := app.DB.GetSummarySnapshotByUserIDAndDate(u.ID, d)
+
+		if err != nil {
+			klogger.Error(method, constants.UnexpectedSQLError, err)
+			failed[u.ID] = true
+			continue
+		}
+
+		if ss.ID != 0 {
+			klogger.Trace(method, "summary for user %d has already been snapshotted", u.ID)
+			continue
+		}
+
+		s, err := app.Service.GetUserSummary(u.ID, d, false)
+
+		if err != nil {
+			klogger.Error(method, "failed to build summary for user %d:\n%v", u.ID, err)
+			failed[u.ID] = true
+			continue
+		}
+
+		_, err = app.DB.InsertSummarySnapshot(models.NewSummarySnapshot(u.ID, s, d))
+
+		if err != nil {
+			klogger.Error(method, constants.UnexpectedSQLError, err)
+			failed[u.ID] = true
+			continue
+		}
+
+		snapshotted++
+	}
+
+	lastSummarySnapshotDt = d
+	failedSummarySnapshotUsers = failed
+
+	klogger.Debug(method, "snapshotted summaries for %d users", snapshotted)
+
+	if len(failed) > 0 {
+		klogger.Warn(method, "failed to snapshot summaries for %d users, they will be retried", len(failed))
+	}
+
+	klogger.Exit(method)
+}
+
+// Function postScheduledTransactions posts the paydays and bill due dates linked to each user's bank accounts once a day.
+// Transactions that have already been posted are skipped so the job can be safely rerun, and users that fail
+// are retried on the next run without stopping the postings of other users
+func postScheduledTransactions(t time.Time, app application.Application) {
+	method := "jobs.postScheduledTransactions"
+	klogger.Enter(method)
+
+	d := fmUtil.GetStartOfDay(t)
+
+	retry := d.Equal(lastPostingDt)
+
+	if retry && len(failedPostingUsers) == 0 {
+		klogger.Trace(method, "transactions have already been posted today")
+		klogger.Exit(method, loglevel.Trace)
+		return
+	}
+
+	users, err := app.DB.GetAllUsers("")
+
+	if err != nil {
+		klogger.Error(method, constants.UnexpectedSQLError, err)
+		klogger.Warn(method, "completed execution unsuccessfully")
+		return
+	}
+
+	failed := make(map[int]bool)
+	posted := 0
+
+	for _, u := range users {
+		if retry && !failedPostingUsers[u.ID] {
+			continue
+		}
+
+		n, err := app.Service.PostScheduledTransactions(u.ID, t)
+		posted += n
+
+		if err != nil {
+			klogger.Error(method, "failed to post transactions for user %d:\n%v", u.ID, err)
+			failed[u.ID] = true
+			continue
+		}
+	}
+
+	lastPostingDt = d
+	failedPostingUsers = failed
+
+	klogger.Debug(method, "posted %d transactions", posted)
+
+	if len(failed) > 0 {
+		klogger.Warn(method, "failed to post transactions for %d users, they will be retried", len(failed))
+	}
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,135 @@
+package models
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type BankAccount is a checking, savings or cash account holding OpeningBalance on OpeningDt.
+// Balance is derived from the opening balance and the ledger of Transactions and is not persisted
+type BankAccount struct {
+	ID             int                      `json:"id"`
+	UserID         int                      `json:"userId"`
+	Name           string                   `json:"name"`
+	Type           string                   `json:"type"`
+	OpeningBalance float64                  `json:"openingBalance"`
+	OpeningDt      time.Time                `json:"openingDt"`
+	Balance        float64                  `json:"balance"`
+	Transactions   []BankAccountTransaction `json:"transactions" gorm:"-"`
+	CreateDt       time.Time                `json:"-"`
+	LastUpdateDt   time.Time                `json:"-"`
+}
+
+func (a *BankAccount) ValidateCanSaveBankAccount() error {
+	method := "BankAccount.ValidateCanSaveBankAccount"
+	klogger.Enter(method)
+
+	if a.Name == "" {
+		err := errors.New("cannot save bank account without a name")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if a.UserID <= 0 {
+		err := errors.New("userId is required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if !slices.Contains(constants.ValidBankAccountTypes, a.Type) {
+		err := errors.New("type is invalid")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if a.OpeningDt.IsZero() {
+		err := errors.New("opening date is required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function LoadTransactions attaches the transactions in tarr belonging to the account in the order they were made
+func (a *BankAccount) LoadTransactions(tarr []*BankAccountTransaction) {
+	method := "BankAccount.LoadTransactions"
+	klogger.Enter(method)
+
+	a.Transactions = []BankAccountTransaction{}
+	for _, t := range tarr {
+		if t.BankAccountID == a.ID {
+			a.Transactions = append(a.Transactions, *t)
+		}
+	}
+
+	sort.SliceStable(a.Transactions, func(i, j int) bool {
+		return a.Transactions[i].TransactionDt.Before(a.Transactions[j].TransactionDt)
+	})
+
+	klogger.Exit(method)
+}
+
+// Function CalcBalance sets the Balance of the account as of t. Transactions must already be loaded
+func (a *BankAccount) CalcBalance(t time.Time) {
+	method := "BankAccount.CalcBalance"
+	klogger.Enter(method)
+
+	a.Balance = a.GetBalanceForDate(t)
+
+	klogger.Exit(method)
+}
+
+// Function GetBalanceForDate returns the opening balance of the account plus each transaction made from its opening date through t
+func (a *BankAccount) GetBalanceForDate(t time.Time) float64 {
+	method := "BankAccount.GetBalanceForDate"
+	klogger.Enter(method)
+
+	s := fmUtil.GetStartOfDay(a.OpeningDt)
+	b := a.OpeningBalance
+
+	for _, tr := range a.Transactions {
+		if !tr.TransactionDt.Before(s) && !tr.TransactionDt.After(t) {
+			b += tr.GetSignedAmount()
+		}
+	}
+
+	klogger.Exit(method)
+	return math.Round(b*100) / 100
+}
+
+// Function GetUnpostedTransactions returns the transactions in parr scheduled for the account from its opening date on
+// that have not already been posted to its ledger. Transactions must already be loaded
+func (a *BankAccount) GetUnpostedTransactions(parr []BankAccountTransaction) []BankAccountTransaction {
+	method := "BankAccount.GetUnpostedTransactions"
+	klogger.Enter(method)
+
+	s := fmUtil.GetStartOfDay(a.OpeningDt)
+	var unposted []BankAccountTransaction
+
+	for _, p := range parr {
+		if p.BankAccountID != a.ID || p.TransactionDt.Before(s) {
+			continue
+		}
+
+		posted := slices.ContainsFunc(a.Transactions, func(t BankAccountTransaction) bool {
+			return t.Source == p.Source && t.SourceID == p.SourceID &&
+				fmUtil.GetStartOfDay(t.TransactionDt).Equal(fmUtil.GetStartOfDay(p.TransactionDt))
+		})
+
+		if !posted {
+			unposted = append(unposted, p)
+		}
+	}
+
+	klogger.Exit(method)
+	return unposted
+}
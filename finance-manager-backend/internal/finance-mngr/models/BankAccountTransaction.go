@@ -0,0 +1,145 @@
+package models
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"slices"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type BankAccountTransaction is an entry in the ledger of a BankAccount. Amount is always positive and Type decides whether it is added to
+// or taken from the balance. Transfers name the other account in TransferAccountID, and transactions posted for an income payday or a bill
+// due date name the income or bill in Source and SourceID. Transactions imported from a bank statement keep the payee it names and an
+// ExternalID, unique within the account, used to skip them when the statement is imported again. Category is set by hand or by a CategorizationRule
+type BankAccountTransaction struct {
+	ID                int               `json:"id"`
+	BankAccountID     int               `json:"bankAccountId" gorm:"uniqueIndex:bank_account_transactions_external_id_key,where:external_id <> ''"`
+	UserID            int               `json:"userId"`
+	Type              string            `json:"type"`
+	Amount            float64           `json:"amount"`
+	TransactionDt     time.Time         `json:"transactionDate"`
+	Description       string            `json:"description"`
+	TransferAccountID int               `json:"transferAccountId"`
+	Source            string            `json:"source"`
+	SourceID          int               `json:"sourceId"`
+	Payee             string            `json:"payee"`
+	ExternalID        string            `json:"externalId" gorm:"uniqueIndex:bank_account_transactions_external_id_key,where:external_id <> ''"`
+	Category          string            `json:"category"`
+	Suggestion        *TransactionMatch `json:"suggestion,omitempty" gorm:"-"`
+	CreateDt          time.Time         `json:"-"`
+	LastUpdateDt      time.Time         `json:"-"`
+}
+
+func (t *BankAccountTransaction) ValidateCanSaveBankAccountTransaction() error {
+	method := "BankAccountTransaction.ValidateCanSaveBankAccountTransaction"
+	klogger.Enter(method)
+
+	if t.BankAccountID <= 0 {
+		err := errors.New("cannot save transaction without bankAccountId")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if !slices.Contains(constants.ValidTransactionTypes, t.Type) {
+		err := errors.New("type is invalid")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if t.Amount <= 0 {
+		err := errors.New("amount must be positive")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if t.TransactionDt.IsZero() {
+		err := errors.New("cannot save transaction without transaction date")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if t.IsTransfer() && (t.TransferAccountID <= 0 || t.TransferAccountID == t.BankAccountID) {
+		err := errors.New("transfers require a different transferAccountId")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function IsTransfer returns true if the transaction is one side of a transfer between two accounts
+func (t *BankAccountTransaction) IsTransfer() bool {
+	method := "BankAccountTransaction.IsTransfer"
+	klogger.Enter(method)
+
+	isTransfer := t.Type == constants.TransactionTypeTransferIn || t.Type == constants.TransactionTypeTransferOut
+
+	klogger.Exit(method)
+	return isTransfer
+}
+
+// Function GetSignedAmount returns the amount the transaction changes its account's balance by
+func (t *BankAccountTransaction) GetSignedAmount() float64 {
+	method := "BankAccountTransaction.GetSignedAmount"
+	klogger.Enter(method)
+
+	if t.Type == constants.TransactionTypeWithdrawal || t.Type == constants.TransactionTypeTransferOut {
+		klogger.Exit(method)
+		return -1 * t.Amount
+	}
+
+	klogger.Exit(method)
+	return t.Amount
+}
+
+// Function NewTransfer returns the transfer-out and transfer-in that together move Amount between t's account and its TransferAccountID.
+// Transfer-outs move money from t's account and transfer-ins move money into it
+func (t *BankAccountTransaction) NewTransfer() (BankAccountTransaction, BankAccountTransaction) {
+	method := "BankAccountTransaction.NewTransfer"
+	klogger.Enter(method)
+
+	out := *t
+	out.Type = constants.TransactionTypeTransferOut
+	out.Source = ""
+	out.SourceID = 0
+
+	in := out
+	in.Type = constants.TransactionTypeTransferIn
+
+	if t.Type == constants.TransactionTypeTransferIn {
+		out.BankAccountID = t.TransferAccountID
+		out.TransferAccountID = t.BankAccountID
+	} else {
+		in.BankAccountID = t.TransferAccountID
+		in.TransferAccountID = t.BankAccountID
+	}
+
+	klogger.Exit(method)
+	return out, in
+}
+
+// Function FindTransferPair returns the other side of the transfer t from tarr, or nil if t is not a transfer or the other side is not found
+func (t *BankAccountTransaction) FindTransferPair(tarr []*BankAccountTransaction) *BankAccountTransaction {
+	method := "BankAccountTransaction.FindTransferPair"
+	klogger.Enter(method)
+
+	if !t.IsTransfer() {
+		klogger.Exit(method)
+		return nil
+	}
+
+	for _, o := range tarr {
+		if o.BankAccountID == t.TransferAccountID && o.TransferAccountID == t.BankAccountID && o.IsTransfer() && o.Type != t.Type &&
+			o.Amount == t.Amount && fmUtil.GetStartOfDay(o.TransactionDt).Equal(fmUtil.GetStartOfDay(t.TransactionDt)) {
+			klogger.Exit(method)
+			return o
+		}
+	}
+
+	klogger.Exit(method)
+	return nil
+}
@@ -0,0 +1,141 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCanSaveBankAccountTransaction(t *testing.T) {
+	method := "BankAccountTransaction_test.TestValidateCanSaveBankAccountTransaction"
+	klogger.Enter(method)
+
+	var tt BankAccountTransaction
+	tr := BankAccountTransaction{
+		BankAccountID: 1,
+		Type:          constants.TransactionTypeDeposit,
+		Amount:        100,
+		TransactionDt: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	assert.Nil(t, tr.ValidateCanSaveBankAccountTransaction())
+
+	//Account is required
+	tt = tr
+	tt.BankAccountID = 0
+	assert.NotNil(t, tt.ValidateCanSaveBankAccountTransaction())
+
+	//Type must be valid
+	tt = tr
+	tt.Type = "refund"
+	assert.NotNil(t, tt.ValidateCanSaveBankAccountTransaction())
+
+	//Amount must be positive
+	tt = tr
+	tt.Amount = -100
+	assert.NotNil(t, tt.ValidateCanSaveBankAccountTransaction())
+
+	//Transaction date is required
+	tt = tr
+	tt.TransactionDt = time.Time{}
+	assert.NotNil(t, tt.ValidateCanSaveBankAccountTransaction())
+
+	//Transfers require a different account
+	tt = tr
+	tt.Type = constants.TransactionTypeTransferOut
+	assert.NotNil(t, tt.ValidateCanSaveBankAccountTransaction())
+
+	tt.TransferAccountID = 1
+	assert.NotNil(t, tt.ValidateCanSaveBankAccountTransaction())
+
+	tt.TransferAccountID = 2
+	assert.Nil(t, tt.ValidateCanSaveBankAccountTransaction())
+
+	klogger.Exit(method)
+}
+
+func TestGetSignedAmount(t *testing.T) {
+	method := "BankAccountTransaction_test.TestGetSignedAmount"
+	klogger.Enter(method)
+
+	tr := BankAccountTransaction{Amount: 100}
+
+	tr.Type = constants.TransactionTypeDeposit
+	assert.Equal(t, 100.0, tr.GetSignedAmount())
+
+	tr.Type = constants.TransactionTypeTransferIn
+	assert.Equal(t, 100.0, tr.GetSignedAmount())
+
+	tr.Type = constants.TransactionTypeWithdrawal
+	assert.Equal(t, -100.0, tr.GetSignedAmount())
+
+	tr.Type = constants.TransactionTypeTransferOut
+	assert.Equal(t, -100.0, tr.GetSignedAmount())
+
+	klogger.Exit(method)
+}
+
+func TestNewTransfer(t *testing.T) {
+	method := "BankAccountTransaction_test.TestNewTransfer"
+	klogger.Enter(method)
+
+	tr := BankAccountTransaction{
+		BankAccountID:     1,
+		Type:              constants.TransactionTypeTransferOut,
+		Amount:            100,
+		TransactionDt:     time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		TransferAccountID: 2,
+		Source:            constants.TransactionSourceBill,
+		SourceID:          1,
+	}
+
+	out, in := tr.NewTransfer()
+	assert.Equal(t, constants.TransactionTypeTransferOut, out.Type)
+	assert.Equal(t, 1, out.BankAccountID)
+	assert.Equal(t, 2, out.TransferAccountID)
+	assert.Equal(t, constants.TransactionTypeTransferIn, in.Type)
+	assert.Equal(t, 2, in.BankAccountID)
+	assert.Equal(t, 1, in.TransferAccountID)
+	assert.Equal(t, 100.0, in.Amount)
+
+	//Transfers are never posted from a source
+	assert.Equal(t, "", out.Source)
+	assert.Equal(t, 0, in.SourceID)
+
+	//Transfer-ins move money from the transfer account into the account
+	tr.Type = constants.TransactionTypeTransferIn
+	out, in = tr.NewTransfer()
+	assert.Equal(t, 2, out.BankAccountID)
+	assert.Equal(t, 1, out.TransferAccountID)
+	assert.Equal(t, 1, in.BankAccountID)
+	assert.Equal(t, 2, in.TransferAccountID)
+
+	klogger.Exit(method)
+}
+
+func TestFindTransferPair(t *testing.T) {
+	method := "BankAccountTransaction_test.TestFindTransferPair"
+	klogger.Enter(method)
+
+	tarr := mockBankAccountTransactions()
+
+	p := tarr[2].FindTransferPair(tarr)
+	assert.NotNil(t, p)
+	assert.Equal(t, 4, p.ID)
+
+	p = tarr[3].FindTransferPair(tarr)
+	assert.NotNil(t, p)
+	assert.Equal(t, 3, p.ID)
+
+	//Transactions that are not transfers have no pair
+	assert.Nil(t, tarr[0].FindTransferPair(tarr))
+
+	//Transfers of a different amount are not paired
+	tarr[3].Amount = 50
+	assert.Nil(t, tarr[2].FindTransferPair(tarr))
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,160 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockBankAccount() BankAccount {
+	return BankAccount{
+		ID:             1,
+		UserID:         1,
+		Name:           "Checking",
+		Type:           constants.BankAccountTypeChecking,
+		OpeningBalance: 1000,
+		OpeningDt:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func mockBankAccountTransactions() []*BankAccountTransaction {
+	t1 := BankAccountTransaction{
+		ID:            1,
+		BankAccountID: 1,
+		Type:          constants.TransactionTypeWithdrawal,
+		Amount:        200,
+		TransactionDt: time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+		Source:        constants.TransactionSourceBill,
+		SourceID:      1,
+	}
+
+	t2 := BankAccountTransaction{
+		ID:            2,
+		BankAccountID: 1,
+		Type:          constants.TransactionTypeDeposit,
+		Amount:        500.55,
+		TransactionDt: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		Source:        constants.TransactionSourceIncome,
+		SourceID:      1,
+	}
+
+	t3 := BankAccountTransaction{
+		ID:                3,
+		BankAccountID:     1,
+		Type:              constants.TransactionTypeTransferOut,
+		Amount:            100,
+		TransactionDt:     time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		TransferAccountID: 2,
+	}
+
+	t4 := BankAccountTransaction{
+		ID:                4,
+		BankAccountID:     2,
+		Type:              constants.TransactionTypeTransferIn,
+		Amount:            100,
+		TransactionDt:     time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		TransferAccountID: 1,
+	}
+
+	return []*BankAccountTransaction{&t1, &t2, &t3, &t4}
+}
+
+func TestValidateCanSaveBankAccount(t *testing.T) {
+	method := "BankAccount_test.TestValidateCanSaveBankAccount"
+	klogger.Enter(method)
+
+	var at BankAccount
+	a := mockBankAccount()
+
+	err := a.ValidateCanSaveBankAccount()
+	assert.Nil(t, err)
+
+	//Name is required
+	at = a
+	at.Name = ""
+	assert.NotNil(t, at.ValidateCanSaveBankAccount())
+
+	//UserId is required
+	at = a
+	at.UserID = 0
+	assert.NotNil(t, at.ValidateCanSaveBankAccount())
+
+	//Type must be valid
+	at = a
+	at.Type = "brokerage"
+	assert.NotNil(t, at.ValidateCanSaveBankAccount())
+
+	//Opening date is required
+	at = a
+	at.OpeningDt = time.Time{}
+	assert.NotNil(t, at.ValidateCanSaveBankAccount())
+
+	klogger.Exit(method)
+}
+
+func TestBankAccountLoadTransactions(t *testing.T) {
+	method := "BankAccount_test.TestBankAccountLoadTransactions"
+	klogger.Enter(method)
+
+	a := mockBankAccount()
+	a.LoadTransactions(mockBankAccountTransactions())
+
+	//Only transactions for the account are loaded, in the order they were made
+	assert.Equal(t, 3, len(a.Transactions))
+	assert.Equal(t, 2, a.Transactions[0].ID)
+	assert.Equal(t, 1, a.Transactions[1].ID)
+	assert.Equal(t, 3, a.Transactions[2].ID)
+
+	klogger.Exit(method)
+}
+
+func TestGetBalanceForDate(t *testing.T) {
+	method := "BankAccount_test.TestGetBalanceForDate"
+	klogger.Enter(method)
+
+	a := mockBankAccount()
+	a.LoadTransactions(mockBankAccountTransactions())
+
+	assert.Equal(t, 1000.0, a.GetBalanceForDate(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 1500.55, a.GetBalanceForDate(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 1300.55, a.GetBalanceForDate(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 1200.55, a.GetBalanceForDate(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)))
+
+	//Transactions made before the account was opened are included in its opening balance
+	a.OpeningDt = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 700.0, a.GetBalanceForDate(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)))
+
+	a.CalcBalance(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, 800.0, a.Balance)
+
+	klogger.Exit(method)
+}
+
+func TestGetUnpostedTransactions(t *testing.T) {
+	method := "BankAccount_test.TestGetUnpostedTransactions"
+	klogger.Enter(method)
+
+	a := mockBankAccount()
+	a.LoadTransactions(mockBankAccountTransactions())
+
+	parr := []BankAccountTransaction{
+		//Already posted
+		{BankAccountID: 1, Type: constants.TransactionTypeDeposit, Amount: 500.55, TransactionDt: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), Source: constants.TransactionSourceIncome, SourceID: 1},
+		//Next payday
+		{BankAccountID: 1, Type: constants.TransactionTypeDeposit, Amount: 500.55, TransactionDt: time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), Source: constants.TransactionSourceIncome, SourceID: 1},
+		//Different account
+		{BankAccountID: 2, Type: constants.TransactionTypeDeposit, Amount: 500.55, TransactionDt: time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), Source: constants.TransactionSourceIncome, SourceID: 2},
+		//Before the account was opened
+		{BankAccountID: 1, Type: constants.TransactionTypeWithdrawal, Amount: 200, TransactionDt: time.Date(2023, 12, 15, 0, 0, 0, 0, time.UTC), Source: constants.TransactionSourceBill, SourceID: 1},
+	}
+
+	unposted := a.GetUnpostedTransactions(parr)
+	assert.Equal(t, 1, len(unposted))
+	assert.Equal(t, time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), unposted[0].TransactionDt)
+	assert.Equal(t, constants.TransactionSourceIncome, unposted[0].Source)
+
+	klogger.Exit(method)
+}
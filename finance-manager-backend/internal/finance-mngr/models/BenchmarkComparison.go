@@ -0,0 +1,189 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type BenchmarkPoint is the close of a benchmark on Date and its value normalized to the starting value of the portfolio it is compared to
+type BenchmarkPoint struct {
+	Date  time.Time `json:"date"`
+	Close float64   `json:"close"`
+	Value float64   `json:"value"`
+}
+
+// Type BenchmarkComparison compares a portfolio to a benchmark ticker from StartDt to EndDt. Returns are percentages. PortfolioReturn is
+// time-weighted so that buying and selling shares does not count as performance. ExcessReturn is the portfolio's return over the benchmark's,
+// and Alpha is its return over what its Beta to the benchmark would predict. Beta is 1 when there are too few days to estimate it
+type BenchmarkComparison struct {
+	Ticker          string           `json:"ticker"`
+	StartDt         time.Time        `json:"startDt"`
+	EndDt           time.Time        `json:"endDt"`
+	Return          float64          `json:"return"`
+	PortfolioReturn float64          `json:"portfolioReturn"`
+	ExcessReturn    float64          `json:"excessReturn"`
+	Beta            float64          `json:"beta"`
+	Alpha           float64          `json:"alpha"`
+	Items           []BenchmarkPoint `json:"items"`
+}
+
+// Function NewBenchmarkComparison compares the portfolio whose balances are hist and whose performance is days to the bars of a benchmark.
+// The benchmark is valued on each date in hist at its latest close, normalized to the close of the portfolio on the first date
+func NewBenchmarkComparison(ticker string, hist []PortfolioBalanceHistory, days []PerformanceDay, bars []Stock) BenchmarkComparison {
+	method := "BenchmarkComparison.NewBenchmarkComparison"
+	klogger.Enter(method)
+
+	bc := BenchmarkComparison{
+		Ticker: ticker,
+		Beta:   1,
+		Items:  []BenchmarkPoint{},
+	}
+
+	if len(hist) == 0 || len(bars) == 0 || bars[0].Close <= 0 {
+		klogger.Exit(method)
+		return bc
+	}
+
+	sd := hist[0].Date
+	ed := hist[len(hist)-1].Date
+	bc.StartDt = sd
+	bc.EndDt = ed
+
+	//Normalize the benchmark to the portfolio's starting value
+	b := 0
+	for _, h := range hist {
+		for b < len(bars)-1 && !bars[b+1].Date.After(h.Date) {
+			b++
+		}
+
+		p := BenchmarkPoint{
+			Date:  h.Date,
+			Close: bars[b].Close,
+			Value: math.Round(hist[0].Close*bars[b].Close/bars[0].Close*100) / 100,
+		}
+
+		bc.Items = append(bc.Items, p)
+	}
+
+	pr := getPortfolioDailyReturns(days, sd, ed)
+	br := getBenchmarkDailyReturns(bars, sd, ed)
+
+	rp := 1.0
+	for _, r := range pr {
+		rp *= 1 + r
+	}
+
+	rp--
+	rb := bars[b].Close/bars[0].Close - 1
+
+	//Estimate beta from the days both the portfolio and the benchmark have a return for
+	var xs []float64
+	var ys []float64
+
+	for d, r := range br {
+		if p, ok := pr[d]; ok {
+			xs = append(xs, r)
+			ys = append(ys, p)
+		}
+	}
+
+	if beta, ok := calcBeta(xs, ys); ok {
+		bc.Beta = math.Round(beta*10000) / 10000
+	}
+
+	bc.Return = math.Round(rb*10000) / 100
+	bc.PortfolioReturn = math.Round(rp*10000) / 100
+	bc.ExcessReturn = math.Round((rp-rb)*10000) / 100
+	bc.Alpha = math.Round((rp-bc.Beta*rb)*10000) / 100
+
+	klogger.Exit(method)
+	return bc
+}
+
+// Function getPortfolioDailyReturns returns the return of each of days after sd up to ed, excluding the shares bought and sold that day.
+// Days that begin with nothing held have no return
+func getPortfolioDailyReturns(days []PerformanceDay, sd time.Time, ed time.Time) map[time.Time]float64 {
+	method := "BenchmarkComparison.getPortfolioDailyReturns"
+	klogger.Enter(method)
+
+	rm := make(map[time.Time]float64)
+	pv := 0.0
+
+	for _, d := range days {
+		if d.Date.After(ed) {
+			break
+		}
+
+		if d.Date.After(sd) && pv > 0 {
+			rm[d.Date] = (d.Value-d.Flow+d.Dividend)/pv - 1
+		}
+
+		pv = d.Value
+	}
+
+	klogger.Exit(method)
+	return rm
+}
+
+// Function getBenchmarkDailyReturns returns the change in close of each of bars after sd up to ed
+func getBenchmarkDailyReturns(bars []Stock, sd time.Time, ed time.Time) map[time.Time]float64 {
+	method := "BenchmarkComparison.getBenchmarkDailyReturns"
+	klogger.Enter(method)
+
+	rm := make(map[time.Time]float64)
+
+	for i := 1; i < len(bars); i++ {
+		if bars[i].Date.After(ed) {
+			break
+		}
+
+		if bars[i].Date.After(sd) && bars[i-1].Close > 0 {
+			rm[bars[i].Date] = bars[i].Close/bars[i-1].Close - 1
+		}
+	}
+
+	klogger.Exit(method)
+	return rm
+}
+
+// Function calcBeta returns the slope of ys regressed on xs. Returns false if there are fewer than two points or xs do not vary
+func calcBeta(xs []float64, ys []float64) (float64, bool) {
+	method := "BenchmarkComparison.calcBeta"
+	klogger.Enter(method)
+
+	n := float64(len(xs))
+
+	if len(xs) < 2 || len(xs) != len(ys) {
+		klogger.Exit(method)
+		return 0, false
+	}
+
+	var mx float64
+	var my float64
+
+	for i := range xs {
+		mx += xs[i]
+		my += ys[i]
+	}
+
+	mx /= n
+	my /= n
+
+	var cov float64
+	var vx float64
+
+	for i := range xs {
+		cov += (xs[i] - mx) * (ys[i] - my)
+		vx += (xs[i] - mx) * (xs[i] - mx)
+	}
+
+	if vx < 1e-18 {
+		klogger.Exit(method)
+		return 0, false
+	}
+
+	klogger.Exit(method)
+	return cov / vx, true
+}
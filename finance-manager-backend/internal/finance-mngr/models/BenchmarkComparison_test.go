@@ -0,0 +1,120 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockBenchmarkHistory(closes ...float64) []PortfolioBalanceHistory {
+	var hist []PortfolioBalanceHistory
+
+	for i, c := range closes {
+		hist = append(hist, PortfolioBalanceHistory{Close: c, Date: time.Date(2024, 1, 2+i, 5, 0, 0, 0, time.UTC)})
+	}
+
+	return hist
+}
+
+func TestNewBenchmarkComparison(t *testing.T) {
+	method := "BenchmarkComparison_test.TestNewBenchmarkComparison"
+	klogger.Enter(method)
+
+	//The portfolio doubles its shares on the third day, which raises its balance but not its return
+	days := BuildPerformanceDays("AAPL", mockPerformanceUserStocks(), mockPerformanceBars("AAPL", 10, 11, 12, 12), nil, nil)
+	hist := mockBenchmarkHistory(100, 110, 240, 240)
+	bars := mockPerformanceBars("SPY", 50, 51, 52, 55)
+
+	bc := NewBenchmarkComparison("SPY", hist, days, bars)
+	assert.Equal(t, "SPY", bc.Ticker)
+	assert.Equal(t, hist[0].Date, bc.StartDt)
+	assert.Equal(t, hist[3].Date, bc.EndDt)
+
+	//The benchmark starts from the portfolio's starting value
+	assert.Equal(t, 4, len(bc.Items))
+	assert.Equal(t, BenchmarkPoint{Date: hist[0].Date, Close: 50, Value: 100}, bc.Items[0])
+	assert.Equal(t, BenchmarkPoint{Date: hist[3].Date, Close: 55, Value: 110}, bc.Items[3])
+
+	assert.Equal(t, 10.0, bc.Return)
+	assert.Equal(t, 20.0, bc.PortfolioReturn)
+	assert.Equal(t, 10.0, bc.ExcessReturn)
+	assert.InDelta(t, 20.0-bc.Beta*10, bc.Alpha, 0.01)
+	assert.NotEqual(t, 1.0, bc.Beta)
+
+	klogger.Exit(method)
+}
+
+func TestNewBenchmarkComparison_split(t *testing.T) {
+	method := "BenchmarkComparison_test.TestNewBenchmarkComparison_split"
+	klogger.Enter(method)
+
+	//A portfolio holding only the benchmark matches it across a 2:1 split. Bars are adjusted for the split, and so is the portfolio history
+	sarr := []StockSplit{{Ticker: "AAPL", ExecutionDt: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), SplitFrom: 1, SplitTo: 2}}
+	bars := mockPerformanceBars("AAPL", 10, 11, 11, 12)
+	days := BuildPerformanceDays("AAPL", mockPerformanceUserStocks(), bars, sarr, nil)
+	hist := mockBenchmarkHistory(200, 220, 220, 240)
+
+	bc := NewBenchmarkComparison("AAPL", hist, days, bars)
+
+	for i, h := range hist {
+		assert.Equal(t, h.Close, bc.Items[i].Value)
+	}
+
+	assert.Equal(t, 20.0, bc.Return)
+	assert.Equal(t, 20.0, bc.PortfolioReturn)
+	assert.Equal(t, 0.0, bc.ExcessReturn)
+	assert.Equal(t, 1.0, bc.Beta)
+	assert.Equal(t, 0.0, bc.Alpha)
+
+	klogger.Exit(method)
+}
+
+func TestNewBenchmarkComparison_missingBars(t *testing.T) {
+	method := "BenchmarkComparison_test.TestNewBenchmarkComparison_missingBars"
+	klogger.Enter(method)
+
+	hist := mockBenchmarkHistory(100, 100, 100)
+	days := []PerformanceDay{{Date: hist[0].Date, Value: 100, Flow: 100}, {Date: hist[1].Date, Value: 100}, {Date: hist[2].Date, Value: 100}}
+
+	//Dates the benchmark has no bar for are valued at its latest close
+	bars := []Stock{{Ticker: "QQQ", Close: 20, Date: hist[0].Date}, {Ticker: "QQQ", Close: 25, Date: hist[2].Date}}
+
+	bc := NewBenchmarkComparison("QQQ", hist, days, bars)
+	assert.Equal(t, 100.0, bc.Items[1].Value)
+	assert.Equal(t, 125.0, bc.Items[2].Value)
+	assert.Equal(t, 25.0, bc.Return)
+	assert.Equal(t, -25.0, bc.ExcessReturn)
+
+	//Beta cannot be estimated from a single day
+	assert.Equal(t, 1.0, bc.Beta)
+	assert.Equal(t, bc.ExcessReturn, bc.Alpha)
+
+	//Nothing can be compared without history or bars
+	bc = NewBenchmarkComparison("QQQ", nil, days, bars)
+	assert.Equal(t, 0, len(bc.Items))
+	assert.Equal(t, 1.0, bc.Beta)
+
+	bc = NewBenchmarkComparison("QQQ", hist, days, nil)
+	assert.Equal(t, 0, len(bc.Items))
+
+	klogger.Exit(method)
+}
+
+func TestCalcBeta(t *testing.T) {
+	method := "BenchmarkComparison_test.TestCalcBeta"
+	klogger.Enter(method)
+
+	beta, ok := calcBeta([]float64{0.01, 0.02, -0.01}, []float64{0.02, 0.04, -0.02})
+	assert.True(t, ok)
+	assert.InDelta(t, 2.0, beta, 0.000001)
+
+	_, ok = calcBeta([]float64{0.01}, []float64{0.02})
+	assert.False(t, ok)
+
+	_, ok = calcBeta([]float64{0.01, 0.01}, []float64{0.02, 0.03})
+	assert.False(t, ok)
+
+	klogger.Exit(method)
+}
@@ -2,18 +2,28 @@ package models
 
 import (
 	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"strings"
 	"time"
 
 	"github.com/jon-kamis/klogger"
 )
 
+// Type Bill is a recurring expense. Bills are due every Frequency starting on DueDt and ending on EndDt if it is set.
+// Bills without a Frequency are monthly, and monthly bills without a DueDt are due once every month.
+// Bills with an AccountID are paid from that BankAccount on each due date
 type Bill struct {
-	ID           int       `json:"id"`
-	UserID       int       `json:"userId"`
-	Name         string    `json:"name"`
-	Amount       float64   `json:"amount"`
-	CreateDt     time.Time `json:"createDt"`
-	LastUpdateDt time.Time `json:"lastUpdateDt"`
+	ID           int        `json:"id"`
+	UserID       int        `json:"userId"`
+	Name         string     `json:"name"`
+	Amount       float64    `json:"amount"`
+	Frequency    string     `json:"frequency"`
+	DueDt        time.Time  `json:"dueDt"`
+	EndDt        *time.Time `json:"endDt"`
+	AccountID    int        `json:"accountId"`
+	CreateDt     time.Time  `json:"createDt"`
+	LastUpdateDt time.Time  `json:"lastUpdateDt"`
 }
 
 func (b *Bill) ValidateCanSaveBill() error {
@@ -38,6 +48,133 @@ func (b *Bill) ValidateCanSaveBill() error {
 		return err
 	}
 
+	if b.Frequency != "" {
+		isValidFrequency := false
+
+		for _, f := range constants.ValidBillFreq {
+			if strings.Compare(b.Frequency, f) == 0 {
+				isValidFrequency = true
+			}
+		}
+
+		if !isValidFrequency {
+			err := errors.New("frequency is invalid")
+			klogger.ExitError(method, err.Error())
+			return err
+		}
+	}
+
+	if b.DueDt.IsZero() && b.getFrequency() != constants.BillFreqMonthly {
+		err := errors.New("due date is required for bills that are not monthly")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if b.EndDt != nil && b.EndDt.Before(b.DueDt) {
+		err := errors.New("end date cannot be before due date")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
 	klogger.Exit(method)
 	return nil
 }
+
+// Function GetRecurrence returns the schedule the bill is due on
+func (b *Bill) GetRecurrence() fmUtil.Recurrence {
+	method := "Bill.GetRecurrence"
+	klogger.Enter(method)
+
+	r := fmUtil.Recurrence{
+		Frequency: b.getFrequency(),
+		StartDt:   b.DueDt,
+		EndDt:     b.EndDt,
+	}
+
+	klogger.Exit(method)
+	return r
+}
+
+// Function GetDueDatesBetween returns each date the bill is due between s and e inclusively
+func (b *Bill) GetDueDatesBetween(s time.Time, e time.Time) []time.Time {
+	method := "Bill.GetDueDatesBetween"
+	klogger.Enter(method)
+
+	r := b.GetRecurrence()
+	dates := r.GetDatesBetween(s, e)
+
+	klogger.Exit(method)
+	return dates
+}
+
+// Function GetOccurrencesForMonthContainingDate returns the number of times the bill is due in the month containing t
+func (b *Bill) GetOccurrencesForMonthContainingDate(t time.Time) int {
+	method := "Bill.GetOccurrencesForMonthContainingDate"
+	klogger.Enter(method)
+
+	//Monthly bills without a due date are due once every month
+	if b.DueDt.IsZero() && b.getFrequency() == constants.BillFreqMonthly {
+		klogger.Exit(method)
+		return 1
+	}
+
+	occurrences := len(b.GetDueDatesBetween(fmUtil.GetMonthBeginDate(t), fmUtil.GetMonthEndDate(t)))
+
+	klogger.Exit(method)
+	return occurrences
+}
+
+// Function GetMonthlyCost returns the total amount due on the bill in the month containing t
+func (b *Bill) GetMonthlyCost(t time.Time) float64 {
+	method := "Bill.GetMonthlyCost"
+	klogger.Enter(method)
+
+	cost := b.Amount * float64(b.GetOccurrencesForMonthContainingDate(t))
+
+	klogger.Exit(method)
+	return cost
+}
+
+// Function GetPostingsBetween returns a withdrawal from the bill's account for each date the bill is due between s and e inclusively.
+// Bills without an account, and monthly bills without a due date, are not posted
+func (b *Bill) GetPostingsBetween(s time.Time, e time.Time) []BankAccountTransaction {
+	method := "Bill.GetPostingsBetween"
+	klogger.Enter(method)
+
+	var postings []BankAccountTransaction
+
+	if b.AccountID == 0 || b.DueDt.IsZero() || b.Amount <= 0 {
+		klogger.Exit(method)
+		return postings
+	}
+
+	for _, d := range b.GetDueDatesBetween(s, e) {
+		postings = append(postings, BankAccountTransaction{
+			BankAccountID: b.AccountID,
+			UserID:        b.UserID,
+			Type:          constants.TransactionTypeWithdrawal,
+			Amount:        b.Amount,
+			TransactionDt: d,
+			Description:   b.Name,
+			Source:        constants.TransactionSourceBill,
+			SourceID:      b.ID,
+		})
+	}
+
+	klogger.Exit(method)
+	return postings
+}
+
+// Function getFrequency returns the bill's frequency. Bills without a frequency are monthly
+func (b *Bill) getFrequency() string {
+	method := "Bill.getFrequency"
+	klogger.Enter(method)
+
+	if b.Frequency == "" {
+		klogger.Exit(method)
+		return constants.BillFreqMonthly
+	}
+
+	klogger.Exit(method)
+	return b.Frequency
+}
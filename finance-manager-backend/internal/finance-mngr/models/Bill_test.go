@@ -1,9 +1,12 @@
 package models
 
 import (
+	"finance-manager-backend/internal/finance-mngr/constants"
 	"testing"
+	"time"
 
 	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestValidateCanSaveBill(t *testing.T) {
@@ -57,5 +60,139 @@ func TestValidateCanSaveBill(t *testing.T) {
 		t.Errorf("expected error to be thrown for empty userId amount but none was thrown")
 	}
 
+	//Frequency must be valid
+	b1 = b
+	b1.Frequency = "daily"
+	err = b1.ValidateCanSaveBill()
+
+	if err == nil {
+		t.Errorf("expected error to be thrown for invalid frequency but none was thrown")
+	}
+
+	//Due date is required for bills that are not monthly
+	b1 = b
+	b1.Frequency = constants.BillFreqAnnual
+	err = b1.ValidateCanSaveBill()
+
+	if err == nil {
+		t.Errorf("expected error to be thrown for annual bill without due date but none was thrown")
+	}
+
+	//End date cannot be before due date
+	b1 = b
+	b1.DueDt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	b1.EndDt = &endDt
+	err = b1.ValidateCanSaveBill()
+
+	if err == nil {
+		t.Errorf("expected error to be thrown for end date before due date but none was thrown")
+	}
+
+	klogger.Exit(method)
+}
+
+func TestGetDueDatesBetween(t *testing.T) {
+	method := "Bill_test.TestGetDueDatesBetween"
+	klogger.Enter(method)
+
+	s := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	e := time.Date(2024, 2, 29, 23, 59, 59, 0, time.UTC)
+
+	//Weekly bills anchored on a Friday
+	b := Bill{Frequency: constants.BillFreqWeekly, DueDt: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)}
+	d := b.GetDueDatesBetween(s, e)
+	assert.Equal(t, 4, len(d))
+	assert.Equal(t, time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC), d[0])
+
+	//Bi-weekly bills
+	b = Bill{Frequency: constants.BillFreqBiWeekly, DueDt: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)}
+	d = b.GetDueDatesBetween(s, e)
+	assert.Equal(t, 2, len(d))
+	assert.Equal(t, time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC), d[0])
+	assert.Equal(t, time.Date(2024, 2, 16, 0, 0, 0, 0, time.UTC), d[1])
+
+	//Monthly bills due on the 31st are due on the last day of shorter months
+	b = Bill{Frequency: constants.BillFreqMonthly, DueDt: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)}
+	d = b.GetDueDatesBetween(s, e)
+	assert.Equal(t, []time.Time{time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)}, d)
+
+	//Quarterly bills
+	b = Bill{Frequency: constants.BillFreqQuarterly, DueDt: time.Date(2023, 11, 15, 0, 0, 0, 0, time.UTC)}
+	assert.Equal(t, 1, len(b.GetDueDatesBetween(s, e)))
+	assert.Equal(t, 0, len(b.GetDueDatesBetween(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC))))
+	assert.Equal(t, 4, len(b.GetDueDatesBetween(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC))))
+
+	//Annual bills
+	b = Bill{Frequency: constants.BillFreqAnnual, DueDt: time.Date(2020, 2, 10, 0, 0, 0, 0, time.UTC)}
+	assert.Equal(t, []time.Time{time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)}, b.GetDueDatesBetween(s, e))
+
+	//Bills are not due before their due date or after their end date
+	b = Bill{Frequency: constants.BillFreqWeekly, DueDt: time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)}
+	endDt := time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC)
+	b.EndDt = &endDt
+	assert.Equal(t, 2, len(b.GetDueDatesBetween(s, e)))
+
+	klogger.Exit(method)
+}
+
+func TestGetOccurrencesForMonthContainingDate(t *testing.T) {
+	method := "Bill_test.TestGetOccurrencesForMonthContainingDate"
+	klogger.Enter(method)
+
+	d := time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)
+
+	//Monthly bills without a due date are due once a month
+	b := Bill{Amount: 100}
+	assert.Equal(t, 1, b.GetOccurrencesForMonthContainingDate(d))
+	assert.Equal(t, 100.0, b.GetMonthlyCost(d))
+
+	b = Bill{Amount: 100, Frequency: constants.BillFreqWeekly, DueDt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	assert.Equal(t, 4, b.GetOccurrencesForMonthContainingDate(d))
+	assert.Equal(t, 400.0, b.GetMonthlyCost(d))
+
+	b = Bill{Amount: 1200, Frequency: constants.BillFreqAnnual, DueDt: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)}
+	assert.Equal(t, 0, b.GetOccurrencesForMonthContainingDate(d))
+	assert.Equal(t, 0.0, b.GetMonthlyCost(d))
+
+	klogger.Exit(method)
+}
+
+func TestBillGetPostingsBetween(t *testing.T) {
+	method := "Bill_test.TestBillGetPostingsBetween"
+	klogger.Enter(method)
+
+	s := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	e := time.Date(2024, 2, 29, 23, 59, 59, 0, time.UTC)
+
+	b := Bill{
+		ID:        1,
+		UserID:    1,
+		Name:      "Rent",
+		Amount:    1200,
+		Frequency: constants.BillFreqMonthly,
+		DueDt:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		AccountID: 2,
+	}
+
+	p := b.GetPostingsBetween(s, e)
+	assert.Equal(t, 1, len(p))
+	assert.Equal(t, 2, p[0].BankAccountID)
+	assert.Equal(t, constants.TransactionTypeWithdrawal, p[0].Type)
+	assert.Equal(t, 1200.0, p[0].Amount)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), p[0].TransactionDt)
+	assert.Equal(t, constants.TransactionSourceBill, p[0].Source)
+	assert.Equal(t, 1, p[0].SourceID)
+
+	//Bills without a due date are not posted
+	b1 := b
+	b1.DueDt = time.Time{}
+	assert.Equal(t, 0, len(b1.GetPostingsBetween(s, e)))
+
+	//Bills without an account are not posted
+	b1 = b
+	b1.AccountID = 0
+	assert.Equal(t, 0, len(b1.GetPostingsBetween(s, e)))
+
 	klogger.Exit(method)
 }
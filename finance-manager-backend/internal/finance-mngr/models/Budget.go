@@ -0,0 +1,235 @@
+package models
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type Budget is a monthly spending limit for a category of bank account transactions beginning with the month containing StartDt.
+// Budgets linked to a bill or loan by Source and SourceID are fixed: their planned cost is the bill or loan's cost for the month, and
+// a Limit of 0 defaults to that planned cost. When Rollover is set the part of each month's limit that is not spent is added to the next month
+type Budget struct {
+	ID           int       `json:"id"`
+	UserID       int       `json:"userId"`
+	Category     string    `json:"category"`
+	Limit        float64   `json:"limit" gorm:"column:monthly_limit"`
+	Rollover     bool      `json:"rollover"`
+	Source       string    `json:"source"`
+	SourceID     int       `json:"sourceId"`
+	StartDt      time.Time `json:"startDt"`
+	CreateDt     time.Time `json:"-"`
+	LastUpdateDt time.Time `json:"-"`
+}
+
+// Type BudgetStatus compares a budget's limit for Month to its planned and actual spending. Available is the limit plus the amount
+// RolledOver from earlier months. Actual is only set when transactions were made during the month, and otherwise Remaining and
+// Overspent are based on the planned spending
+type BudgetStatus struct {
+	BudgetID   int       `json:"budgetId"`
+	Category   string    `json:"category"`
+	Month      time.Time `json:"month"`
+	Limit      float64   `json:"limit"`
+	RolledOver float64   `json:"rolledOver"`
+	Available  float64   `json:"available"`
+	Planned    float64   `json:"planned"`
+	Actual     *float64  `json:"actual"`
+	Remaining  float64   `json:"remaining"`
+	Overspent  bool      `json:"overspent"`
+}
+
+func (b *Budget) ValidateCanSaveBudget() error {
+	method := "Budget.ValidateCanSaveBudget"
+	klogger.Enter(method)
+
+	if b.Category == "" {
+		err := errors.New("category is required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if b.UserID <= 0 {
+		err := errors.New("userId is required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if b.Limit < 0 {
+		err := errors.New("limit cannot be negative")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if b.Source != "" && (!slices.Contains(constants.ValidBudgetSources, b.Source) || b.SourceID <= 0) {
+		err := errors.New("source must be one of bill or loan with a sourceId")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if b.Source == "" && b.Limit == 0 {
+		err := errors.New("limit is required for budgets that are not linked to a bill or loan")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if b.StartDt.IsZero() {
+		err := errors.New("startDt is required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function GetPlannedForMonth returns the cost of the budget's bill or loan for the month containing t, which is m months after the
+// current month. Loans are planned while they are projected to have a balance, and months before the current month are planned at the
+// current payment. Budgets that are not linked to a bill or loan have no planned cost
+func (b *Budget) GetPlannedForMonth(t time.Time, m int, barr []*Bill, larr []*Loan) float64 {
+	method := "Budget.GetPlannedForMonth"
+	klogger.Enter(method)
+
+	planned := 0.0
+
+	switch b.Source {
+	case constants.BudgetSourceBill:
+		for _, bill := range barr {
+			if bill.ID == b.SourceID {
+				planned = bill.Amount * float64(bill.GetOccurrencesForMonthContainingDate(t))
+			}
+		}
+	case constants.BudgetSourceLoan:
+		for _, l := range larr {
+			if l.ID == b.SourceID {
+				planned = l.GetProjectedPayment(max(m, 0))
+			}
+		}
+	}
+
+	klogger.Exit(method)
+	return math.Round(planned*100) / 100
+}
+
+// Function GetActualForMonth returns the net amount spent in the budget's category during the month containing t, and whether any
+// transactions were made during the month at all. Transactions linked to the budget's bill count toward it whatever their category,
+// and deposits in the category reduce the amount spent
+func (b *Budget) GetActualForMonth(t time.Time, tarr []*BankAccountTransaction) (float64, bool) {
+	method := "Budget.GetActualForMonth"
+	klogger.Enter(method)
+
+	s := fmUtil.GetMonthBeginDate(t)
+	e := fmUtil.GetMonthEndDate(t)
+	actual := 0.0
+	found := false
+
+	for _, tr := range tarr {
+		if tr.TransactionDt.Before(s) || tr.TransactionDt.After(e) {
+			continue
+		}
+
+		found = true
+
+		if tr.Type == constants.TransactionTypeTransferIn || tr.Type == constants.TransactionTypeTransferOut {
+			continue
+		}
+
+		c := tr.Category
+		if c == "" {
+			c = constants.UncategorizedCategory
+		}
+
+		linked := b.Source == constants.BudgetSourceBill && tr.Source == constants.TransactionSourceBill && tr.SourceID == b.SourceID
+
+		if c != b.Category && !linked {
+			continue
+		}
+
+		if tr.Type == constants.TransactionTypeWithdrawal {
+			actual += tr.Amount
+		} else {
+			actual -= tr.Amount
+		}
+	}
+
+	klogger.Exit(method)
+	return math.Round(actual*100) / 100, found
+}
+
+// Function GetStatusForMonth returns the status of the budget for the month containing t, which is m months after the current month.
+// When the budget rolls over, each month from the start of the budget is calculated in turn to find the amount carried into the month
+func (b *Budget) GetStatusForMonth(t time.Time, m int, barr []*Bill, larr []*Loan, tarr []*BankAccountTransaction) BudgetStatus {
+	method := "Budget.GetStatusForMonth"
+	klogger.Enter(method)
+
+	month := fmUtil.GetMonthBeginDate(t)
+	start := fmUtil.GetMonthBeginDate(b.StartDt)
+	n := fmUtil.GetMonthsBetween(start, month)
+
+	if !b.Rollover || n < 0 {
+		start = month
+		n = 0
+	}
+
+	var bs BudgetStatus
+	carried := 0.0
+
+	for k := 0; k <= n; k++ {
+		d := fmUtil.AddMonths(start, k)
+
+		bs = BudgetStatus{
+			BudgetID:   b.ID,
+			Category:   b.Category,
+			Month:      d,
+			Limit:      b.Limit,
+			RolledOver: carried,
+			Planned:    b.GetPlannedForMonth(d, m-n+k, barr, larr),
+		}
+
+		//Fixed budgets without a limit are limited to their planned cost
+		if bs.Limit == 0 {
+			bs.Limit = bs.Planned
+		}
+
+		bs.Available = math.Round((bs.Limit+bs.RolledOver)*100) / 100
+		spent := bs.Planned
+
+		if actual, found := b.GetActualForMonth(d, tarr); found {
+			bs.Actual = &actual
+			spent = actual
+		}
+
+		bs.Remaining = math.Round((bs.Available-spent)*100) / 100
+		bs.Overspent = bs.Remaining < 0
+
+		//Only the unspent part of the limit rolls over, and overspending is not carried forward
+		carried = math.Max(bs.Remaining, 0)
+	}
+
+	klogger.Exit(method)
+	return bs
+}
+
+// Function GetBudgetStatuses returns the status of each budget that has started by the month containing t, which is m months after the current month
+func GetBudgetStatuses(t time.Time, m int, budgets []*Budget, barr []*Bill, larr []*Loan, tarr []*BankAccountTransaction) []BudgetStatus {
+	method := "Budget.GetBudgetStatuses"
+	klogger.Enter(method)
+
+	e := fmUtil.GetMonthEndDate(t)
+	sarr := []BudgetStatus{}
+
+	for _, b := range budgets {
+		if b.StartDt.After(e) {
+			continue
+		}
+
+		sarr = append(sarr, b.GetStatusForMonth(t, m, barr, larr, tarr))
+	}
+
+	klogger.Exit(method)
+	return sarr
+}
@@ -0,0 +1,235 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockBudgets() []*Budget {
+	b1 := Budget{
+		ID:       1,
+		UserID:   1,
+		Category: "rent",
+		Source:   constants.BudgetSourceBill,
+		SourceID: 1,
+		StartDt:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	b2 := Budget{
+		ID:       2,
+		UserID:   1,
+		Category: "groceries",
+		Limit:    300,
+		Rollover: true,
+		StartDt:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	b3 := Budget{
+		ID:       3,
+		UserID:   1,
+		Category: "car",
+		Limit:    250,
+		Source:   constants.BudgetSourceLoan,
+		SourceID: 1,
+		StartDt:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	return []*Budget{&b1, &b2, &b3}
+}
+
+func mockBudgetRecords() ([]*Bill, []*Loan, []*BankAccountTransaction) {
+	bill := Bill{ID: 1, Name: "Rent", Amount: 150}
+	loan := Loan{ID: 1, Name: "Car", Total: 1000, MonthlyPayment: 300}
+
+	g1 := BankAccountTransaction{
+		BankAccountID: 1,
+		Type:          constants.TransactionTypeWithdrawal,
+		Amount:        250,
+		TransactionDt: time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC),
+		Category:      "groceries",
+	}
+
+	g2 := BankAccountTransaction{
+		BankAccountID: 1,
+		Type:          constants.TransactionTypeWithdrawal,
+		Amount:        400,
+		TransactionDt: time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC),
+		Category:      "groceries",
+	}
+
+	g3 := BankAccountTransaction{
+		BankAccountID: 1,
+		Type:          constants.TransactionTypeDeposit,
+		Amount:        20,
+		TransactionDt: time.Date(2024, 2, 21, 0, 0, 0, 0, time.UTC),
+		Category:      "groceries",
+	}
+
+	tarr := append(mockBankAccountTransactions(), &g1, &g2, &g3)
+
+	return []*Bill{&bill}, []*Loan{&loan}, tarr
+}
+
+func TestValidateCanSaveBudget(t *testing.T) {
+	method := "Budget_test.TestValidateCanSaveBudget"
+	klogger.Enter(method)
+
+	var bt Budget
+	b := *mockBudgets()[0]
+
+	assert.Nil(t, b.ValidateCanSaveBudget())
+
+	//Category is required
+	bt = b
+	bt.Category = ""
+	assert.NotNil(t, bt.ValidateCanSaveBudget())
+
+	//UserId is required
+	bt = b
+	bt.UserID = 0
+	assert.NotNil(t, bt.ValidateCanSaveBudget())
+
+	//Limits cannot be negative
+	bt = b
+	bt.Limit = -1
+	assert.NotNil(t, bt.ValidateCanSaveBudget())
+
+	//Sources must be valid and have an id
+	bt = b
+	bt.Source = constants.TransactionSourceIncome
+	assert.NotNil(t, bt.ValidateCanSaveBudget())
+
+	bt = b
+	bt.SourceID = 0
+	assert.NotNil(t, bt.ValidateCanSaveBudget())
+
+	//Budgets that are not fixed require a limit
+	bt = b
+	bt.Source = ""
+	bt.SourceID = 0
+	assert.NotNil(t, bt.ValidateCanSaveBudget())
+
+	bt.Limit = 100
+	assert.Nil(t, bt.ValidateCanSaveBudget())
+
+	//StartDt is required
+	bt = b
+	bt.StartDt = time.Time{}
+	assert.NotNil(t, bt.ValidateCanSaveBudget())
+
+	klogger.Exit(method)
+}
+
+func TestBudgetGetPlannedForMonth(t *testing.T) {
+	method := "Budget_test.TestBudgetGetPlannedForMonth"
+	klogger.Enter(method)
+
+	budgets := mockBudgets()
+	barr, larr, _ := mockBudgetRecords()
+	d := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 150.0, budgets[0].GetPlannedForMonth(d, 0, barr, larr))
+	assert.Equal(t, 0.0, budgets[1].GetPlannedForMonth(d, 0, barr, larr))
+	assert.Equal(t, 300.0, budgets[2].GetPlannedForMonth(d, 0, barr, larr))
+
+	//The final loan payment only covers what is left and paid off loans are not planned
+	assert.Equal(t, 100.0, budgets[2].GetPlannedForMonth(d, 3, barr, larr))
+	assert.Equal(t, 0.0, budgets[2].GetPlannedForMonth(d, 4, barr, larr))
+
+	klogger.Exit(method)
+}
+
+func TestBudgetGetActualForMonth(t *testing.T) {
+	method := "Budget_test.TestBudgetGetActualForMonth"
+	klogger.Enter(method)
+
+	budgets := mockBudgets()
+	_, _, tarr := mockBudgetRecords()
+
+	//Transactions linked to a fixed budget's bill count toward it
+	actual, found := budgets[0].GetActualForMonth(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), tarr)
+	assert.True(t, found)
+	assert.Equal(t, 200.0, actual)
+
+	//Deposits reduce the amount spent
+	actual, found = budgets[1].GetActualForMonth(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), tarr)
+	assert.True(t, found)
+	assert.Equal(t, 380.0, actual)
+
+	//Months with only transfers have transactions but no spending
+	actual, found = budgets[1].GetActualForMonth(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), tarr)
+	assert.True(t, found)
+	assert.Equal(t, 0.0, actual)
+
+	_, found = budgets[1].GetActualForMonth(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), tarr)
+	assert.False(t, found)
+
+	klogger.Exit(method)
+}
+
+func TestBudgetGetStatusForMonth(t *testing.T) {
+	method := "Budget_test.TestBudgetGetStatusForMonth"
+	klogger.Enter(method)
+
+	budgets := mockBudgets()
+	barr, larr, tarr := mockBudgetRecords()
+	feb := time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)
+	apr := time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC)
+
+	//Fixed budgets without a limit are limited to their planned cost
+	bs := budgets[0].GetStatusForMonth(feb, 0, barr, larr, tarr)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), bs.Month)
+	assert.Equal(t, 150.0, bs.Limit)
+	assert.Equal(t, 150.0, bs.Planned)
+	assert.Equal(t, 200.0, *bs.Actual)
+	assert.Equal(t, -50.0, bs.Remaining)
+	assert.True(t, bs.Overspent)
+
+	//The 50 left over in January rolls over into February
+	bs = budgets[1].GetStatusForMonth(feb, 0, barr, larr, tarr)
+	assert.Equal(t, 50.0, bs.RolledOver)
+	assert.Equal(t, 350.0, bs.Available)
+	assert.Equal(t, -30.0, bs.Remaining)
+	assert.True(t, bs.Overspent)
+
+	//Overspending is not carried forward, and months without transactions are compared to the planned spending
+	bs = budgets[1].GetStatusForMonth(apr, 2, barr, larr, tarr)
+	assert.Nil(t, bs.Actual)
+	assert.Equal(t, 300.0, bs.RolledOver)
+	assert.Equal(t, 600.0, bs.Available)
+	assert.Equal(t, 600.0, bs.Remaining)
+	assert.False(t, bs.Overspent)
+
+	bs = budgets[2].GetStatusForMonth(feb, 0, barr, larr, tarr)
+	assert.Equal(t, 0.0, *bs.Actual)
+	assert.False(t, bs.Overspent)
+
+	bs = budgets[2].GetStatusForMonth(apr, 0, barr, larr, tarr)
+	assert.Nil(t, bs.Actual)
+	assert.Equal(t, -50.0, bs.Remaining)
+	assert.True(t, bs.Overspent)
+
+	klogger.Exit(method)
+}
+
+func TestGetBudgetStatuses(t *testing.T) {
+	method := "Budget_test.TestGetBudgetStatuses"
+	klogger.Enter(method)
+
+	budgets := mockBudgets()
+	barr, larr, tarr := mockBudgetRecords()
+
+	assert.Equal(t, 3, len(GetBudgetStatuses(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), 0, budgets, barr, larr, tarr)))
+
+	//Budgets that have not started are skipped
+	budgets[0].StartDt = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	sarr := GetBudgetStatuses(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), 0, budgets, barr, larr, tarr)
+	assert.Equal(t, 2, len(sarr))
+	assert.Equal(t, 2, sarr[0].BudgetID)
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,277 @@
+package models
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type Calendar is a dated list of every income and expense a user expects between From and To inclusively.
+// Each event carries the projected balance after it occurs, starting from StartingBalance.
+// Credit cards and bills without a due date are due monthly on the day they were created, and loans on the day of their most recent payment
+type Calendar struct {
+	From            time.Time       `json:"from"`
+	To              time.Time       `json:"to"`
+	StartingBalance float64         `json:"startingBalance"`
+	EndingBalance   float64         `json:"endingBalance"`
+	TotalIncome     float64         `json:"totalIncome"`
+	TotalExpenses   float64         `json:"totalExpenses"`
+	LowestBalance   float64         `json:"lowestBalance"`
+	LowestBalanceDt time.Time       `json:"lowestBalanceDt"`
+	Events          []CalendarEvent `json:"events"`
+}
+
+// Type CalendarEvent is a single income or expense on a Calendar
+type CalendarEvent struct {
+	Date    time.Time `json:"date"`
+	Type    string    `json:"type"`
+	Source  string    `json:"source"`
+	ID      int       `json:"id"`
+	Name    string    `json:"name"`
+	Amount  float64   `json:"amount"`
+	Balance float64   `json:"balance"`
+}
+
+func (c *Calendar) ValidateCanPerformCalc() error {
+	method := "Calendar.ValidateCanPerformCalc"
+	klogger.Enter(method)
+
+	if c.From.IsZero() || c.To.IsZero() {
+		err := errors.New("from and to dates are required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if c.To.Before(c.From) {
+		err := errors.New("to date cannot be before from date")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if c.To.Sub(c.From) > time.Duration(constants.CalendarMaxDays)*24*time.Hour {
+		err := fmt.Errorf("calendar cannot cover more than %d days", constants.CalendarMaxDays)
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function LoadIncomes adds an event for each payday of each income at the pay rate in effect on that day.
+// Incomes must have their net pay populated
+func (c *Calendar) LoadIncomes(iarr []*Income) {
+	method := "Calendar.LoadIncomes"
+	klogger.Enter(method)
+
+	for _, i := range iarr {
+		for _, d := range i.GetPayDatesBetween(c.From, c.To) {
+			e := CalendarEvent{
+				Date:   d,
+				Type:   incomeType,
+				Source: incomeSrc,
+				ID:     i.ID,
+				Name:   i.Name,
+				Amount: i.GetPaycheckForDate(d).NetPay,
+			}
+
+			c.Events = append(c.Events, e)
+		}
+	}
+
+	klogger.Exit(method)
+}
+
+// Function LoadBills adds an event for each due date of each bill
+func (c *Calendar) LoadBills(barr []*Bill) {
+	method := "Calendar.LoadBills"
+	klogger.Enter(method)
+
+	for _, b := range barr {
+		r := b.GetRecurrence()
+
+		if r.StartDt.IsZero() {
+			r.StartDt = b.CreateDt
+		}
+
+		for _, d := range r.GetDatesBetween(c.From, c.To) {
+			e := CalendarEvent{
+				Date:   d,
+				Type:   expenseType,
+				Source: billSrc,
+				ID:     b.ID,
+				Name:   b.Name,
+				Amount: b.Amount,
+			}
+
+			c.Events = append(c.Events, e)
+		}
+	}
+
+	klogger.Exit(method)
+}
+
+// Function LoadLoans adds an event for each monthly payment of each loan between From and To until its remaining balance is projected
+// to be paid off. Payments are projected from each loan's remaining balance on t, so due dates before t or on or before the loan's most
+// recent recorded payment are skipped and the balance is carried forward through the due dates between t and From.
+// Each payment is charged interest at the rate of the month of the loan it falls in.
+// Loans must have their payments loaded. Loans without a monthly payment have one calculated
+func (c *Calendar) LoadLoans(larr []*Loan, t time.Time) error {
+	method := "Calendar.LoadLoans"
+	klogger.Enter(method)
+
+	for _, l := range larr {
+		b := l.GetRemainingBalance()
+
+		//Skip loans that have been paid off
+		if b <= 0.009 {
+			continue
+		}
+
+		if l.MonthlyPayment < 1 {
+			err := l.PerformPaymentCalc()
+
+			if err != nil {
+				klogger.ExitError(method, "failed to calculate payment for loan %d:\n%v", l.ID, err)
+				return err
+			}
+		}
+
+		start := fmUtil.GetStartOfDay(t)
+		if !l.LastPaymentDt.IsZero() && !l.LastPaymentDt.Before(start) {
+			start = fmUtil.GetStartOfDay(l.LastPaymentDt).AddDate(0, 0, 1)
+		}
+
+		r := l.GetPaymentRecurrence()
+
+		for _, d := range r.GetDatesBetween(start, c.To) {
+			if b <= 0.009 {
+				break
+			}
+
+			interest := (b * (l.GetInterestRateForMonth(l.GetMonthForDate(d)) / 100)) / 12
+			pay := math.Min(l.MonthlyPayment, b+interest)
+			b -= pay - interest
+
+			if d.Before(c.From) {
+				continue
+			}
+
+			e := CalendarEvent{
+				Date:   d,
+				Type:   expenseType,
+				Source: loanSrc,
+				ID:     l.ID,
+				Name:   l.Name,
+				Amount: pay,
+			}
+
+			c.Events = append(c.Events, e)
+		}
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function LoadCreditCards adds an event for each monthly minimum payment of each credit card between From and To until its balance is
+// projected to be paid off. Payments are projected from each card's balance on t, so due dates before t are skipped and the balance is
+// carried forward through the due dates between t and From
+func (c *Calendar) LoadCreditCards(carr []*CreditCard, t time.Time) {
+	method := "Calendar.LoadCreditCards"
+	klogger.Enter(method)
+
+	for _, cc := range carr {
+		b := cc.Balance
+
+		r := fmUtil.Recurrence{
+			Frequency: constants.RecurrenceFreqMonthly,
+			StartDt:   cc.CreateDt,
+		}
+
+		for _, d := range r.GetDatesBetween(fmUtil.GetStartOfDay(t), c.To) {
+			if b <= 0.009 {
+				break
+			}
+
+			b += (b * (cc.APR / 100)) / 12
+			pay := math.Min(cc.GetMinPaymentForBalance(b), b)
+			b -= pay
+
+			if d.Before(c.From) {
+				continue
+			}
+
+			e := CalendarEvent{
+				Date:   d,
+				Type:   expenseType,
+				Source: ccSrc,
+				ID:     cc.ID,
+				Name:   cc.Name,
+				Amount: pay,
+			}
+
+			c.Events = append(c.Events, e)
+		}
+	}
+
+	klogger.Exit(method)
+}
+
+// Function Finalize orders the calendar's events by date and calculates the projected balance after each one.
+// Income on a given day is applied before that day's expenses
+func (c *Calendar) Finalize() {
+	method := "Calendar.Finalize"
+	klogger.Enter(method)
+
+	sort.SliceStable(c.Events, func(i, j int) bool {
+		a := c.Events[i]
+		b := c.Events[j]
+
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+
+		if a.Type != b.Type {
+			return a.Type == incomeType
+		}
+
+		return strings.Compare(a.Name, b.Name) < 0
+	})
+
+	balance := c.StartingBalance
+	c.TotalIncome = 0
+	c.TotalExpenses = 0
+	c.LowestBalance = balance
+	c.LowestBalanceDt = c.From
+
+	for k := range c.Events {
+		e := &c.Events[k]
+
+		if e.Type == incomeType {
+			balance += e.Amount
+			c.TotalIncome += e.Amount
+		} else {
+			balance -= e.Amount
+			c.TotalExpenses += e.Amount
+		}
+
+		e.Balance = balance
+
+		if balance < c.LowestBalance {
+			c.LowestBalance = balance
+			c.LowestBalanceDt = e.Date
+		}
+	}
+
+	c.EndingBalance = balance
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,161 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarValidateCanPerformCalc(t *testing.T) {
+	method := "Calendar_test.TestCalendarValidateCanPerformCalc"
+	klogger.Enter(method)
+
+	c := Calendar{
+		From: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+	}
+
+	assert.Nil(t, c.ValidateCanPerformCalc())
+
+	c.To = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.NotNil(t, c.ValidateCanPerformCalc())
+
+	c.To = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	assert.NotNil(t, c.ValidateCanPerformCalc())
+
+	c.To = time.Time{}
+	assert.NotNil(t, c.ValidateCanPerformCalc())
+
+	klogger.Exit(method)
+}
+
+func TestCalendar(t *testing.T) {
+	method := "Calendar_test.TestCalendar"
+	klogger.Enter(method)
+
+	c := Calendar{
+		From:            time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		To:              time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		StartingBalance: 100,
+	}
+
+	i := Income{
+		ID:        1,
+		Name:      "paycheck",
+		Frequency: constants.IncomeFreqBiWeekly,
+		StartDt:   time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+		NetPay:    1000,
+	}
+
+	b := Bill{
+		ID:       2,
+		Name:     "rent",
+		Amount:   1500,
+		CreateDt: time.Date(2023, 6, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	l := Loan{
+		ID:             3,
+		Name:           "car",
+		Total:          150,
+		InterestRate:   0,
+		MonthlyPayment: 200,
+		CreateDt:       time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	cc := CreditCard{
+		ID:         4,
+		Name:       "card",
+		Balance:    1000,
+		MinPayment: 25,
+		CreateDt:   time.Date(2023, 6, 20, 0, 0, 0, 0, time.UTC),
+	}
+
+	err := c.LoadLoans([]*Loan{&l}, c.From)
+	assert.Nil(t, err)
+
+	c.LoadIncomes([]*Income{&i})
+	c.LoadBills([]*Bill{&b})
+	c.LoadCreditCards([]*CreditCard{&cc}, c.From)
+	c.Finalize()
+
+	//Paydays on the 2nd and 16th, rent on the 2nd, the remaining loan balance on the 15th and a card minimum on the 20th
+	assert.Equal(t, 5, len(c.Events))
+
+	//Income is applied before expenses on the same day
+	assert.Equal(t, incomeSrc, c.Events[0].Source)
+	assert.Equal(t, 1100.0, c.Events[0].Balance)
+	assert.Equal(t, billSrc, c.Events[1].Source)
+	assert.Equal(t, -400.0, c.Events[1].Balance)
+
+	assert.Equal(t, loanSrc, c.Events[2].Source)
+	assert.Equal(t, 150.0, c.Events[2].Amount)
+	assert.Equal(t, time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), c.Events[2].Date)
+
+	assert.Equal(t, ccSrc, c.Events[4].Source)
+	assert.Equal(t, 25.0, c.Events[4].Amount)
+
+	assert.Equal(t, 2000.0, c.TotalIncome)
+	assert.Equal(t, 1675.0, c.TotalExpenses)
+	assert.Equal(t, 425.0, c.EndingBalance)
+	assert.Equal(t, -550.0, c.LowestBalance)
+	assert.Equal(t, time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), c.LowestBalanceDt)
+
+	klogger.Exit(method)
+}
+
+func TestCalendar_projectedFromDate(t *testing.T) {
+	method := "Calendar_test.TestCalendar_projectedFromDate"
+	klogger.Enter(method)
+
+	c := Calendar{
+		From: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+	}
+
+	now := time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)
+
+	l := Loan{
+		ID:             1,
+		Name:           "car",
+		Total:          250,
+		InterestRate:   12,
+		MonthlyPayment: 100,
+		RateSchedule:   LoanRateSchedule{Steps: []LoanRateStep{{Month: 3, InterestRate: 24}}},
+		CreateDt:       time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+	}
+
+	l.LoadPayments([]*LoanPayment{{LoanID: 1, Type: constants.LoanPaymentTypePayment, Amount: 100, PaymentDt: now}})
+
+	cc := CreditCard{
+		ID:         2,
+		Name:       "card",
+		Balance:    100,
+		MinPayment: 40,
+		CreateDt:   time.Date(2023, 6, 20, 0, 0, 0, 0, time.UTC),
+	}
+
+	err := c.LoadLoans([]*Loan{&l}, now)
+	assert.Nil(t, err)
+
+	c.LoadCreditCards([]*CreditCard{&cc}, now)
+	c.Finalize()
+
+	assert.Equal(t, 2, len(c.Events))
+
+	//The loan is paid on the day of its last payment. March is month 2 at 12% and April is month 3 at 24%,
+	//leaving 54.03 and its interest to pay off in April
+	assert.Equal(t, loanSrc, c.Events[0].Source)
+	assert.Equal(t, time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC), c.Events[0].Date)
+	assert.Equal(t, 55.11, math.Round(c.Events[0].Amount*100)/100)
+
+	//The card is paid down by 40 in February and March before its last 20 in April
+	assert.Equal(t, ccSrc, c.Events[1].Source)
+	assert.Equal(t, 20.0, c.Events[1].Amount)
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,157 @@
+package models
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type CategorizationRule assigns Category to the bank account transactions it matches, and links them to the income, bill or credit card
+// named by Source and SourceID when they are set and the transaction is not already linked. Rules match transactions whose payee matches
+// PayeePattern, whose amount is between MinAmount and MaxAmount inclusively and that were made in AccountID. Criteria that are not set
+// match every transaction.
+// When several rules match a transaction the rule with the lowest Priority is applied
+type CategorizationRule struct {
+	ID           int       `json:"id"`
+	UserID       int       `json:"userId"`
+	Name         string    `json:"name"`
+	Priority     int       `json:"priority"`
+	PayeePattern string    `json:"payeePattern"`
+	MinAmount    *float64  `json:"minAmount"`
+	MaxAmount    *float64  `json:"maxAmount"`
+	AccountID    int       `json:"accountId"`
+	Category     string    `json:"category"`
+	Source       string    `json:"source"`
+	SourceID     int       `json:"sourceId"`
+	CreateDt     time.Time `json:"-"`
+	LastUpdateDt time.Time `json:"-"`
+}
+
+func (r *CategorizationRule) ValidateCanSaveCategorizationRule() error {
+	method := "CategorizationRule.ValidateCanSaveCategorizationRule"
+	klogger.Enter(method)
+
+	if r.Name == "" {
+		err := errors.New("cannot save categorization rule without a name")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if r.UserID <= 0 {
+		err := errors.New("userId is required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if r.PayeePattern == "" && r.MinAmount == nil && r.MaxAmount == nil && r.AccountID == 0 {
+		err := errors.New("rule must match on a payee pattern, amount range or account")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if _, err := regexp.Compile(r.PayeePattern); err != nil {
+		err = errors.New("payeePattern is not a valid regular expression")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if (r.MinAmount != nil && *r.MinAmount < 0) || (r.MaxAmount != nil && *r.MaxAmount < 0) {
+		err := errors.New("amounts cannot be negative")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if r.MinAmount != nil && r.MaxAmount != nil && *r.MinAmount > *r.MaxAmount {
+		err := errors.New("minAmount cannot be greater than maxAmount")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if r.Category == "" && r.Source == "" {
+		err := errors.New("rule must set a category or link a source")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if r.Source != "" && (!slices.Contains(constants.ValidRuleSources, r.Source) || r.SourceID <= 0) {
+		err := errors.New("source must be one of income, bill or credit-card with a sourceId")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function Matches returns true if the rule matches transaction t. Transfers are never matched, and transactions without a payee
+// are matched by their description. Payee patterns are not case sensitive
+func (r *CategorizationRule) Matches(t *BankAccountTransaction) bool {
+	method := "CategorizationRule.Matches"
+	klogger.Enter(method)
+
+	if t.IsTransfer() ||
+		(r.AccountID != 0 && r.AccountID != t.BankAccountID) ||
+		(r.MinAmount != nil && t.Amount < *r.MinAmount) ||
+		(r.MaxAmount != nil && t.Amount > *r.MaxAmount) {
+		klogger.Exit(method)
+		return false
+	}
+
+	if r.PayeePattern != "" {
+		re, err := regexp.Compile("(?i)" + r.PayeePattern)
+		if err != nil {
+			klogger.ExitError(method, "failed to compile payee pattern:\n%v", err)
+			return false
+		}
+
+		payee := t.Payee
+		if payee == "" {
+			payee = t.Description
+		}
+
+		if !re.MatchString(payee) {
+			klogger.Exit(method)
+			return false
+		}
+	}
+
+	klogger.Exit(method)
+	return true
+}
+
+// Function ApplyCategorizationRules applies the first rule in rarr that matches the transaction to it. Rules must be in priority order.
+// Rules only link transactions that are not already linked, so scheduled postings keep the income or bill that created them.
+// Returns true if the category or link of the transaction was changed
+func (t *BankAccountTransaction) ApplyCategorizationRules(rarr []*CategorizationRule) bool {
+	method := "BankAccountTransaction.ApplyCategorizationRules"
+	klogger.Enter(method)
+
+	for _, r := range rarr {
+		if !r.Matches(t) {
+			continue
+		}
+
+		changed := false
+
+		if r.Category != "" && r.Category != t.Category {
+			t.Category = r.Category
+			changed = true
+		}
+
+		if r.Source != "" && t.Source == "" {
+			t.Source = r.Source
+			t.SourceID = r.SourceID
+			changed = true
+		}
+
+		klogger.Exit(method)
+		return changed
+	}
+
+	klogger.Exit(method)
+	return false
+}
@@ -0,0 +1,177 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockCategorizationRules() []*CategorizationRule {
+	min := 50.0
+	max := 100.0
+
+	r1 := CategorizationRule{
+		ID:           1,
+		Name:         "Groceries",
+		Priority:     1,
+		PayeePattern: "grocery|market",
+		Category:     "groceries",
+	}
+
+	r2 := CategorizationRule{
+		ID:        2,
+		Name:      "Large purchases",
+		Priority:  2,
+		MinAmount: &min,
+		MaxAmount: &max,
+		AccountID: 1,
+		Category:  "shopping",
+	}
+
+	r3 := CategorizationRule{
+		ID:           3,
+		Name:         "Rent",
+		Priority:     3,
+		PayeePattern: "^oak apartments",
+		Category:     "housing",
+		Source:       constants.TransactionSourceBill,
+		SourceID:     1,
+	}
+
+	return []*CategorizationRule{&r1, &r2, &r3}
+}
+
+func TestValidateCanSaveCategorizationRule(t *testing.T) {
+	method := "CategorizationRule_test.TestValidateCanSaveCategorizationRule"
+	klogger.Enter(method)
+
+	var rt CategorizationRule
+	r := *mockCategorizationRules()[2]
+	r.UserID = 1
+
+	assert.Nil(t, r.ValidateCanSaveCategorizationRule())
+
+	//Name is required
+	rt = r
+	rt.Name = ""
+	assert.NotNil(t, rt.ValidateCanSaveCategorizationRule())
+
+	//UserId is required
+	rt = r
+	rt.UserID = 0
+	assert.NotNil(t, rt.ValidateCanSaveCategorizationRule())
+
+	//Rules must match on something
+	rt = r
+	rt.PayeePattern = ""
+	assert.NotNil(t, rt.ValidateCanSaveCategorizationRule())
+
+	//Payee patterns must be valid
+	rt = r
+	rt.PayeePattern = "oak("
+	assert.NotNil(t, rt.ValidateCanSaveCategorizationRule())
+
+	//Amount ranges must be positive and in order
+	min := 10.0
+	max := 5.0
+	neg := -1.0
+
+	rt = r
+	rt.MinAmount = &neg
+	assert.NotNil(t, rt.ValidateCanSaveCategorizationRule())
+
+	rt.MinAmount = &min
+	rt.MaxAmount = &max
+	assert.NotNil(t, rt.ValidateCanSaveCategorizationRule())
+
+	//Rules must set a category or link a source
+	rt = r
+	rt.Category = ""
+	assert.Nil(t, rt.ValidateCanSaveCategorizationRule())
+
+	rt.Source = ""
+	assert.NotNil(t, rt.ValidateCanSaveCategorizationRule())
+
+	//Sources must be valid and have an id
+	rt = r
+	rt.Source = "loan"
+	assert.NotNil(t, rt.ValidateCanSaveCategorizationRule())
+
+	rt = r
+	rt.SourceID = 0
+	assert.NotNil(t, rt.ValidateCanSaveCategorizationRule())
+
+	klogger.Exit(method)
+}
+
+func TestCategorizationRuleMatches(t *testing.T) {
+	method := "CategorizationRule_test.TestCategorizationRuleMatches"
+	klogger.Enter(method)
+
+	rarr := mockCategorizationRules()
+	tr := BankAccountTransaction{BankAccountID: 1, Type: constants.TransactionTypeWithdrawal, Amount: 75, Payee: "Corner Grocery"}
+
+	//Payee patterns are not case sensitive
+	assert.True(t, rarr[0].Matches(&tr))
+	assert.True(t, rarr[1].Matches(&tr))
+	assert.False(t, rarr[2].Matches(&tr))
+
+	//Transactions without a payee are matched by their description
+	tr.Payee = ""
+	tr.Description = "FARMERS MARKET"
+	assert.True(t, rarr[0].Matches(&tr))
+
+	//Amounts must be in range
+	tr.Amount = 100.01
+	assert.False(t, rarr[1].Matches(&tr))
+
+	//Accounts must match
+	tr.Amount = 75
+	tr.BankAccountID = 2
+	assert.False(t, rarr[1].Matches(&tr))
+
+	//Transfers are never matched
+	tr.Type = constants.TransactionTypeTransferOut
+	assert.False(t, rarr[0].Matches(&tr))
+
+	klogger.Exit(method)
+}
+
+func TestApplyCategorizationRules(t *testing.T) {
+	method := "CategorizationRule_test.TestApplyCategorizationRules"
+	klogger.Enter(method)
+
+	rarr := mockCategorizationRules()
+
+	//Only the first matching rule is applied
+	tr := BankAccountTransaction{BankAccountID: 1, Type: constants.TransactionTypeWithdrawal, Amount: 75, Payee: "Corner Grocery", TransactionDt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+	assert.True(t, tr.ApplyCategorizationRules(rarr))
+	assert.Equal(t, "groceries", tr.Category)
+
+	//Applying the same rules again changes nothing
+	assert.False(t, tr.ApplyCategorizationRules(rarr))
+
+	//Rules with a source link the transaction to it
+	tr = BankAccountTransaction{BankAccountID: 2, Type: constants.TransactionTypeWithdrawal, Amount: 1200, Payee: "OAK APARTMENTS RENT"}
+	assert.True(t, tr.ApplyCategorizationRules(rarr))
+	assert.Equal(t, "housing", tr.Category)
+	assert.Equal(t, constants.TransactionSourceBill, tr.Source)
+	assert.Equal(t, 1, tr.SourceID)
+
+	//Transactions that are already linked keep their link
+	tr = BankAccountTransaction{BankAccountID: 2, Type: constants.TransactionTypeWithdrawal, Amount: 1200, Payee: "OAK APARTMENTS RENT", Source: constants.TransactionSourceBill, SourceID: 2}
+	assert.True(t, tr.ApplyCategorizationRules(rarr))
+	assert.Equal(t, "housing", tr.Category)
+	assert.Equal(t, constants.TransactionSourceBill, tr.Source)
+	assert.Equal(t, 2, tr.SourceID)
+
+	//Transactions no rule matches are not changed
+	tr = BankAccountTransaction{BankAccountID: 2, Type: constants.TransactionTypeWithdrawal, Amount: 10, Payee: "COFFEE HUT", Category: "dining"}
+	assert.False(t, tr.ApplyCategorizationRules(rarr))
+	assert.Equal(t, "dining", tr.Category)
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type CategoryTotal is the money spent on and received in a category of bank account transactions over a period of time.
+// Refunds are received in the category of the purchase they refund, so Net is the actual spending of the category
+type CategoryTotal struct {
+	Category string  `json:"category"`
+	Spent    float64 `json:"spent"`
+	Received float64 `json:"received"`
+	Net      float64 `json:"net"`
+}
+
+// Type MonthlyCategoryTotals holds the category totals of the transactions made in the month beginning on Month
+type MonthlyCategoryTotals struct {
+	Month  time.Time       `json:"month"`
+	Totals []CategoryTotal `json:"totals"`
+}
+
+// Function GetCategoryTotals totals the transactions in tarr made between s and e inclusively by category, ordered by category.
+// Transfers between accounts are not spending and are skipped. Transactions without a category are totaled as uncategorized
+func GetCategoryTotals(tarr []*BankAccountTransaction, s time.Time, e time.Time) []CategoryTotal {
+	method := "CategoryTotal.GetCategoryTotals"
+	klogger.Enter(method)
+
+	totals := make(map[string]*CategoryTotal)
+
+	for _, t := range tarr {
+		if t.IsTransfer() || t.TransactionDt.Before(s) || t.TransactionDt.After(e) {
+			continue
+		}
+
+		c := t.Category
+		if c == "" {
+			c = constants.UncategorizedCategory
+		}
+
+		if totals[c] == nil {
+			totals[c] = &CategoryTotal{Category: c}
+		}
+
+		if t.Type == constants.TransactionTypeWithdrawal {
+			totals[c].Spent += t.Amount
+		} else {
+			totals[c].Received += t.Amount
+		}
+	}
+
+	result := []CategoryTotal{}
+	for _, ct := range totals {
+		ct.Spent = math.Round(ct.Spent*100) / 100
+		ct.Received = math.Round(ct.Received*100) / 100
+		ct.Net = math.Round((ct.Spent-ct.Received)*100) / 100
+		result = append(result, *ct)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Category < result[j].Category
+	})
+
+	klogger.Exit(method)
+	return result
+}
+
+// Function GetMonthlyCategoryTotals returns the category totals of the transactions in tarr for each month from the month containing s
+// through the month containing e
+func GetMonthlyCategoryTotals(tarr []*BankAccountTransaction, s time.Time, e time.Time) []MonthlyCategoryTotals {
+	method := "CategoryTotal.GetMonthlyCategoryTotals"
+	klogger.Enter(method)
+
+	months := []MonthlyCategoryTotals{}
+
+	for m := fmUtil.GetMonthBeginDate(s); !m.After(e); m = fmUtil.AddMonths(m, 1) {
+		months = append(months, MonthlyCategoryTotals{
+			Month:  m,
+			Totals: GetCategoryTotals(tarr, m, fmUtil.GetMonthEndDate(m)),
+		})
+	}
+
+	klogger.Exit(method)
+	return months
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockCategorizedTransactions() []*BankAccountTransaction {
+	tarr := mockBankAccountTransactions()
+	tarr[0].Category = "housing"
+
+	refund := BankAccountTransaction{
+		ID:            5,
+		BankAccountID: 1,
+		Type:          constants.TransactionTypeDeposit,
+		Amount:        50.25,
+		TransactionDt: time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC),
+		Category:      "housing",
+	}
+
+	return append(tarr, &refund)
+}
+
+func TestGetCategoryTotals(t *testing.T) {
+	method := "CategoryTotal_test.TestGetCategoryTotals"
+	klogger.Enter(method)
+
+	tarr := mockCategorizedTransactions()
+
+	//Transfers are skipped and transactions without a category are uncategorized
+	totals := GetCategoryTotals(tarr, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, 2, len(totals))
+	assert.Equal(t, CategoryTotal{Category: "housing", Spent: 200, Received: 50.25, Net: 149.75}, totals[0])
+	assert.Equal(t, CategoryTotal{Category: constants.UncategorizedCategory, Received: 500.55, Net: -500.55}, totals[1])
+
+	//Only transactions in the period are totaled
+	totals = GetCategoryTotals(tarr, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, 1, len(totals))
+	assert.Equal(t, constants.UncategorizedCategory, totals[0].Category)
+
+	klogger.Exit(method)
+}
+
+func TestGetMonthlyCategoryTotals(t *testing.T) {
+	method := "CategoryTotal_test.TestGetMonthlyCategoryTotals"
+	klogger.Enter(method)
+
+	months := GetMonthlyCategoryTotals(mockCategorizedTransactions(), time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, 3, len(months))
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), months[0].Month)
+	assert.Equal(t, 1, len(months[0].Totals))
+	assert.Equal(t, 1, len(months[1].Totals))
+	assert.Equal(t, 149.75, months[1].Totals[0].Net)
+
+	//Months without transactions have no totals
+	assert.Equal(t, 0, len(months[2].Totals))
+
+	klogger.Exit(method)
+}
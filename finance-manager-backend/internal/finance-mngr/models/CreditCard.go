@@ -23,6 +23,23 @@ type CreditCard struct {
 	LastUpdateDt         time.Time `json:"lastUpdateDt" gorm:"column:last_update_dt"`
 }
 
+// Type CreditCardProjection compares paying off a credit card with only minimum payments against a fixed payment
+type CreditCardProjection struct {
+	Balance        float64           `json:"balance"`
+	APR            float64           `json:"apr"`
+	MinimumPayment CreditCardPayoff  `json:"minimumPayment"`
+	FixedPayment   *CreditCardPayoff `json:"fixedPayment,omitempty"`
+}
+
+// Type CreditCardPayoff holds the payoff schedule of a credit card for a single payment plan
+type CreditCardPayoff struct {
+	Payment         float64               `json:"payment"`
+	Months          int                   `json:"months"`
+	TotalInterest   float64               `json:"totalInterest"`
+	TotalPayment    float64               `json:"totalPayment"`
+	PaymentSchedule []PaymentScheduleItem `json:"paymentSchedule"`
+}
+
 func (cc *CreditCard) ValidateCanSaveCreditCard() error {
 	method := "creditcard.ValidateCanSaveCreditCard"
 	klogger.Enter(method)
@@ -41,11 +58,144 @@ func (cc *CreditCard) CalcPayment() {
 	method := "creditcard.CalcPayment"
 	klogger.Enter(method)
 
+	cc.Payment = cc.GetMinPaymentForBalance(cc.Balance)
+
+	klogger.Exit(method)
+}
+
+// Function GetMinPaymentForBalance returns the minimum payment due on the card if it had balance b
+func (cc *CreditCard) GetMinPaymentForBalance(b float64) float64 {
+	method := "creditcard.GetMinPaymentForBalance"
+	klogger.Enter(method)
+
 	//Values are stored as percentages, divide by 100
 	minPercent := cc.MinPaymentPercentage / 100
-	minPayment := math.Max(cc.MinPayment, cc.Balance*minPercent)
+	minPayment := math.Max(cc.MinPayment, b*minPercent)
+
+	klogger.Exit(method)
+	return minPayment
+}
+
+// Function GetProjectedBalance returns the balance the card is projected to have after m months of minimum payments.
+// Interest is accrued from the card's APR before each payment
+func (cc *CreditCard) GetProjectedBalance(m int) float64 {
+	method := "creditcard.GetProjectedBalance"
+	klogger.Enter(method)
+
+	b := cc.Balance
+
+	for k := 1; k <= m && b > 0.009; k++ {
+		b += (b * (cc.APR / 100)) / 12
+		b -= math.Min(cc.GetMinPaymentForBalance(b), b)
+	}
+
+	if b < 0.009 {
+		b = 0
+	}
+
+	klogger.Exit(method)
+	return b
+}
+
+// Function CalcProjection projects paying off the card with only minimum payments.
+// If p is greater than 0 a payoff with a fixed monthly payment of p is projected as well
+func (cc *CreditCard) CalcProjection(p float64) (CreditCardProjection, error) {
+	method := "creditcard.CalcProjection"
+	klogger.Enter(method)
+
+	r := CreditCardProjection{
+		Balance: cc.Balance,
+		APR:     cc.APR,
+	}
+
+	var err error
+	r.MinimumPayment, err = cc.CalcPayoff(0)
+
+	if err != nil {
+		klogger.ExitError(method, err.Error())
+		return r, err
+	}
+
+	if p > 0 {
+		fp, err := cc.CalcPayoff(p)
+
+		if err != nil {
+			klogger.ExitError(method, err.Error())
+			return r, err
+		}
+
+		r.FixedPayment = &fp
+	}
+
+	klogger.Exit(method)
+	return r, nil
+}
+
+// Function CalcPayoff accrues interest monthly from the card's APR and pays the card down until its balance is 0.
+// The minimum payment is recalculated each month as the balance falls. If p is greater than 0 it is paid
+// each month instead, unless it is less than the minimum payment
+func (cc *CreditCard) CalcPayoff(p float64) (CreditCardPayoff, error) {
+	method := "creditcard.CalcPayoff"
+	klogger.Enter(method)
+
+	r := CreditCardPayoff{
+		Payment: p,
+	}
+
+	if cc.APR < 0 || cc.Balance < 0 {
+		err := errors.New("apr and balance cannot be negative")
+		klogger.ExitError(method, err.Error())
+		return r, err
+	}
+
+	if p <= 0 {
+		r.Payment = cc.GetMinPaymentForBalance(cc.Balance)
+	}
+
+	balance := cc.Balance
+	months := 0
+	var interestToDate float64
+	var principalToDate float64
+
+	for balance > 0.009 {
+		months++
+
+		if months > constants.CreditCardMaxProjectionMonths {
+			err := errors.New("payment is not sufficient to pay off the credit card")
+			klogger.ExitError(method, err.Error())
+			return r, err
+		}
+
+		interest := (balance * (cc.APR / 100)) / 12
+		balance += interest
+
+		pay := math.Max(p, cc.GetMinPaymentForBalance(balance))
+		pay = math.Min(pay, balance)
+		balance -= pay
+
+		if balance < 0.009 {
+			balance = 0
+		}
+
+		interestToDate += interest
+		principalToDate += pay - interest
+
+		i := PaymentScheduleItem{
+			Month:            months,
+			Principal:        pay - interest,
+			Interest:         interest,
+			InterestToDate:   interestToDate,
+			PrincipalToDate:  principalToDate,
+			RemainingBalance: balance,
+		}
+
+		r.PaymentSchedule = append(r.PaymentSchedule, i)
+		r.TotalPayment += pay
+	}
 
-	cc.Payment = minPayment
+	r.Months = months
+	r.TotalInterest = interestToDate
 
 	klogger.Exit(method)
+	return r, nil
 }
@@ -0,0 +1,347 @@
+package models
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type DebtPlan simulates paying off all of a user's loans and credit cards with a fixed monthly budget.
+// Payments freed up by paid off debts are rolled into the next debt chosen by the plan's strategy
+type DebtPlan struct {
+	Strategy      string                 `json:"strategy"`
+	Budget        float64                `json:"budget"`
+	MinPayment    float64                `json:"minPayment"`
+	TotalBalance  float64                `json:"totalBalance"`
+	TotalInterest float64                `json:"totalInterest"`
+	TotalPayment  float64                `json:"totalPayment"`
+	Months        int                    `json:"months"`
+	PayoffDate    time.Time              `json:"payoffDate"`
+	Debts         []DebtPlanDebt         `json:"debts"`
+	Schedule      []DebtPlanScheduleItem `json:"schedule"`
+}
+
+// Type DebtPlanDebt holds the payoff results of a single loan or credit card in a DebtPlan. InterestRate is the rate of the
+// first simulated month, and loans with a RateSchedule accrue interest at the scheduled rate of each month after it
+type DebtPlanDebt struct {
+	Source               string    `json:"source"`
+	ID                   int       `json:"id"`
+	Name                 string    `json:"name"`
+	Balance              float64   `json:"balance"`
+	InterestRate         float64   `json:"interestRate"`
+	MinPayment           float64   `json:"minPayment"`
+	MinPaymentPercentage float64   `json:"minPaymentPercentage"`
+	PayoffMonth          int       `json:"payoffMonth"`
+	PayoffDate           time.Time `json:"payoffDate"`
+	TotalInterest        float64   `json:"totalInterest"`
+	TotalPayment         float64   `json:"totalPayment"`
+	loan                 *Loan
+}
+
+// Type DebtPlanScheduleItem holds the combined payments made toward all debts for one month of a DebtPlan
+type DebtPlanScheduleItem struct {
+	Month            int               `json:"month"`
+	Date             time.Time         `json:"date"`
+	Payment          float64           `json:"payment"`
+	Principal        float64           `json:"principal"`
+	Interest         float64           `json:"interest"`
+	InterestToDate   float64           `json:"interestToDate"`
+	RemainingBalance float64           `json:"remainingBalance"`
+	Payments         []DebtPlanPayment `json:"payments"`
+}
+
+// Type DebtPlanPayment holds the payment made toward a single debt for one month of a DebtPlan
+type DebtPlanPayment struct {
+	Source           string  `json:"source"`
+	ID               int     `json:"id"`
+	Name             string  `json:"name"`
+	Payment          float64 `json:"payment"`
+	Principal        float64 `json:"principal"`
+	Interest         float64 `json:"interest"`
+	RemainingBalance float64 `json:"remainingBalance"`
+}
+
+// Loads loans into the plan as debts using their remaining balance. Loans without a monthly payment have one calculated
+func (d *DebtPlan) LoadLoans(larr []*Loan) error {
+	method := "DebtPlan.LoadLoans"
+	klogger.Enter(method)
+
+	for _, l := range larr {
+		b := l.GetRemainingBalance()
+
+		//Skip loans that have been paid off
+		if b <= 0.009 {
+			continue
+		}
+
+		if l.MonthlyPayment < 1 {
+			err := l.PerformPaymentCalc()
+
+			if err != nil {
+				klogger.ExitError(method, "failed to calculate payment for loan %d:\n%v", l.ID, err)
+				return err
+			}
+		}
+
+		i := DebtPlanDebt{
+			Source:       loanSrc,
+			ID:           l.ID,
+			Name:         l.Name,
+			Balance:      b,
+			InterestRate: l.GetInterestRateForMonth(l.PaymentsMade + 1),
+			MinPayment:   l.MonthlyPayment,
+			loan:         l,
+		}
+
+		d.Debts = append(d.Debts, i)
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Loads credit cards into the plan as debts
+func (d *DebtPlan) LoadCreditCards(carr []*CreditCard) {
+	method := "DebtPlan.LoadCreditCards"
+	klogger.Enter(method)
+
+	for _, cc := range carr {
+		i := DebtPlanDebt{
+			Source:               ccSrc,
+			ID:                   cc.ID,
+			Name:                 cc.Name,
+			Balance:              cc.Balance,
+			InterestRate:         cc.APR,
+			MinPayment:           cc.MinPayment,
+			MinPaymentPercentage: cc.MinPaymentPercentage,
+		}
+
+		d.Debts = append(d.Debts, i)
+	}
+
+	klogger.Exit(method)
+}
+
+// Function GetMinPayment returns the minimum payment due on a debt with balance b.
+// Credit cards use the greater of their minimum payment and minimum payment percentage of the balance.
+// The payment never exceeds b
+func (i *DebtPlanDebt) GetMinPayment(b float64) float64 {
+	method := "DebtPlan.GetMinPayment"
+	klogger.Enter(method)
+
+	p := i.MinPayment
+
+	if i.Source == ccSrc {
+		p = math.Max(i.MinPayment, b*(i.MinPaymentPercentage/100))
+	}
+
+	klogger.Exit(method)
+	return math.Min(p, b)
+}
+
+// Function GetInterestRateForMonth returns the interest rate of the debt in month m of the plan.
+// Loans follow their rate schedule from the payment after the last one made, and credit cards keep their APR
+func (i *DebtPlanDebt) GetInterestRateForMonth(m int) float64 {
+	method := "DebtPlan.GetInterestRateForMonth"
+	klogger.Enter(method)
+
+	if i.loan == nil {
+		klogger.Exit(method)
+		return i.InterestRate
+	}
+
+	klogger.Exit(method)
+	return i.loan.GetInterestRateForMonth(i.loan.PaymentsMade + m)
+}
+
+func (d *DebtPlan) ValidateCanPerformCalc() error {
+	method := "DebtPlan.ValidateCanPerformCalc"
+	klogger.Enter(method)
+
+	isValidStrategy := false
+	for _, s := range constants.ValidDebtPlanStrategies {
+		if strings.Compare(d.Strategy, s) == 0 {
+			isValidStrategy = true
+		}
+	}
+
+	if !isValidStrategy {
+		err := errors.New("strategy is invalid")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if d.Budget <= 0 {
+		err := errors.New("budget must be greater than 0")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function Calculate simulates paying off each debt month by month starting the month after t.
+// Minimum payments are made on every debt and the remaining budget is applied to debts in strategy order
+func (d *DebtPlan) Calculate(t time.Time) error {
+	method := "DebtPlan.Calculate"
+	klogger.Enter(method)
+
+	err := d.ValidateCanPerformCalc()
+	if err != nil {
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	//Order debts by strategy. Avalanche targets the highest rate first and snowball targets the lowest balance first
+	sort.SliceStable(d.Debts, func(i, j int) bool {
+		a := d.Debts[i]
+		b := d.Debts[j]
+
+		if d.Strategy == constants.DebtPlanStrategySnowball {
+			if a.Balance != b.Balance {
+				return a.Balance < b.Balance
+			}
+			return a.InterestRate > b.InterestRate
+		}
+
+		if a.InterestRate != b.InterestRate {
+			return a.InterestRate > b.InterestRate
+		}
+		return a.Balance < b.Balance
+	})
+
+	remaining := make([]float64, len(d.Debts))
+	minPayment := 0.0
+	totalBalance := 0.0
+
+	for k, i := range d.Debts {
+		remaining[k] = i.Balance
+		minPayment += i.GetMinPayment(i.Balance)
+		totalBalance += i.Balance
+	}
+
+	d.MinPayment = minPayment
+	d.TotalBalance = totalBalance
+	d.TotalInterest = 0
+	d.TotalPayment = 0
+	d.Months = 0
+	d.Schedule = nil
+
+	if d.Budget < minPayment {
+		err := fmt.Errorf("budget must cover the minimum payments of %.2f", minPayment)
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	start := fmUtil.GetMonthBeginDate(t)
+	balance := totalBalance
+	interestToDate := 0.0
+	month := 0
+
+	for balance > 0.009 {
+		month++
+
+		if month > constants.DebtPlanMaxMonths {
+			err := errors.New("budget is not sufficient to pay off all debts")
+			klogger.ExitError(method, err.Error())
+			return err
+		}
+
+		date := start.AddDate(0, month, 0)
+		payments := make([]DebtPlanPayment, len(d.Debts))
+		available := d.Budget
+
+		//Accrue interest and make the minimum payment on every open debt
+		for k := range d.Debts {
+			i := &d.Debts[k]
+			payments[k] = DebtPlanPayment{Source: i.Source, ID: i.ID, Name: i.Name}
+
+			if remaining[k] <= 0 {
+				continue
+			}
+
+			interest := (remaining[k] * (i.GetInterestRateForMonth(month) / 100)) / 12
+			remaining[k] += interest
+
+			pay := i.GetMinPayment(remaining[k])
+			remaining[k] -= pay
+			available -= pay
+
+			payments[k].Interest = interest
+			payments[k].Payment = pay
+		}
+
+		//Roll the rest of the budget into debts in strategy order
+		for k := range d.Debts {
+			if available <= 0 {
+				break
+			}
+
+			if remaining[k] <= 0 {
+				continue
+			}
+
+			pay := math.Min(available, remaining[k])
+			remaining[k] -= pay
+			available -= pay
+			payments[k].Payment += pay
+		}
+
+		item := DebtPlanScheduleItem{
+			Month: month,
+			Date:  date,
+		}
+
+		balance = 0.0
+		for k := range d.Debts {
+			i := &d.Debts[k]
+
+			if remaining[k] < 0.009 {
+				remaining[k] = 0
+			}
+
+			payments[k].Principal = payments[k].Payment - payments[k].Interest
+			payments[k].RemainingBalance = remaining[k]
+
+			if payments[k].Payment > 0 {
+				i.TotalInterest += payments[k].Interest
+				i.TotalPayment += payments[k].Payment
+
+				if remaining[k] == 0 {
+					i.PayoffMonth = month
+					i.PayoffDate = date
+				}
+			}
+
+			item.Payment += payments[k].Payment
+			item.Interest += payments[k].Interest
+			balance += remaining[k]
+		}
+
+		interestToDate += item.Interest
+		item.Principal = item.Payment - item.Interest
+		item.InterestToDate = interestToDate
+		item.RemainingBalance = balance
+		item.Payments = payments
+
+		d.Schedule = append(d.Schedule, item)
+		d.TotalInterest += item.Interest
+		d.TotalPayment += item.Payment
+	}
+
+	d.Months = month
+	if month > 0 {
+		d.PayoffDate = start.AddDate(0, month, 0)
+	}
+
+	klogger.Exit(method)
+	return nil
+}
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockDebtPlan(strategy string, budget float64) DebtPlan {
+	d := DebtPlan{
+		Strategy: strategy,
+		Budget:   budget,
+	}
+
+	larr := []*Loan{
+		{ID: 1, Name: "Car", Total: 5000, InterestRate: 5, MonthlyPayment: 150},
+	}
+
+	carr := []*CreditCard{
+		{ID: 2, Name: "High APR", Balance: 3000, APR: 25, MinPayment: 35, MinPaymentPercentage: 2},
+		{ID: 3, Name: "Small", Balance: 500, APR: 15, MinPayment: 25, MinPaymentPercentage: 2},
+	}
+
+	d.LoadLoans(larr)
+	d.LoadCreditCards(carr)
+
+	return d
+}
+
+func TestDebtPlanValidateCanPerformCalc(t *testing.T) {
+	method := "DebtPlan_test.TestDebtPlanValidateCanPerformCalc"
+	klogger.Enter(method)
+
+	d := DebtPlan{Strategy: constants.DebtPlanStrategyAvalanche, Budget: 100}
+	assert.Nil(t, d.ValidateCanPerformCalc())
+
+	//Strategy must be valid
+	d.Strategy = "invalid"
+	assert.NotNil(t, d.ValidateCanPerformCalc())
+
+	//Budget must be greater than 0
+	d.Strategy = constants.DebtPlanStrategySnowball
+	d.Budget = 0
+	assert.NotNil(t, d.ValidateCanPerformCalc())
+
+	klogger.Exit(method)
+}
+
+func TestDebtPlanCalculate_avalanche(t *testing.T) {
+	method := "DebtPlan_test.TestDebtPlanCalculate_avalanche"
+	klogger.Enter(method)
+
+	d := mockDebtPlan(constants.DebtPlanStrategyAvalanche, 500)
+
+	err := d.Calculate(testDate)
+	assert.Nil(t, err)
+
+	//Highest rate is targeted first
+	assert.Equal(t, "High APR", d.Debts[0].Name)
+	assert.Equal(t, 8500.0, d.TotalBalance)
+	assert.Equal(t, len(d.Schedule), d.Months)
+	assert.Equal(t, 0.0, d.Schedule[len(d.Schedule)-1].RemainingBalance)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), d.Schedule[0].Date)
+
+	//Every month but the last uses the full budget
+	for _, i := range d.Schedule[:len(d.Schedule)-1] {
+		assert.Equal(t, 500.0, math.Round(i.Payment*100)/100)
+	}
+
+	//Totals match the sum of each debt
+	interest := 0.0
+	for _, i := range d.Debts {
+		assert.Greater(t, i.PayoffMonth, 0)
+		interest += i.TotalInterest
+	}
+	assert.Equal(t, math.Round(d.TotalInterest*100)/100, math.Round(interest*100)/100)
+	assert.Equal(t, math.Round((d.TotalBalance+d.TotalInterest)*100)/100, math.Round(d.TotalPayment*100)/100)
+
+	klogger.Exit(method)
+}
+
+func TestDebtPlanCalculate_snowball(t *testing.T) {
+	method := "DebtPlan_test.TestDebtPlanCalculate_snowball"
+	klogger.Enter(method)
+
+	a := mockDebtPlan(constants.DebtPlanStrategyAvalanche, 500)
+	s := mockDebtPlan(constants.DebtPlanStrategySnowball, 500)
+
+	assert.Nil(t, a.Calculate(testDate))
+	assert.Nil(t, s.Calculate(testDate))
+
+	//Lowest balance is targeted and paid off first
+	assert.Equal(t, "Small", s.Debts[0].Name)
+	for _, i := range s.Debts[1:] {
+		assert.Less(t, s.Debts[0].PayoffMonth, i.PayoffMonth)
+	}
+
+	//Avalanche never costs more interest than snowball
+	assert.LessOrEqual(t, a.TotalInterest, s.TotalInterest)
+
+	klogger.Exit(method)
+}
+
+func TestDebtPlanCalculate_variableRate(t *testing.T) {
+	method := "DebtPlan_test.TestDebtPlanCalculate_variableRate"
+	klogger.Enter(method)
+
+	//Promotional rate loan that begins charging 12% in its fourth month
+	l := Loan{ID: 1, Name: "Promo", Total: 1200, InterestRate: 12, MonthlyPayment: 100,
+		RateSchedule: LoanRateSchedule{Steps: []LoanRateStep{{Month: 1, InterestRate: 0}, {Month: 4, InterestRate: 12}}}}
+
+	d := DebtPlan{Strategy: constants.DebtPlanStrategyAvalanche, Budget: 100}
+	assert.Nil(t, d.LoadLoans([]*Loan{&l}))
+	assert.Nil(t, d.Calculate(testDate))
+
+	assert.Equal(t, 0.0, d.Debts[0].InterestRate)
+	assert.Equal(t, 0.0, d.Schedule[2].Interest)
+	assert.Equal(t, 9.0, d.Schedule[3].Interest)
+
+	//The schedule continues from the payments already made
+	l.PaymentsMade = 2
+
+	d = DebtPlan{Strategy: constants.DebtPlanStrategyAvalanche, Budget: 100}
+	assert.Nil(t, d.LoadLoans([]*Loan{&l}))
+	assert.Nil(t, d.Calculate(testDate))
+
+	assert.Equal(t, 0.0, d.Schedule[0].Interest)
+	assert.Equal(t, 11.0, d.Schedule[1].Interest)
+
+	klogger.Exit(method)
+}
+
+func TestDebtPlanCalculate_insufficientBudget(t *testing.T) {
+	method := "DebtPlan_test.TestDebtPlanCalculate_insufficientBudget"
+	klogger.Enter(method)
+
+	//Budget does not cover the minimum payments
+	d := mockDebtPlan(constants.DebtPlanStrategyAvalanche, 100)
+	err := d.Calculate(testDate)
+	assert.NotNil(t, err)
+	assert.Equal(t, 235.0, d.MinPayment)
+
+	//No debts results in an empty plan
+	d = DebtPlan{Strategy: constants.DebtPlanStrategyAvalanche, Budget: 100}
+	err = d.Calculate(testDate)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, d.Months)
+	assert.Equal(t, 0, len(d.Schedule))
+
+	klogger.Exit(method)
+}
@@ -4,34 +4,74 @@ import (
 	"errors"
 	"finance-manager-backend/internal/finance-mngr/constants"
 	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"finance-manager-backend/internal/finance-mngr/tax"
+	"math"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/jon-kamis/klogger"
 )
 
-// Type Income contains methods and fields related to a user's income source
+// Type Income contains methods and fields related to a user's income source.
+// Incomes with a FilingStatus have their taxes calculated by a tax engine on the total of the user's incomes and itemized per paycheck,
+// otherwise taxes are a flat TaxPercentage of gross pay.
+// RetirementPercentage, HSAContribution and HealthPremium are deducted before taxes, while RothPercentage and Garnishment are deducted after.
+// Percentages are of gross pay and all other deductions are amounts per paycheck.
+// Changes to the pay rate are recorded as Versions, and the income is no longer paid after EndDt if it is set.
+// Hourly incomes pay hours past RegularHoursCap in a paycheck at OvertimeMultiplier times the pay rate,
+// and are paid for the hours logged in LoggedHours, or a trailing average of them for paychecks that have not been logged.
+// Incomes with an AccountID deposit their net pay to that BankAccount on each payday
 type Income struct {
-	ID            int       `json:"id"`
-	UserID        int       `json:"userId"`
-	Name          string    `json:"name"`
-	Rate          float64   `json:"rate"`
-	Hours         float64   `json:"hours"`
-	Type          string    `json:"type"`
-	GrossPay      float64   `json:"grossPay"`
-	Taxes         float64   `json:"taxes"`
-	NetPay        float64   `json:"netPay"`
-	Frequency     string    `json:"frequency"`
-	TaxPercentage float64   `json:"taxPercentage"`
-	StartDt       time.Time `json:"startDt"`
-	NextDt        time.Time `json:"nextDt"`
-	CreateDt      time.Time `json:"createDt"`
-	LastUpdateDt  time.Time `json:"lastUpdateDt"`
+	ID                     int             `json:"id"`
+	UserID                 int             `json:"userId"`
+	Name                   string          `json:"name"`
+	Rate                   float64         `json:"rate"`
+	Hours                  float64         `json:"hours"`
+	Type                   string          `json:"type"`
+	GrossPay               float64         `json:"grossPay" gorm:"column:amount"`
+	Taxes                  float64         `json:"taxes"`
+	NetPay                 float64         `json:"netPay"`
+	Frequency              string          `json:"frequency"`
+	TaxPercentage          float64         `json:"taxPercentage"`
+	FilingStatus           string          `json:"filingStatus"`
+	State                  string          `json:"state"`
+	FederalTax             float64         `json:"federalTax"`
+	StateTax               float64         `json:"stateTax"`
+	SocialSecurityTax      float64         `json:"socialSecurityTax"`
+	MedicareTax            float64         `json:"medicareTax"`
+	RetirementPercentage   float64         `json:"retirementPercentage"`
+	RothPercentage         float64         `json:"rothPercentage"`
+	HSAContribution        float64         `json:"hsaContribution"`
+	HealthPremium          float64         `json:"healthPremium"`
+	Garnishment            float64         `json:"garnishment"`
+	RegularHoursCap        float64         `json:"regularHoursCap"`
+	OvertimeMultiplier     float64         `json:"overtimeMultiplier"`
+	PaidHours              float64         `json:"paidHours"`
+	OvertimeHours          float64         `json:"overtimeHours"`
+	OvertimePay            float64         `json:"overtimePay"`
+	PreTaxDeductions       float64         `json:"preTaxDeductions"`
+	TaxableGross           float64         `json:"taxableGross"`
+	PostTaxDeductions      float64         `json:"postTaxDeductions"`
+	RetirementContribution float64         `json:"retirementContribution"`
+	Paystub                Paystub         `json:"paystub" gorm:"-"`
+	Versions               []IncomeVersion `json:"versions" gorm:"-"`
+	LoggedHours            []IncomeHours   `json:"loggedHours" gorm:"-"`
+	StartDt                time.Time       `json:"startDt"`
+	EndDt                  *time.Time      `json:"endDt"`
+	AccountID              int             `json:"accountId"`
+	NextDt                 time.Time       `json:"nextDt"`
+	CreateDt               time.Time       `json:"createDt"`
+	LastUpdateDt           time.Time       `json:"lastUpdateDt"`
+	taxEngine              tax.TaxEngine
+	otherTaxableIncome     float64
+	otherFicaWages         float64
 }
 
 // Function PopulateEmptyValues takes an argument of time and uses it
 // to determine how much income will be generated for a user for the month containing that time.
-// Values calculated are Hours, GrossPay, Taxes, and NetPay
+// Values calculated are Hours, GrossPay, deductions, Taxes, NetPay and the Paystub using the pay rate in effect on the next payday
+// and, for hourly incomes, the hours logged or projected for it
 func (i *Income) PopulateEmptyValues(t time.Time) error {
 	method := "Income.PopulateEmptyValues"
 	klogger.Enter(method)
@@ -50,31 +90,8 @@ func (i *Income) PopulateEmptyValues(t time.Time) error {
 	}
 
 	//Determine Next Payday
-	if strings.Compare(i.Frequency, constants.IncomeFreqWeekly) == 0 {
-		payday := int(i.StartDt.Weekday())
-		date := fmUtil.GetStartOfDay(t)
-
-		for int(date.Weekday()) != payday {
-			date = date.AddDate(0, 0, 1)
-		}
-
-		i.NextDt = date
-
-	} else if strings.Compare(i.Frequency, constants.IncomeFreqBiWeekly) == 0 {
-		date := fmUtil.GetStartOfDay(i.StartDt)
-		for date.Before(t) {
-			date = date.AddDate(0, 0, 14)
-		}
-
-		i.NextDt = date
-	} else if strings.Compare(i.Frequency, constants.IncomeFreqMonthly) == 0 {
-		date := fmUtil.GetStartOfDay(i.StartDt)
-		for date.Before(t) {
-			date = date.AddDate(0, 1, 0)
-		}
-
-		i.NextDt = date
-	}
+	r := i.GetRecurrence()
+	i.NextDt = r.GetNextDate(t)
 
 	// Populate Hours
 	if i.Hours == 0 {
@@ -98,24 +115,53 @@ func (i *Income) PopulateEmptyValues(t time.Time) error {
 				date = date.AddDate(0, 0, 1)
 			}
 			i.Hours = float64(workdays * 8)
+		} else if n := i.GetPaysPerYear(); n > 0 {
+			//Remaining frequencies spread a full year of work evenly across each paycheck
+			i.Hours = float64(hoursPerWeek*52) / float64(n)
+		}
+	}
+
+	// Determine the pay rate in effect on the next payday
+	rate := i.Rate
+	hours := i.Hours
+
+	d := i.NextDt
+	if d.IsZero() {
+		d = t
+	}
+
+	if v := i.GetVersionForDate(d); v != nil {
+		rate = v.Rate
+
+		if v.Hours > 0 {
+			hours = v.Hours
 		}
 	}
 
 	// Populate GrossPay
-	if i.Rate > 0 {
+	if rate > 0 {
 		if strings.Compare(i.Type, constants.IncomeTypeHourly) == 0 {
-			i.GrossPay = i.Rate * i.Hours
+			if logged, ok := i.GetLoggedHoursForDate(d); ok {
+				hours = logged
+			}
+
+			i.calcHourlyPay(rate, hours)
 		} else {
-			i.GrossPay = i.Rate
+			i.GrossPay = rate
 		}
 	}
 
+	// Populate Deductions
+	i.calcDeductions()
+
 	// Populate Taxes and Net Pay
 	if i.Taxes == 0 {
-		i.Taxes = i.GrossPay * i.TaxPercentage
-		i.NetPay = i.GrossPay - i.Taxes
+		i.Taxes = i.TaxableGross * i.TaxPercentage
+		i.NetPay = i.TaxableGross - i.Taxes - i.PostTaxDeductions
 	}
 
+	i.Paystub = NewPaystub(i)
+
 	klogger.Exit(method)
 	return nil
 }
@@ -201,6 +247,52 @@ func (i *Income) ValidateCanSaveIncome() error {
 		return err
 	}
 
+	if i.EndDt != nil && i.EndDt.Before(i.StartDt) {
+		err := errors.New("end date cannot be before start date")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if i.RegularHoursCap < 0 {
+		err := errors.New("regular hours cap cannot be negative")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if i.OvertimeMultiplier != 0 && i.OvertimeMultiplier < 1 {
+		err := errors.New("overtime multiplier must be at least 1")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if i.RetirementPercentage < 0 || i.RothPercentage < 0 || i.RetirementPercentage+i.RothPercentage > 1 {
+		err := errors.New("retirement percentages must be between 0 and 1")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if i.HSAContribution < 0 || i.HealthPremium < 0 || i.Garnishment < 0 {
+		err := errors.New("deductions cannot be negative")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if i.FilingStatus != "" {
+		isValidFilingStatus := false
+
+		for _, fs := range constants.ValidFilingStatuses {
+			if strings.Compare(i.FilingStatus, fs) == 0 {
+				isValidFilingStatus = true
+			}
+		}
+
+		if !isValidFilingStatus {
+			err := errors.New("filing status is invalid")
+			klogger.ExitError(method, err.Error())
+			return err
+		}
+	}
+
 	err := i.ValidateTypeAndFrequency()
 	if err != nil {
 		klogger.ExitError(method, err.Error())
@@ -211,75 +303,486 @@ func (i *Income) ValidateCanSaveIncome() error {
 	return nil
 }
 
+// Function calcHourlyPay calculates the gross pay of a paycheck for the given hours at the given rate.
+// Hours past the regular hours cap are paid at the overtime multiplier, or the default multiplier if the income does not set one
+func (i *Income) calcHourlyPay(rate float64, hours float64) {
+	method := "Income.calcHourlyPay"
+	klogger.Enter(method)
+
+	i.PaidHours = hours
+	i.OvertimeHours = 0
+	i.OvertimePay = 0
+
+	if i.RegularHoursCap > 0 && hours > i.RegularHoursCap {
+		multiplier := i.OvertimeMultiplier
+		if multiplier == 0 {
+			multiplier = constants.DefaultOvertimeMultiplier
+		}
+
+		i.OvertimeHours = hours - i.RegularHoursCap
+		i.OvertimePay = rate * multiplier * i.OvertimeHours
+	}
+
+	i.GrossPay = rate*(hours-i.OvertimeHours) + i.OvertimePay
+
+	klogger.Exit(method)
+}
+
+// Function calcDeductions calculates the pre-tax and post-tax deductions of a single paycheck and the gross pay left to be taxed
+func (i *Income) calcDeductions() {
+	method := "Income.calcDeductions"
+	klogger.Enter(method)
+
+	retirement := i.GrossPay * i.RetirementPercentage
+	roth := i.GrossPay * i.RothPercentage
+
+	i.PreTaxDeductions = retirement + i.HSAContribution + i.HealthPremium
+	i.TaxableGross = math.Max(i.GrossPay-i.PreTaxDeductions, 0)
+	i.PostTaxDeductions = roth + i.Garnishment
+	i.RetirementContribution = retirement + roth
+
+	klogger.Exit(method)
+}
+
+// Function GetDeductionItems returns the deductions taken from a single paycheck keyed by name
+func (i *Income) GetDeductionItems() map[string]float64 {
+	method := "Income.GetDeductionItems"
+	klogger.Enter(method)
+
+	items := map[string]float64{
+		constants.DeductionNameRetirement:    i.GrossPay * i.RetirementPercentage,
+		constants.DeductionNameRoth:          i.GrossPay * i.RothPercentage,
+		constants.DeductionNameHSA:           i.HSAContribution,
+		constants.DeductionNameHealthPremium: i.HealthPremium,
+		constants.DeductionNameGarnishment:   i.Garnishment,
+	}
+
+	klogger.Exit(method)
+	return items
+}
+
+// Function CalcTaxes annualizes the income's taxable gross pay by its frequency, calculates the annual tax liability with engine e,
+// and spreads it evenly across each paycheck. The liability is calculated on the total of this income and the user's other incomes
+// loaded by CalcUserTaxes, and the income owes its share of that total. Incomes without a filing status, or when e is nil, keep their flat tax rate
+func (i *Income) CalcTaxes(e tax.TaxEngine) error {
+	method := "Income.CalcTaxes"
+	klogger.Enter(method)
+
+	//Keep the engine so paychecks at other pay rates are taxed the same way
+	i.taxEngine = e
+
+	if e == nil || i.FilingStatus == "" {
+		klogger.Exit(method)
+		return nil
+	}
+
+	n := i.GetPaysPerYear()
+	if n == 0 {
+		err := errors.New("frequency is invalid")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	g, f := i.getAnnualTaxableIncome(n)
+
+	l, err := e.CalcAnnualLiability(g+i.otherTaxableIncome, f+i.otherFicaWages, i.FilingStatus, i.State)
+	if err != nil {
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	//Income tax is split by share of taxable income and FICA by share of wages
+	gs := 0.0
+	if g > 0 {
+		gs = g / (g + i.otherTaxableIncome)
+	}
+
+	fs := 0.0
+	if f > 0 {
+		fs = f / (f + i.otherFicaWages)
+	}
+
+	i.FederalTax = l.Federal * gs / float64(n)
+	i.StateTax = l.State * gs / float64(n)
+	i.SocialSecurityTax = l.SocialSecurity * fs / float64(n)
+	i.MedicareTax = l.Medicare * fs / float64(n)
+	i.Taxes = i.FederalTax + i.StateTax + i.SocialSecurityTax + i.MedicareTax
+	i.NetPay = i.TaxableGross - i.Taxes - i.PostTaxDeductions
+	i.Paystub = NewPaystub(i)
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function CalcUserTaxes calculates the taxes of all of a user's incomes together. Incomes with the same filing status are taxed as a single
+// annual income, so the standard deduction, brackets and Social Security wage base are only applied once, and the liability is split
+// back across the incomes by their share of it. Returns the first error from calculating the taxes of an income
+func CalcUserTaxes(iarr []*Income, e tax.TaxEngine) error {
+	method := "Income.CalcUserTaxes"
+	klogger.Enter(method)
+
+	gm := make(map[string]float64)
+	fm := make(map[string]float64)
+
+	for _, i := range iarr {
+		n := i.GetPaysPerYear()
+
+		if e == nil || i.FilingStatus == "" || n == 0 {
+			continue
+		}
+
+		g, f := i.getAnnualTaxableIncome(n)
+		gm[i.FilingStatus] += g
+		fm[i.FilingStatus] += f
+	}
+
+	for _, i := range iarr {
+		i.otherTaxableIncome = 0
+		i.otherFicaWages = 0
+
+		if n := i.GetPaysPerYear(); e != nil && i.FilingStatus != "" && n > 0 {
+			g, f := i.getAnnualTaxableIncome(n)
+			i.otherTaxableIncome = math.Max(gm[i.FilingStatus]-g, 0)
+			i.otherFicaWages = math.Max(fm[i.FilingStatus]-f, 0)
+		}
+
+		err := i.CalcTaxes(e)
+		if err != nil {
+			klogger.ExitError(method, "failed to calculate taxes for income %d:\n%v", i.ID, err)
+			return err
+		}
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function getAnnualTaxableIncome calculates the deductions of a paycheck and returns the income subject to income tax and the wages
+// subject to FICA for a year of n paychecks
+func (i *Income) getAnnualTaxableIncome(n int) (float64, float64) {
+	method := "Income.getAnnualTaxableIncome"
+	klogger.Enter(method)
+
+	i.calcDeductions()
+
+	//Health premiums and HSA contributions are exempt from FICA, but 401k contributions are not
+	fica := math.Max(i.GrossPay-i.HSAContribution-i.HealthPremium, 0)
+
+	klogger.Exit(method)
+	return i.TaxableGross * float64(n), fica * float64(n)
+}
+
+// Function GetPaysPerYear returns the number of paychecks the income pays in a year, or 0 if its frequency is invalid
+func (i *Income) GetPaysPerYear() int {
+	method := "Income.GetPaysPerYear"
+	klogger.Enter(method)
+
+	n := 0
+
+	switch i.Frequency {
+	case constants.IncomeFreqWeekly:
+		n = constants.PaysPerYearWeekly
+	case constants.IncomeFreqBiWeekly:
+		n = constants.PaysPerYearBiWeekly
+	case constants.IncomeFreqSemiMonthly:
+		n = constants.PaysPerYearSemiMonthly
+	case constants.IncomeFreqMonthly:
+		n = constants.PaysPerYearMonthly
+	case constants.IncomeFreqQuarterly:
+		n = constants.PaysPerYearQuarterly
+	case constants.IncomeFreqAnnual:
+		n = constants.PaysPerYearAnnual
+	}
+
+	klogger.Exit(method)
+	return n
+}
+
+// Function GetTaxItems returns the taxes withheld from a single paycheck keyed by name.
+// Incomes with a flat tax rate have a single income tax item
+func (i *Income) GetTaxItems() map[string]float64 {
+	method := "Income.GetTaxItems"
+	klogger.Enter(method)
+
+	items := make(map[string]float64)
+
+	if i.FederalTax+i.StateTax+i.SocialSecurityTax+i.MedicareTax > 0 {
+		items[constants.TaxNameFederal] = i.FederalTax
+		items[constants.TaxNameState] = i.StateTax
+		items[constants.TaxNameSocialSecurity] = i.SocialSecurityTax
+		items[constants.TaxNameMedicare] = i.MedicareTax
+	} else {
+		items[taxName] = i.Taxes
+	}
+
+	klogger.Exit(method)
+	return items
+}
+
+// Function GetRecurrence returns the schedule the income is paid on. Paydays that fall on a weekend are paid the Friday before
+func (i *Income) GetRecurrence() fmUtil.Recurrence {
+	method := "Income.GetRecurrence"
+	klogger.Enter(method)
+
+	r := fmUtil.Recurrence{
+		Frequency:    i.Frequency,
+		StartDt:      i.StartDt,
+		EndDt:        i.EndDt,
+		BusinessDays: true,
+	}
+
+	klogger.Exit(method)
+	return r
+}
+
+// Function GetPayDatesBetween returns each payday of the income between s and e inclusively
+func (i *Income) GetPayDatesBetween(s time.Time, e time.Time) []time.Time {
+	method := "Income.GetPayDatesBetween"
+	klogger.Enter(method)
+
+	r := i.GetRecurrence()
+	dates := r.GetDatesBetween(s, e)
+
+	klogger.Exit(method)
+	return dates
+}
+
 func (i *Income) GetPaysForMonthContainingDate(t time.Time) int {
 	method := "Income.GetPaysForMonthContainingDate"
 	klogger.Enter(method)
 
-	e := fmUtil.GetMonthEndDate(t)
-	if i.StartDt.After(e) {
-		klogger.Info(method, "income begin date is after this month")
+	//Monthly incomes without a start date are paid once every month
+	if i.StartDt.IsZero() && strings.Compare(i.Frequency, constants.IncomeFreqMonthly) == 0 {
+		klogger.Exit(method)
+		return 1
+	}
+
+	r := i.GetRecurrence()
+	pays := r.GetCountForMonthContainingDate(t)
+
+	klogger.Exit(method)
+	return pays
+}
+
+// Function GetPaychecksForMonthContainingDate returns each paycheck of the income in the month containing t,
+// calculated with the pay rate in effect on its payday
+func (i *Income) GetPaychecksForMonthContainingDate(t time.Time) []Income {
+	method := "Income.GetPaychecksForMonthContainingDate"
+	klogger.Enter(method)
+
+	var paychecks []Income
+
+	n := i.GetPaysForMonthContainingDate(t)
+	dates := i.GetPayDatesBetween(fmUtil.GetMonthBeginDate(t), fmUtil.GetMonthEndDate(t))
+
+	for k := 0; k < n; k++ {
+		//Incomes without a start date do not have paydays and are always paid at their current rate
+		if len(dates) != n {
+			paychecks = append(paychecks, *i)
+			continue
+		}
+
+		paychecks = append(paychecks, i.GetPaycheckForDate(dates[k]))
+	}
+
+	klogger.Exit(method)
+	return paychecks
+}
+
+// Function GetPaycheckForDate returns a copy of the income with its values calculated for a paycheck on date d
+// using the pay rate in effect and the hours logged or projected on that date. Incomes without versions or logged hours are returned as they are
+func (i *Income) GetPaycheckForDate(d time.Time) Income {
+	method := "Income.GetPaycheckForDate"
+	klogger.Enter(method)
+
+	if len(i.Versions) == 0 && len(i.LoggedHours) == 0 {
 		klogger.Exit(method)
-		return 0
+		return *i
+	}
+
+	p := *i
+	p.Taxes = 0
+
+	//The income has already been validated, so values are only recalculated for the new rate. Its taxes were calculated with the same
+	//filing status and state, so an error here is unexpected and the income is returned as it is rather than falling back to its flat rate
+	p.PopulateEmptyValues(d)
+
+	err := p.CalcTaxes(i.taxEngine)
+	if err != nil {
+		klogger.ExitError(method, "failed to calculate taxes for paycheck:\n%v", err)
+		return *i
 	}
 
-	if strings.Compare(i.Frequency, constants.IncomeFreqMonthly) == 0 {
+	klogger.Exit(method)
+	return p
+}
+
+// Function GetPostingsBetween returns a deposit of the net pay to the income's account for each payday between s and e inclusively.
+// Incomes without an account are not posted
+func (i *Income) GetPostingsBetween(s time.Time, e time.Time) []BankAccountTransaction {
+	method := "Income.GetPostingsBetween"
+	klogger.Enter(method)
+
+	var postings []BankAccountTransaction
+
+	if i.AccountID == 0 {
 		klogger.Exit(method)
-		return 1
-	} else if strings.Compare(i.Frequency, constants.IncomeFreqWeekly) == 0 {
-		payday := int(i.StartDt.Weekday())
-		pays := 0
+		return postings
+	}
 
-		date := getWeeklyPayStartingDtForMonth(t, i.StartDt)
-		monthEndDt := fmUtil.GetMonthEndDate(t)
+	for _, d := range i.GetPayDatesBetween(s, e) {
+		p := i.GetPaycheckForDate(d)
+		amount := math.Round(p.NetPay*100) / 100
 
-		for int(date.Weekday()) != payday {
-			date = date.AddDate(0, 0, 1)
+		if amount <= 0 {
+			continue
 		}
 
-		for date.Before(monthEndDt) {
-			pays++
-			date = date.AddDate(0, 0, 7)
+		postings = append(postings, BankAccountTransaction{
+			BankAccountID: i.AccountID,
+			UserID:        i.UserID,
+			Type:          constants.TransactionTypeDeposit,
+			Amount:        amount,
+			TransactionDt: d,
+			Description:   i.Name,
+			Source:        constants.TransactionSourceIncome,
+			SourceID:      i.ID,
+		})
+	}
+
+	klogger.Exit(method)
+	return postings
+}
+
+// Function LoadVersions attaches the versions in varr belonging to the income in the order they take effect.
+// Each version expires when the next begins, and the last version expires on the income's end date
+func (i *Income) LoadVersions(varr []*IncomeVersion) {
+	method := "Income.LoadVersions"
+	klogger.Enter(method)
+
+	i.Versions = []IncomeVersion{}
+	for _, v := range varr {
+		if v.IncomeID == i.ID {
+			i.Versions = append(i.Versions, *v)
 		}
+	}
 
-		klogger.Exit(method)
-		return pays
-	} else {
-		pays := 0
-		date := i.StartDt
-		monthBegin := fmUtil.GetMonthBeginDate(t)
-		monthEndDt := fmUtil.GetMonthEndDate(t)
-
-		//Iterate to the first payday of the current month
-		for date.Before(monthBegin) {
-			date = date.AddDate(0, 0, 14)
+	sort.SliceStable(i.Versions, func(a, b int) bool {
+		return i.Versions[a].EffectiveDt.Before(i.Versions[b].EffectiveDt)
+	})
+
+	for k := range i.Versions {
+		if k+1 < len(i.Versions) {
+			exp := i.Versions[k+1].EffectiveDt.Add(-1 * time.Millisecond)
+			i.Versions[k].ExpirationDt = &exp
+		} else {
+			i.Versions[k].ExpirationDt = i.EndDt
 		}
+	}
+
+	klogger.Exit(method)
+}
 
-		//Iterate and count paydays until beginning of next month
-		for !date.After(monthEndDt) {
-			pays++
-			date = date.AddDate(0, 0, 14)
+// Function GetVersionForDate returns the version of the income in effect on t, or nil if the original pay rate is in effect.
+// Versions must be loaded in the order they take effect
+func (i *Income) GetVersionForDate(t time.Time) *IncomeVersion {
+	method := "Income.GetVersionForDate"
+	klogger.Enter(method)
+
+	var v *IncomeVersion
+
+	for k := range i.Versions {
+		if i.Versions[k].EffectiveDt.After(t) {
+			break
 		}
 
+		v = &i.Versions[k]
+	}
+
+	klogger.Exit(method)
+	return v
+}
+
+// Function LoadLoggedHours attaches the hours in harr logged for the income in the order of their pay dates
+func (i *Income) LoadLoggedHours(harr []*IncomeHours) {
+	method := "Income.LoadLoggedHours"
+	klogger.Enter(method)
+
+	i.LoggedHours = []IncomeHours{}
+	for _, h := range harr {
+		if h.IncomeID == i.ID {
+			i.LoggedHours = append(i.LoggedHours, *h)
+		}
+	}
+
+	sort.SliceStable(i.LoggedHours, func(a, b int) bool {
+		return i.LoggedHours[a].PayDt.Before(i.LoggedHours[b].PayDt)
+	})
+
+	klogger.Exit(method)
+}
+
+// Function GetLoggedHoursForDate returns the hours logged for the paycheck on d. Paychecks without logged hours are projected
+// from the average of the most recent pay periods logged before d. Returns false if no hours were logged on or before d.
+// Logged hours must be loaded in the order of their pay dates
+func (i *Income) GetLoggedHoursForDate(d time.Time) (float64, bool) {
+	method := "Income.GetLoggedHoursForDate"
+	klogger.Enter(method)
+
+	var prior []IncomeHours
+
+	for _, h := range i.LoggedHours {
+		if fmUtil.GetStartOfDay(h.PayDt).Equal(fmUtil.GetStartOfDay(d)) {
+			klogger.Exit(method)
+			return h.Hours, true
+		}
+
+		if h.PayDt.After(d) {
+			break
+		}
+
+		prior = append(prior, h)
+	}
+
+	if len(prior) == 0 {
 		klogger.Exit(method)
-		return pays
+		return 0, false
+	}
+
+	if len(prior) > constants.TrailingHoursPeriods {
+		prior = prior[len(prior)-constants.TrailingHoursPeriods:]
+	}
+
+	total := 0.0
+	for _, h := range prior {
+		total += h.Hours
 	}
+
+	klogger.Exit(method)
+	return total / float64(len(prior)), true
 }
 
 func (i *Income) GetMonthlyNetPay(t time.Time) float64 {
 	method := "Income.GetMonthlyNetPay"
 	klogger.Enter(method)
 
-	netPay := i.NetPay * float64(i.GetPaysForMonthContainingDate(t))
+	netPay := 0.0
+	for _, p := range i.GetPaychecksForMonthContainingDate(t) {
+		netPay += p.NetPay
+	}
 
 	klogger.Exit(method)
 	return netPay
 }
 
 func (i *Income) GetMonthlyGrossPay(t time.Time) float64 {
-	method := "Income.GetMonthlyNetPay"
+	method := "Income.GetMonthlyGrossPay"
 	klogger.Enter(method)
 
-	grossPay := i.GrossPay * float64(i.GetPaysForMonthContainingDate(t))
+	grossPay := 0.0
+	for _, p := range i.GetPaychecksForMonthContainingDate(t) {
+		grossPay += p.GrossPay
+	}
 
 	klogger.Exit(method)
 	return grossPay
@@ -289,28 +792,11 @@ func (i *Income) GetMonthlyTaxes(t time.Time) float64 {
 	method := "Income.GetMonthlyTaxes"
 	klogger.Enter(method)
 
-	taxes := i.Taxes * float64(i.GetPaysForMonthContainingDate(t))
-
-	klogger.Exit(method)
-	return taxes
-}
-
-// Function getPayStartingDtForMonth returns either the first day of the month 't' occurs in
-// or the first nanosecond of s if s is after t.
-// t is the month we are getting the pay starting date for
-// s is the StartDt of the income this is being called for
-func getWeeklyPayStartingDtForMonth(t time.Time, s time.Time) time.Time {
-	method := "Income.getPayStartingDtForMonth"
-	klogger.Enter(method)
-
-	monthBeginDt := fmUtil.GetMonthBeginDate(t)
-	startDt := fmUtil.GetStartOfDay(s)
-
-	if startDt.Before(monthBeginDt) {
-		klogger.Exit(method)
-		return monthBeginDt
+	taxes := 0.0
+	for _, p := range i.GetPaychecksForMonthContainingDate(t) {
+		taxes += p.Taxes
 	}
 
 	klogger.Exit(method)
-	return startDt
+	return taxes
 }
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"strings"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type IncomeHours is the number of hours actually worked in the pay period of an hourly Income ending on PayDt.
+// Paychecks without logged hours are projected from a trailing average of the hours logged before them
+type IncomeHours struct {
+	ID           int       `json:"id"`
+	IncomeID     int       `json:"incomeId"`
+	UserID       int       `json:"userId"`
+	PayDt        time.Time `json:"payDt"`
+	Hours        float64   `json:"hours"`
+	CreateDt     time.Time `json:"-"`
+	LastUpdateDt time.Time `json:"-"`
+}
+
+func (h *IncomeHours) ValidateCanSaveIncomeHours(i Income) error {
+	method := "IncomeHours.ValidateCanSaveIncomeHours"
+	klogger.Enter(method)
+
+	if h.IncomeID <= 0 {
+		err := errors.New("cannot save income hours without incomeId")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if strings.Compare(i.Type, constants.IncomeTypeHourly) != 0 {
+		err := errors.New("hours can only be logged for hourly incomes")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if h.Hours < 0 {
+		err := errors.New("hours cannot be negative")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if h.PayDt.IsZero() {
+		err := errors.New("pay date is required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if len(i.GetPayDatesBetween(h.PayDt, h.PayDt)) == 0 {
+		err := errors.New("pay date is not a payday of the income")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	for _, e := range i.LoggedHours {
+		if e.PayDt.Equal(h.PayDt) {
+			err := errors.New("income already has hours logged for this pay date")
+			klogger.ExitError(method, err.Error())
+			return err
+		}
+	}
+
+	klogger.Exit(method)
+	return nil
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCanSaveIncomeHours(t *testing.T) {
+	method := "IncomeHours_test.TestValidateCanSaveIncomeHours"
+	klogger.Enter(method)
+
+	i := Income{
+		ID:          1,
+		Type:        constants.IncomeTypeHourly,
+		Frequency:   constants.IncomeFreqWeekly,
+		StartDt:     time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+		LoggedHours: []IncomeHours{{PayDt: time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	var ht IncomeHours
+	h := IncomeHours{
+		IncomeID: 1,
+		Hours:    45,
+		PayDt:    time.Date(2024, 1, 19, 0, 0, 0, 0, time.UTC),
+	}
+
+	err := h.ValidateCanSaveIncomeHours(i)
+	assert.Nil(t, err)
+
+	//IncomeId is required
+	ht = h
+	ht.IncomeID = 0
+	err = ht.ValidateCanSaveIncomeHours(i)
+	assert.NotNil(t, err)
+
+	//Hours cannot be negative
+	ht = h
+	ht.Hours = -1
+	err = ht.ValidateCanSaveIncomeHours(i)
+	assert.NotNil(t, err)
+
+	//Pay date is required and must be a payday of the income
+	ht = h
+	ht.PayDt = time.Time{}
+	err = ht.ValidateCanSaveIncomeHours(i)
+	assert.NotNil(t, err)
+
+	ht.PayDt = time.Date(2024, 1, 18, 0, 0, 0, 0, time.UTC)
+	err = ht.ValidateCanSaveIncomeHours(i)
+	assert.NotNil(t, err)
+
+	//Hours can only be logged once per pay date
+	ht.PayDt = i.LoggedHours[0].PayDt
+	err = ht.ValidateCanSaveIncomeHours(i)
+	assert.NotNil(t, err)
+
+	//Hours can only be logged for hourly incomes
+	ht = h
+	it := i
+	it.Type = constants.IncomeTypeSalary
+	err = ht.ValidateCanSaveIncomeHours(it)
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
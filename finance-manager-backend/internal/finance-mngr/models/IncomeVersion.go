@@ -0,0 +1,74 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type IncomeVersion is a change to the pay rate of an Income, such as a raise, that is in effect from EffectiveDt until the next version begins.
+// Versions without Hours keep the hours of the income. ExpirationDt is derived from the income's versions and is not persisted
+type IncomeVersion struct {
+	ID           int        `json:"id"`
+	IncomeID     int        `json:"incomeId"`
+	UserID       int        `json:"userId"`
+	Rate         float64    `json:"rate"`
+	Hours        float64    `json:"hours"`
+	EffectiveDt  time.Time  `json:"effectiveDt"`
+	ExpirationDt *time.Time `json:"expirationDt"`
+	CreateDt     time.Time  `json:"-"`
+	LastUpdateDt time.Time  `json:"-"`
+}
+
+func (v *IncomeVersion) ValidateCanSaveIncomeVersion(i Income) error {
+	method := "IncomeVersion.ValidateCanSaveIncomeVersion"
+	klogger.Enter(method)
+
+	if v.IncomeID <= 0 {
+		err := errors.New("cannot save income version without incomeId")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if v.Rate <= 0 {
+		err := errors.New("pay rate is required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if v.Hours < 0 {
+		err := errors.New("hours cannot be negative")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if v.EffectiveDt.IsZero() {
+		err := errors.New("effective date is required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if !v.EffectiveDt.After(i.StartDt) {
+		err := errors.New("effective date must be after the income start date")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if i.EndDt != nil && v.EffectiveDt.After(*i.EndDt) {
+		err := errors.New("effective date cannot be after the income end date")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	for _, e := range i.Versions {
+		if e.EffectiveDt.Equal(v.EffectiveDt) {
+			err := errors.New("income already has a version effective on this date")
+			klogger.ExitError(method, err.Error())
+			return err
+		}
+	}
+
+	klogger.Exit(method)
+	return nil
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCanSaveIncomeVersion(t *testing.T) {
+	method := "IncomeVersion_test.TestValidateCanSaveIncomeVersion"
+	klogger.Enter(method)
+
+	end := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	i := Income{
+		ID:       1,
+		StartDt:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDt:    &end,
+		Versions: []IncomeVersion{{EffectiveDt: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	var vt IncomeVersion
+	v := IncomeVersion{
+		IncomeID:    1,
+		Rate:        30,
+		EffectiveDt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	err := v.ValidateCanSaveIncomeVersion(i)
+	assert.Nil(t, err)
+
+	//IncomeId is required
+	vt = v
+	vt.IncomeID = 0
+	err = vt.ValidateCanSaveIncomeVersion(i)
+	assert.NotNil(t, err)
+
+	//Rate must be greater than 0
+	vt = v
+	vt.Rate = 0
+	err = vt.ValidateCanSaveIncomeVersion(i)
+	assert.NotNil(t, err)
+
+	//Hours cannot be negative
+	vt = v
+	vt.Hours = -1
+	err = vt.ValidateCanSaveIncomeVersion(i)
+	assert.NotNil(t, err)
+
+	//Effective date is required and must be after the income starts and before it ends
+	vt = v
+	vt.EffectiveDt = time.Time{}
+	err = vt.ValidateCanSaveIncomeVersion(i)
+	assert.NotNil(t, err)
+
+	vt.EffectiveDt = i.StartDt
+	err = vt.ValidateCanSaveIncomeVersion(i)
+	assert.NotNil(t, err)
+
+	vt.EffectiveDt = end.AddDate(0, 0, 1)
+	err = vt.ValidateCanSaveIncomeVersion(i)
+	assert.NotNil(t, err)
+
+	//Only one version may take effect on a date
+	vt.EffectiveDt = i.Versions[0].EffectiveDt
+	err = vt.ValidateCanSaveIncomeVersion(i)
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
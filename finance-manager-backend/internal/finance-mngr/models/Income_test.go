@@ -1,8 +1,10 @@
 package models
 
 import (
+	"errors"
 	"finance-manager-backend/internal/finance-mngr/constants"
-	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"finance-manager-backend/internal/finance-mngr/tax"
+	"math"
 	"testing"
 	"time"
 
@@ -368,32 +370,682 @@ func TestValidateCanSaveIncome(t *testing.T) {
 	err = it.ValidateCanSaveIncome()
 	assert.NotNil(t, err)
 
+	//Retirement percentages must be between 0 and 1
+	it = i
+	it.RetirementPercentage = 0.06
+	it.RothPercentage = 0.04
+	err = it.ValidateCanSaveIncome()
+	assert.Nil(t, err)
+
+	it.RetirementPercentage = -0.01
+	err = it.ValidateCanSaveIncome()
+	assert.NotNil(t, err)
+
+	it.RetirementPercentage = 0.97
+	err = it.ValidateCanSaveIncome()
+	assert.NotNil(t, err)
+
+	//Deductions cannot be negative
+	it = i
+	it.HealthPremium = -10
+	err = it.ValidateCanSaveIncome()
+	assert.NotNil(t, err)
+
+	//Regular hours cap cannot be negative
+	it = i
+	it.RegularHoursCap = -1
+	err = it.ValidateCanSaveIncome()
+	assert.NotNil(t, err)
+
+	//Overtime multiplier must be at least 1 when set
+	it = i
+	it.OvertimeMultiplier = 0.5
+	err = it.ValidateCanSaveIncome()
+	assert.NotNil(t, err)
+
+	it.OvertimeMultiplier = 2
+	err = it.ValidateCanSaveIncome()
+	assert.Nil(t, err)
+
+	//End date cannot be before the start date
+	it = i
+	end := i.StartDt.AddDate(0, 0, -1)
+	it.EndDt = &end
+	err = it.ValidateCanSaveIncome()
+	assert.NotNil(t, err)
+
+	//Filing status must be valid when given
+	it = i
+	it.FilingStatus = constants.FilingStatusMarriedJoint
+	err = it.ValidateCanSaveIncome()
+	assert.Nil(t, err)
+
+	it.FilingStatus = "status"
+	err = it.ValidateCanSaveIncome()
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
+
+func TestGetPayDatesBetween(t *testing.T) {
+	method := "Income_test.TestGetPayDatesBetween"
+	klogger.Enter(method)
+
+	s := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	e := time.Date(2024, 2, 29, 23, 59, 59, 0, time.UTC)
+
+	i := Income{
+		Frequency: constants.IncomeFreqBiWeekly,
+		StartDt:   time.Date(2024, 1, 5, 13, 6, 4, 5, time.UTC),
+	}
+
+	assert.Equal(t, []time.Time{time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 16, 0, 0, 0, 0, time.UTC)}, i.GetPayDatesBetween(s, e))
+
+	//Income does not pay before it starts
+	i.StartDt = time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, []time.Time{time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC)}, i.GetPayDatesBetween(s, e))
+
+	i.Frequency = constants.IncomeFreqMonthly
+	i.StartDt = time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, []time.Time{time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)}, i.GetPayDatesBetween(s, e))
+
+	klogger.Exit(method)
+}
+
+// Type stubTaxEngine owes 10% federal and 5% state income tax, and 6% social security and 2% medicare on FICA wages
+type stubTaxEngine struct{}
+
+func (e stubTaxEngine) CalcAnnualLiability(g float64, f float64, filingStatus string, state string) (tax.TaxLiability, error) {
+	if filingStatus == constants.FilingStatusMarriedSeparate {
+		return tax.TaxLiability{}, errors.New("filing status is not supported")
+	}
+
+	l := tax.TaxLiability{
+		Federal:        g * 0.10,
+		SocialSecurity: f * 0.06,
+		Medicare:       f * 0.02,
+	}
+
+	if state != "" {
+		l.State = g * 0.05
+	}
+
+	l.Total = l.Federal + l.State + l.SocialSecurity + l.Medicare
+	return l, nil
+}
+
+func TestGetPaysPerYear(t *testing.T) {
+	method := "Income_test.TestGetPaysPerYear"
+	klogger.Enter(method)
+
+	i := Income{Frequency: constants.IncomeFreqWeekly}
+	assert.Equal(t, constants.PaysPerYearWeekly, i.GetPaysPerYear())
+
+	i.Frequency = constants.IncomeFreqBiWeekly
+	assert.Equal(t, constants.PaysPerYearBiWeekly, i.GetPaysPerYear())
+
+	i.Frequency = constants.IncomeFreqMonthly
+	assert.Equal(t, constants.PaysPerYearMonthly, i.GetPaysPerYear())
+
+	i.Frequency = constants.IncomeFreqSemiMonthly
+	assert.Equal(t, constants.PaysPerYearSemiMonthly, i.GetPaysPerYear())
+
+	i.Frequency = constants.IncomeFreqQuarterly
+	assert.Equal(t, constants.PaysPerYearQuarterly, i.GetPaysPerYear())
+
+	i.Frequency = constants.IncomeFreqAnnual
+	assert.Equal(t, constants.PaysPerYearAnnual, i.GetPaysPerYear())
+
+	i.Frequency = "freq"
+	assert.Equal(t, 0, i.GetPaysPerYear())
+
+	klogger.Exit(method)
+}
+
+func TestCalcTaxes(t *testing.T) {
+	method := "Income_test.TestCalcTaxes"
+	klogger.Enter(method)
+
+	i := Income{
+		GrossPay:      1000,
+		Taxes:         150,
+		NetPay:        850,
+		TaxPercentage: 0.15,
+		Frequency:     constants.IncomeFreqMonthly,
+	}
+
+	//Incomes without a filing status keep their flat tax
+	err := i.CalcTaxes(stubTaxEngine{})
+	assert.Nil(t, err)
+	assert.Equal(t, 150.0, i.Taxes)
+	assert.Equal(t, map[string]float64{taxName: 150.0}, i.GetTaxItems())
+
+	//A nil engine keeps the flat tax
+	i.FilingStatus = constants.FilingStatusSingle
+	err = i.CalcTaxes(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 150.0, i.Taxes)
+
+	i.State = "VA"
+	err = i.CalcTaxes(stubTaxEngine{})
+	assert.Nil(t, err)
+	assert.InDelta(t, 100.0, i.FederalTax, 0.001)
+	assert.InDelta(t, 50.0, i.StateTax, 0.001)
+	assert.InDelta(t, 60.0, i.SocialSecurityTax, 0.001)
+	assert.InDelta(t, 20.0, i.MedicareTax, 0.001)
+	assert.InDelta(t, 230.0, i.Taxes, 0.001)
+	assert.InDelta(t, 770.0, i.NetPay, 0.001)
+
+	items := i.GetTaxItems()
+	assert.InDelta(t, 100.0, items[constants.TaxNameFederal], 0.001)
+	assert.InDelta(t, 50.0, items[constants.TaxNameState], 0.001)
+	assert.InDelta(t, 60.0, items[constants.TaxNameSocialSecurity], 0.001)
+	assert.InDelta(t, 20.0, items[constants.TaxNameMedicare], 0.001)
+
+	//Engine errors are returned
+	i.FilingStatus = constants.FilingStatusMarriedSeparate
+	err = i.CalcTaxes(stubTaxEngine{})
+	assert.NotNil(t, err)
+
+	//Pre-tax deductions lower income tax, but only health premiums and HSA contributions lower FICA wages
+	i.FilingStatus = constants.FilingStatusSingle
+	i.State = ""
+	i.RetirementPercentage = 0.10
+	i.HealthPremium = 50
+	i.RothPercentage = 0.05
+	i.Garnishment = 25
+	err = i.CalcTaxes(stubTaxEngine{})
+	assert.Nil(t, err)
+	assert.InDelta(t, 150.0, i.PreTaxDeductions, 0.001)
+	assert.InDelta(t, 850.0, i.TaxableGross, 0.001)
+	assert.InDelta(t, 85.0, i.FederalTax, 0.001)
+	assert.InDelta(t, 57.0, i.SocialSecurityTax, 0.001)
+	assert.InDelta(t, 19.0, i.MedicareTax, 0.001)
+	assert.InDelta(t, 75.0, i.PostTaxDeductions, 0.001)
+	assert.InDelta(t, 150.0, i.RetirementContribution, 0.001)
+	assert.InDelta(t, 614.0, i.NetPay, 0.001)
+	assert.InDelta(t, i.NetPay, i.Paystub.NetPay, 0.001)
+
+	//Invalid frequencies cannot be annualized
+	i.FilingStatus = constants.FilingStatusSingle
+	i.Frequency = "freq"
+	err = i.CalcTaxes(stubTaxEngine{})
+	assert.NotNil(t, err)
+
 	klogger.Exit(method)
 }
 
-func TestGetPayStartingDtForMonth(t *testing.T) {
-	method := "Income_test.TestGetPayStartingDtForMonth"
+// Type progressiveTaxEngine deducts 10,000 and owes 10% federal tax on the first 50,000 and 20% above it, and 6% social security on
+// FICA wages up to 100,000
+type progressiveTaxEngine struct{}
+
+func (e progressiveTaxEngine) CalcAnnualLiability(g float64, f float64, filingStatus string, state string) (tax.TaxLiability, error) {
+	t := math.Max(g-10000, 0)
+
+	l := tax.TaxLiability{
+		Federal:        math.Min(t, 50000)*0.10 + math.Max(t-50000, 0)*0.20,
+		SocialSecurity: math.Min(f, 100000) * 0.06,
+	}
+
+	l.Total = l.Federal + l.SocialSecurity
+	return l, nil
+}
+
+func TestCalcUserTaxes(t *testing.T) {
+	method := "Income_test.TestCalcUserTaxes"
 	klogger.Enter(method)
 
-	//Date to test against (only month matters)
-	d := time.Date(2024, 2, 12, 20, 45, 6, 8, time.UTC)
+	i1 := Income{ID: 1, GrossPay: 5000, FilingStatus: constants.FilingStatusSingle, Frequency: constants.IncomeFreqMonthly}
+	i2 := Income{ID: 2, GrossPay: 5000, FilingStatus: constants.FilingStatusSingle, Frequency: constants.IncomeFreqMonthly}
+	i3 := Income{ID: 3, GrossPay: 1000, Taxes: 150, TaxPercentage: 0.15, Frequency: constants.IncomeFreqMonthly}
 
-	//Starting dates to compre
-	s1 := time.Date(2023, 2, 23, 13, 6, 4, 5, time.UTC) //Later date in month but for prior year
-	s2 := time.Date(2024, 1, 23, 10, 2, 4, 5, time.UTC) //Date is before month begin date
-	s3 := time.Date(2024, 2, 15, 10, 2, 4, 5, time.UTC) //Date is after month begin date
+	//Taxed alone, each income gets its own deduction and stays in the lowest bracket
+	err := i1.CalcTaxes(progressiveTaxEngine{})
+	assert.Nil(t, err)
+	assert.InDelta(t, 5000.0/12, i1.FederalTax, 0.001)
+	assert.InDelta(t, 300.0, i1.SocialSecurityTax, 0.001)
 
-	td1 := getWeeklyPayStartingDtForMonth(d, s1)
-	td2 := getWeeklyPayStartingDtForMonth(d, s2)
-	td3 := getWeeklyPayStartingDtForMonth(d, s3)
+	//Taxed together, the deduction, brackets and wage base apply once to their total and the liability is split evenly
+	err = CalcUserTaxes([]*Income{&i1, &i2, &i3}, progressiveTaxEngine{})
+	assert.Nil(t, err)
+	assert.InDelta(t, 8500.0/12, i1.FederalTax, 0.001)
+	assert.InDelta(t, 8500.0/12, i2.FederalTax, 0.001)
+	assert.InDelta(t, 250.0, i1.SocialSecurityTax, 0.001)
+	assert.InDelta(t, 250.0, i2.SocialSecurityTax, 0.001)
+	assert.InDelta(t, 8500.0/12+250, i1.Taxes, 0.001)
+	assert.InDelta(t, 5000-i1.Taxes, i1.NetPay, 0.001)
+
+	//Incomes without a filing status keep their flat tax and are not part of the total
+	assert.Equal(t, 150.0, i3.Taxes)
+
+	//Paychecks recalculated later are still taxed with the user's other incomes
+	i1.CalcTaxes(progressiveTaxEngine{})
+	assert.InDelta(t, 8500.0/12, i1.FederalTax, 0.001)
+
+	//Errors are returned
+	i2.FilingStatus = constants.FilingStatusMarriedSeparate
+	err = CalcUserTaxes([]*Income{&i1, &i2}, stubTaxEngine{})
+	assert.NotNil(t, err)
 
-	//td1 should be beginning of the month of d since s1 is before that date
-	//td2 should be beginning of the month of d since s2 is before that date
-	//td3 should be beginning of the day of s3 since it is after the beginning of the month of d
+	klogger.Exit(method)
+}
+
+func TestPopulateEmptyValues_deductions(t *testing.T) {
+	method := "Income_test.TestPopulateEmptyValues_deductions"
+	klogger.Enter(method)
+
+	i := Income{
+		Rate:                 2000,
+		Type:                 constants.IncomeTypeSalary,
+		Frequency:            constants.IncomeFreqBiWeekly,
+		StartDt:              testDate,
+		TaxPercentage:        0.20,
+		RetirementPercentage: 0.05,
+		HSAContribution:      50,
+		HealthPremium:        100,
+		RothPercentage:       0.02,
+		Garnishment:          60,
+	}
+
+	err := i.PopulateEmptyValues(testDate)
+	assert.Nil(t, err)
+
+	//Taxes are only owed on gross pay after pre-tax deductions
+	assert.Equal(t, 2000.0, i.GrossPay)
+	assert.InDelta(t, 250.0, i.PreTaxDeductions, 0.001)
+	assert.InDelta(t, 1750.0, i.TaxableGross, 0.001)
+	assert.InDelta(t, 350.0, i.Taxes, 0.001)
+	assert.InDelta(t, 100.0, i.PostTaxDeductions, 0.001)
+	assert.InDelta(t, 140.0, i.RetirementContribution, 0.001)
+	assert.InDelta(t, 1300.0, i.NetPay, 0.001)
+
+	//Paystub lists each deduction in order
+	p := i.Paystub
+	assert.Equal(t, []PaystubItem{
+		{Name: constants.DeductionNameRetirement, Amount: 100},
+		{Name: constants.DeductionNameHSA, Amount: 50},
+		{Name: constants.DeductionNameHealthPremium, Amount: 100},
+	}, p.PreTaxDeductions)
+	assert.Equal(t, []PaystubItem{{Name: taxName, Amount: 350}}, p.Taxes)
+	assert.Equal(t, []PaystubItem{
+		{Name: constants.DeductionNameRoth, Amount: 40},
+		{Name: constants.DeductionNameGarnishment, Amount: 60},
+	}, p.PostTaxDeductions)
+	assert.InDelta(t, 700.0, p.TotalDeductions, 0.001)
+	assert.InDelta(t, p.GrossPay-p.TotalDeductions, p.NetPay, 0.001)
+
+	klogger.Exit(method)
+}
+
+func TestGetPaysForMonthContainingDate_semiMonthly(t *testing.T) {
+	method := "Income_test.TestGetPaysForMonthContainingDate_semiMonthly"
+	klogger.Enter(method)
+
+	i := Income{
+		Frequency: constants.IncomeFreqSemiMonthly,
+		StartDt:   time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, 2, i.GetPaysForMonthContainingDate(testDate))
+
+	//Paydays on a weekend are paid the Friday before. August 31st 2024 is a Saturday
+	assert.Equal(t, []time.Time{
+		time.Date(2024, 8, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 8, 30, 0, 0, 0, 0, time.UTC),
+	}, i.GetPayDatesBetween(time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 8, 31, 0, 0, 0, 0, time.UTC)))
+
+	klogger.Exit(method)
+}
+
+func TestGetPaysForMonthContainingDate_quarterly(t *testing.T) {
+	method := "Income_test.TestGetPaysForMonthContainingDate_quarterly"
+	klogger.Enter(method)
+
+	i := Income{
+		Frequency: constants.IncomeFreqQuarterly,
+		StartDt:   time.Date(2023, 10, 20, 0, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, 1, i.GetPaysForMonthContainingDate(testDate))
+	assert.Equal(t, 0, i.GetPaysForMonthContainingDate(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)))
+
+	//Annual incomes are paid once a year. April 20th 2024 is a Saturday
+	i.Frequency = constants.IncomeFreqAnnual
+	i.StartDt = time.Date(2023, 4, 20, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 0, i.GetPaysForMonthContainingDate(testDate))
+	assert.Equal(t, 1, i.GetPaysForMonthContainingDate(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)))
+
+	err := i.PopulateEmptyValues(testDate)
+	assert.NotNil(t, err)
+
+	i.Rate = 5000
+	err = i.PopulateEmptyValues(testDate)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2024, 4, 19, 0, 0, 0, 0, time.UTC), i.NextDt)
+	assert.Equal(t, 2080.0, i.Hours)
+
+	klogger.Exit(method)
+}
+
+func mockIncomeVersions() []*IncomeVersion {
+	v1 := IncomeVersion{
+		ID:          1,
+		IncomeID:    1,
+		Rate:        4000,
+		EffectiveDt: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	v2 := IncomeVersion{
+		ID:          2,
+		IncomeID:    1,
+		Rate:        3600,
+		EffectiveDt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	v3 := IncomeVersion{
+		ID:          3,
+		IncomeID:    2,
+		Rate:        100,
+		EffectiveDt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	return []*IncomeVersion{&v1, &v2, &v3}
+}
+
+func TestLoadVersions(t *testing.T) {
+	method := "Income_test.TestLoadVersions"
+	klogger.Enter(method)
+
+	i := Income{ID: 1}
+	i.LoadVersions(mockIncomeVersions())
+
+	//Only versions of the income are loaded in the order they take effect
+	assert.Equal(t, 2, len(i.Versions))
+	assert.Equal(t, 2, i.Versions[0].ID)
+	assert.Equal(t, 1, i.Versions[1].ID)
+
+	//Each version expires when the next begins and the last expires with the income
+	assert.Equal(t, time.Date(2024, 5, 31, 23, 59, 59, 999000000, time.UTC), *i.Versions[0].ExpirationDt)
+	assert.Nil(t, i.Versions[1].ExpirationDt)
+
+	end := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	i.EndDt = &end
+	i.LoadVersions(mockIncomeVersions())
+	assert.Equal(t, end, *i.Versions[1].ExpirationDt)
+
+	klogger.Exit(method)
+}
+
+func TestGetVersionForDate(t *testing.T) {
+	method := "Income_test.TestGetVersionForDate"
+	klogger.Enter(method)
+
+	i := Income{ID: 1}
+	assert.Nil(t, i.GetVersionForDate(testDate))
+
+	i.LoadVersions(mockIncomeVersions())
+
+	assert.Nil(t, i.GetVersionForDate(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 3600.0, i.GetVersionForDate(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)).Rate)
+	assert.Equal(t, 4000.0, i.GetVersionForDate(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)).Rate)
+
+	klogger.Exit(method)
+}
+
+func TestGetPaychecksForMonthContainingDate(t *testing.T) {
+	method := "Income_test.TestGetPaychecksForMonthContainingDate"
+	klogger.Enter(method)
+
+	i := Income{
+		ID:            1,
+		Rate:          3000,
+		Type:          constants.IncomeTypeSalary,
+		Frequency:     constants.IncomeFreqMonthly,
+		TaxPercentage: 0.1,
+		StartDt:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	i.LoadVersions(mockIncomeVersions())
+
+	err := i.PopulateEmptyValues(time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+
+	//Values are populated with the rate in effect on the next payday
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), i.NextDt)
+	assert.Equal(t, 3600.0, i.GrossPay)
+	assert.Equal(t, 3000.0, i.Rate)
+
+	//Each month is paid at the rate in effect on its payday
+	assert.Equal(t, 3000.0, i.GetMonthlyGrossPay(time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 3600.0, i.GetMonthlyGrossPay(time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)))
+	assert.InDelta(t, 360.0, i.GetMonthlyTaxes(time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)), 0.001)
+	assert.InDelta(t, 3600.0, i.GetMonthlyNetPay(time.Date(2024, 7, 10, 0, 0, 0, 0, time.UTC)), 0.001)
+
+	//Pays stop after the end date
+	end := time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC)
+	i.EndDt = &end
+
+	assert.Equal(t, 1, len(i.GetPaychecksForMonthContainingDate(time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC))))
+	assert.Equal(t, 0, len(i.GetPaychecksForMonthContainingDate(time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC))))
+
+	err = i.PopulateEmptyValues(time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.True(t, i.NextDt.IsZero())
+
+	klogger.Exit(method)
+}
+
+func TestGetPaychecksForMonthContainingDate_raise(t *testing.T) {
+	method := "Income_test.TestGetPaychecksForMonthContainingDate_raise"
+	klogger.Enter(method)
+
+	i := Income{
+		ID:            1,
+		Rate:          25,
+		Hours:         80,
+		Type:          constants.IncomeTypeHourly,
+		Frequency:     constants.IncomeFreqBiWeekly,
+		TaxPercentage: 0.1,
+		StartDt:       time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+	}
+
+	//A raise in the middle of the month only applies to the paychecks after it
+	i.LoadVersions([]*IncomeVersion{{IncomeID: 1, Rate: 30, EffectiveDt: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)}})
+
+	err := i.PopulateEmptyValues(testDate)
+	assert.Nil(t, err)
+
+	paychecks := i.GetPaychecksForMonthContainingDate(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, 3, len(paychecks))
+	assert.Equal(t, 2000.0, paychecks[0].GrossPay)
+	assert.Equal(t, 2400.0, paychecks[1].GrossPay)
+	assert.Equal(t, 2400.0, paychecks[2].GrossPay)
+	assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), paychecks[1].NextDt)
+
+	//Versions with hours replace the hours of the income
+	i.LoadVersions([]*IncomeVersion{{IncomeID: 1, Rate: 30, Hours: 60, EffectiveDt: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)}})
+	assert.Equal(t, 1800.0, i.GetPaycheckForDate(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)).GrossPay)
+
+	klogger.Exit(method)
+}
+
+func mockOvertimeIncome() Income {
+	return Income{
+		ID:              1,
+		Rate:            20,
+		Hours:           50,
+		RegularHoursCap: 40,
+		Type:            constants.IncomeTypeHourly,
+		Frequency:       constants.IncomeFreqWeekly,
+		StartDt:         time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func mockIncomeHours() []*IncomeHours {
+	var harr []*IncomeHours
+
+	hours := []float64{40, 44, 48, 36, 52}
+	for k, h := range hours {
+		harr = append(harr, &IncomeHours{
+			ID:       k + 1,
+			IncomeID: 1,
+			PayDt:    time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC).AddDate(0, 0, 7*k),
+			Hours:    h,
+		})
+	}
+
+	//Hours logged for another income are ignored
+	harr = append(harr, &IncomeHours{ID: 6, IncomeID: 2, PayDt: time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC), Hours: 10})
+
+	//Hours are loaded in the order of their pay dates
+	harr[0], harr[4] = harr[4], harr[0]
+
+	return harr
+}
+
+func TestPopulateEmptyValues_overtime(t *testing.T) {
+	method := "Income_test.TestPopulateEmptyValues_overtime"
+	klogger.Enter(method)
+
+	i := mockOvertimeIncome()
+
+	//Hours past the cap are paid at the default multiplier
+	err := i.PopulateEmptyValues(testDate)
+	assert.Nil(t, err)
+	assert.Equal(t, 50.0, i.PaidHours)
+	assert.Equal(t, 10.0, i.OvertimeHours)
+	assert.Equal(t, 300.0, i.OvertimePay)
+	assert.Equal(t, 1100.0, i.GrossPay)
+	assert.Equal(t, 50.0, i.Paystub.Hours)
+	assert.Equal(t, 300.0, i.Paystub.OvertimePay)
+
+	//Overtime is paid at the multiplier of the income when set
+	i.OvertimeMultiplier = 2
+	err = i.PopulateEmptyValues(testDate)
+	assert.Nil(t, err)
+	assert.Equal(t, 1200.0, i.GrossPay)
+
+	//No overtime is paid without a cap or under it
+	i.RegularHoursCap = 0
+	err = i.PopulateEmptyValues(testDate)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, i.OvertimeHours)
+	assert.Equal(t, 1000.0, i.GrossPay)
+
+	i.RegularHoursCap = 60
+	err = i.PopulateEmptyValues(testDate)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, i.OvertimePay)
+	assert.Equal(t, 1000.0, i.GrossPay)
+
+	//Salaried incomes are not paid overtime
+	i.Type = constants.IncomeTypeSalary
+	i.RegularHoursCap = 40
+	err = i.PopulateEmptyValues(testDate)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, i.OvertimePay)
+	assert.Equal(t, 20.0, i.GrossPay)
+
+	klogger.Exit(method)
+}
+
+func TestGetLoggedHoursForDate(t *testing.T) {
+	method := "Income_test.TestGetLoggedHoursForDate"
+	klogger.Enter(method)
+
+	i := mockOvertimeIncome()
+
+	_, ok := i.GetLoggedHoursForDate(testDate)
+	assert.False(t, ok)
+
+	i.LoadLoggedHours(mockIncomeHours())
+	assert.Equal(t, 5, len(i.LoggedHours))
+	assert.Equal(t, 1, i.LoggedHours[0].ID)
+
+	//No hours are projected before the first logged pay period
+	_, ok = i.GetLoggedHoursForDate(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+
+	//Logged pay periods are paid for their hours
+	h, ok := i.GetLoggedHoursForDate(time.Date(2024, 1, 19, 14, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, 48.0, h)
+
+	//Pay periods without logged hours use the average of the pay periods logged before them
+	h, ok = i.GetLoggedHoursForDate(time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, 44.0, h)
+
+	//Only the most recent pay periods are averaged
+	h, ok = i.GetLoggedHoursForDate(time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, 45.0, h)
+
+	klogger.Exit(method)
+}
+
+func TestGetPaychecksForMonthContainingDate_loggedHours(t *testing.T) {
+	method := "Income_test.TestGetPaychecksForMonthContainingDate_loggedHours"
+	klogger.Enter(method)
+
+	i := mockOvertimeIncome()
+	i.LoadLoggedHours(mockIncomeHours())
+
+	err := i.PopulateEmptyValues(time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+
+	//The next paycheck is projected from the trailing average
+	assert.Equal(t, time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC), i.NextDt)
+	assert.Equal(t, 45.0, i.PaidHours)
+	assert.Equal(t, 950.0, i.GrossPay)
+
+	//February 2nd was logged with 12 hours of overtime and the rest of the month is projected
+	paychecks := i.GetPaychecksForMonthContainingDate(time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, 4, len(paychecks))
+	assert.Equal(t, 1160.0, paychecks[0].GrossPay)
+	assert.Equal(t, 950.0, paychecks[1].GrossPay)
+	assert.Equal(t, 4010.0, i.GetMonthlyGrossPay(time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)))
+
+	klogger.Exit(method)
+}
+
+func TestIncomeGetPostingsBetween(t *testing.T) {
+	method := "Income_test.TestIncomeGetPostingsBetween"
+	klogger.Enter(method)
+
+	s := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	e := time.Date(2024, 2, 29, 23, 59, 59, 0, time.UTC)
+
+	i := Income{
+		ID:            1,
+		UserID:        1,
+		Name:          "Job",
+		Rate:          25,
+		Hours:         80,
+		Type:          constants.IncomeTypeHourly,
+		Frequency:     constants.IncomeFreqBiWeekly,
+		TaxPercentage: 0.1,
+		StartDt:       time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+		AccountID:     2,
+	}
+
+	err := i.PopulateEmptyValues(s)
+	assert.Nil(t, err)
 
-	assert.Equal(t, fmUtil.GetMonthBeginDate(d), td1)
-	assert.Equal(t, fmUtil.GetMonthBeginDate(d), td2)
-	assert.Equal(t, fmUtil.GetStartOfDay(s3), td3)
+	//Net pay is deposited on each payday
+	p := i.GetPostingsBetween(s, e)
+	assert.Equal(t, 2, len(p))
+	assert.Equal(t, 2, p[0].BankAccountID)
+	assert.Equal(t, constants.TransactionTypeDeposit, p[0].Type)
+	assert.Equal(t, 1800.0, p[0].Amount)
+	assert.Equal(t, time.Date(2024, 2, 16, 0, 0, 0, 0, time.UTC), p[1].TransactionDt)
+	assert.Equal(t, constants.TransactionSourceIncome, p[1].Source)
+	assert.Equal(t, 1, p[1].SourceID)
+
+	//Incomes without an account are not posted
+	i.AccountID = 0
+	assert.Equal(t, 0, len(i.GetPostingsBetween(s, e)))
 
 	klogger.Exit(method)
 }
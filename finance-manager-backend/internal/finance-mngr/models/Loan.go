@@ -2,7 +2,11 @@ package models
 
 import (
 	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
 	"math"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/jon-kamis/klogger"
@@ -11,23 +15,70 @@ import (
 type Loan struct {
 	ID              int                   `json:"id"`
 	UserID          int                   `json:"userId"`
-	Name            string                `json:"name"`
-	Total           float64               `json:"total"`
+	Name            string                `json:"name" gorm:"column:loan_name"`
+	Total           float64               `json:"total" gorm:"column:total_balance"`
 	InterestRate    float64               `json:"interestRate"`
 	MonthlyPayment  float64               `json:"monthlyPayment"`
-	Interest        float64               `json:"interest"`
+	Interest        float64               `json:"interest" gorm:"column:total_interest"`
 	TotalCost       float64               `json:"totalCost"`
-	TotalPayment    float64               `json:"totalPayment"`
+	TotalPayment    float64               `json:"totalPayment" gorm:"column:total_principal"`
 	LoanTerm        int                   `json:"loanTerm"`
-	PaymentSchedule []PaymentScheduleItem `json:"paymentSchedule"`
+	Prepayments     []LoanPrepayment      `json:"prepayments" gorm:"-"`
+	RateSchedule    LoanRateSchedule      `json:"rateSchedule" gorm:"embedded"`
+	InterestSaved   float64               `json:"interestSaved" gorm:"-"`
+	MonthsSaved     int                   `json:"monthsSaved" gorm:"-"`
+	PaymentSchedule []PaymentScheduleItem `json:"paymentSchedule" gorm:"-"`
+	Balance         float64               `json:"balance" gorm:"-"`
+	PrincipalPaid   float64               `json:"principalPaid" gorm:"-"`
+	InterestPaid    float64               `json:"interestPaid" gorm:"-"`
+	FeesPaid        float64               `json:"feesPaid" gorm:"-"`
+	PaymentsMade    int                   `json:"paymentsMade" gorm:"-"`
+	LastPaymentDt   time.Time             `json:"lastPaymentDt" gorm:"-"`
+	PaymentHistory  []PaymentScheduleItem `json:"paymentHistory" gorm:"-"`
 	CreateDt        time.Time             `json:"-"`
 	LastUpdateDt    time.Time             `json:"-"`
 }
 
+// Type LoanPrepayment holds an extra principal payment made on top of a loan's monthly payment.
+// Recurring prepayments apply every month from StartMonth through EndMonth, or until the loan is paid off if EndMonth is 0.
+// Lump sum prepayments apply once in StartMonth
+type LoanPrepayment struct {
+	Type       string  `json:"type"`
+	Amount     float64 `json:"amount"`
+	StartMonth int     `json:"startMonth"`
+	EndMonth   int     `json:"endMonth"`
+}
+
+// Type LoanRateSchedule describes how the interest rate of a variable rate loan changes over time.
+// The loan's InterestRate applies for the first InitialFixedMonths months. The rate then moves toward AdjustedRate
+// every AdjustmentInterval months, limited by InitialCap on the first adjustment, PeriodicCap on each later adjustment,
+// and LifetimeCap from the loan's starting rate. Caps of 0 are treated as uncapped.
+// Steps set the rate explicitly starting in a given month and take precedence over adjustments made in the same month.
+// A schedule with no InitialFixedMonths and no Steps describes a fixed rate loan
+type LoanRateSchedule struct {
+	InitialFixedMonths int            `json:"initialFixedMonths"`
+	AdjustmentInterval int            `json:"adjustmentInterval"`
+	AdjustedRate       float64        `json:"adjustedRate"`
+	InitialCap         float64        `json:"initialCap"`
+	PeriodicCap        float64        `json:"periodicCap"`
+	LifetimeCap        float64        `json:"lifetimeCap"`
+	Steps              []LoanRateStep `json:"steps" gorm:"column:rate_steps;type:jsonb;serializer:json"`
+}
+
+// Type LoanRateStep sets a loan's interest rate starting in Month
+type LoanRateStep struct {
+	Month        int     `json:"month"`
+	InterestRate float64 `json:"interestRate"`
+}
+
 type PaymentScheduleItem struct {
 	Month            int     `json:"month"`
+	InterestRate     float64 `json:"interestRate"`
+	Payment          float64 `json:"payment"`
 	Principal        float64 `json:"principal"`
+	ExtraPrincipal   float64 `json:"extraPrincipal"`
 	Interest         float64 `json:"interest"`
+	Fees             float64 `json:"fees"`
 	InterestToDate   float64 `json:"interestToDate"`
 	PrincipalToDate  float64 `json:"principalToDate"`
 	RemainingBalance float64 `json:"remainingBalance"`
@@ -38,6 +89,9 @@ type PaymentScheduleComparisonItem struct {
 	Principal             float64 `json:"principal"`
 	PrincipalNew          float64 `json:"principalNew"`
 	PrincipalDelta        float64 `json:"principalDelta"`
+	ExtraPrincipal        float64 `json:"extraPrincipal"`
+	ExtraPrincipalNew     float64 `json:"extraPrincipalNew"`
+	ExtraPrincipalDelta   float64 `json:"extraPrincipalDelta"`
 	Interest              float64 `json:"interest"`
 	InterestNew           float64 `json:"interestNew"`
 	InterestDelta         float64 `json:"interestDelta"`
@@ -52,8 +106,11 @@ type PaymentScheduleComparisonItem struct {
 	RemainingBalanceDelta float64 `json:"remainingBalanceDelta"`
 }
 
+// Type LoansSummary summarizes a user's loans. Count, TotalBalance and MonthlyCost only include loans with a remaining balance,
+// while TotalCount includes paid off loans
 type LoansSummary struct {
 	Count        int     `json:"count"`
+	TotalCount   int     `json:"totalCount"`
 	TotalBalance float64 `json:"totalBalance"`
 	MonthlyCost  float64 `json:"monthlyCost"`
 }
@@ -74,6 +131,18 @@ func (l *Loan) PerformCalc() error {
 	principalToDate = 0
 	totalPayment = 0
 
+	err := l.ValidatePrepayments()
+	if err != nil {
+		klogger.ExitError(method, "Loan calculation request is invalid:\n%s", err)
+		return err
+	}
+
+	err = l.ValidateRateSchedule()
+	if err != nil {
+		klogger.ExitError(method, "Loan calculation request is invalid:\n%s", err)
+		return err
+	}
+
 	// Calculate monthly payment
 	if l.MonthlyPayment < 1 {
 		err := l.PerformPaymentCalc()
@@ -85,25 +154,47 @@ func (l *Loan) PerformCalc() error {
 		}
 	}
 
+	term := l.LoanTerm
+	payment := l.MonthlyPayment
+	rate := l.GetInterestRateForMonth(1)
+
 	for totalCalc > 0 {
-		interest := (totalCalc * (l.InterestRate / 100)) / 12
-		thisPay := l.MonthlyPayment - interest
+		months++
+
+		//Re-amortize the remaining balance over the remaining term when the rate changes
+		if r := l.GetInterestRateForMonth(months); r != rate {
+			rate = r
+			payment = CalcAmortizedPayment(totalCalc, rate, int(math.Max(float64(term-months+1), 1)))
+		}
+
+		interest := (totalCalc * (rate / 100)) / 12
+		thisPay := payment - interest
 
 		interestToDate += interest
 		principalToDate += thisPay
-		months++
-		if totalCalc-thisPay > 0.009 {
-			totalCalc -= thisPay
+		extra := l.GetPrepaymentForMonth(months)
+
+		if totalCalc-thisPay-extra > 0.009 {
+			totalCalc -= thisPay + extra
+		} else if totalCalc-thisPay > 0.009 {
+			//Prepayment pays off the remaining balance
+			extra = totalCalc - thisPay
+			totalCalc = 0.0
 		} else {
 			thisPay = totalCalc - interest
+			extra = 0
 			totalCalc = 0.0
 		}
+		principalToDate += extra
 		totalInterest = totalInterest + interest
-		totalPayment = totalPayment + thisPay
+		totalPayment = totalPayment + thisPay + extra
 
 		paymentSum := PaymentScheduleItem{
 			Month:            months,
+			InterestRate:     rate,
+			Payment:          thisPay + interest,
 			Principal:        thisPay,
+			ExtraPrincipal:   extra,
 			Interest:         interest,
 			InterestToDate:   interestToDate,
 			PrincipalToDate:  principalToDate,
@@ -122,13 +213,286 @@ func (l *Loan) PerformCalc() error {
 	l.TotalPayment = totalPayment
 	l.Interest = totalInterest
 	l.TotalCost = totalPayment + totalInterest
-	l.LoanTerm = months
 	l.PaymentSchedule = paymentSchedule
+	l.InterestSaved = 0
+	l.MonthsSaved = 0
+
+	if len(l.Prepayments) == 0 {
+		l.LoanTerm = months
+		klogger.Exit(method)
+		return nil
+	}
+
+	//Calculate the same loan without prepayments to determine what they save
+	b := *l
+	b.Prepayments = nil
+	err = b.PerformCalc()
+
+	if err != nil {
+		klogger.ExitError(method, "failed to calculate baseline loan:\n%v", err)
+		return err
+	}
+
+	l.LoanTerm = b.LoanTerm
+	l.InterestSaved = b.Interest - l.Interest
+	l.MonthsSaved = b.LoanTerm - months
 
 	klogger.Exit(method)
 	return nil
 }
 
+// Function GetPrepaymentForMonth returns the total extra principal scheduled for month m of the loan
+func (l *Loan) GetPrepaymentForMonth(m int) float64 {
+	method := "Loan.GetPrepaymentForMonth"
+	klogger.Enter(method)
+
+	extra := 0.0
+
+	for _, p := range l.Prepayments {
+		switch p.Type {
+		case constants.LoanPrepaymentTypeLumpSum:
+			if p.StartMonth == m {
+				extra += p.Amount
+			}
+		case constants.LoanPrepaymentTypeRecurring:
+			if p.StartMonth <= m && (p.EndMonth == 0 || m <= p.EndMonth) {
+				extra += p.Amount
+			}
+		}
+	}
+
+	klogger.Exit(method)
+	return extra
+}
+
+func (l *Loan) ValidatePrepayments() error {
+	method := "Loan.ValidatePrepayments"
+	klogger.Enter(method)
+
+	for _, p := range l.Prepayments {
+		isValidType := false
+
+		for _, t := range constants.ValidLoanPrepaymentTypes {
+			if strings.Compare(p.Type, t) == 0 {
+				isValidType = true
+			}
+		}
+
+		if !isValidType {
+			errMsg := "prepayment type is invalid"
+			klogger.ExitError(method, errMsg)
+			return errors.New(errMsg)
+		}
+
+		if p.Amount <= 0 {
+			errMsg := "prepayment amount must be greater than 0"
+			klogger.ExitError(method, errMsg)
+			return errors.New(errMsg)
+		}
+
+		if p.StartMonth < 1 {
+			errMsg := "prepayment start month must be at least 1"
+			klogger.ExitError(method, errMsg)
+			return errors.New(errMsg)
+		}
+
+		if p.EndMonth != 0 && p.EndMonth < p.StartMonth {
+			errMsg := "prepayment end month cannot be before its start month"
+			klogger.ExitError(method, errMsg)
+			return errors.New(errMsg)
+		}
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function LoadPayments derives the loan's current balance and payment history from the payments recorded against it.
+// Payments belonging to other loans are ignored. Each regular payment starts a new month of the history and pays that month's
+// interest before principal. Extra principal and late fees are applied to the month of the most recent regular payment
+func (l *Loan) LoadPayments(parr []*LoanPayment) {
+	method := "Loan.LoadPayments"
+	klogger.Enter(method)
+
+	var lp []*LoanPayment
+	for _, p := range parr {
+		if p.LoanID == l.ID {
+			lp = append(lp, p)
+		}
+	}
+
+	sort.SliceStable(lp, func(i, j int) bool {
+		if !lp[i].PaymentDt.Equal(lp[j].PaymentDt) {
+			return lp[i].PaymentDt.Before(lp[j].PaymentDt)
+		}
+		return lp[i].ID < lp[j].ID
+	})
+
+	balance := l.Total
+	months := 0
+	principalPaid := 0.0
+	interestPaid := 0.0
+	feesPaid := 0.0
+	var lastPaymentDt time.Time
+	var history []PaymentScheduleItem
+
+	for _, p := range lp {
+		p.Principal = 0
+		p.Interest = 0
+
+		//Start a new month for each regular payment, or for the first payment of any kind
+		if len(history) == 0 || (p.Type == constants.LoanPaymentTypePayment && history[len(history)-1].Payment > 0) {
+			history = append(history, PaymentScheduleItem{Month: len(history) + 1})
+		}
+
+		item := &history[len(history)-1]
+
+		switch p.Type {
+		case constants.LoanPaymentTypePayment:
+			months++
+			lastPaymentDt = p.PaymentDt
+			rate := l.GetInterestRateForMonth(item.Month)
+			p.Interest = math.Min((balance*(rate/100))/12, p.Amount)
+			p.Principal = math.Min(p.Amount-p.Interest, balance)
+
+			item.InterestRate = rate
+			item.Payment += p.Amount
+			item.Principal += p.Principal
+			item.Interest += p.Interest
+		case constants.LoanPaymentTypeExtraPrincipal:
+			p.Principal = math.Min(p.Amount, balance)
+			item.ExtraPrincipal += p.Principal
+		case constants.LoanPaymentTypeLateFee:
+			item.Fees += p.Amount
+			feesPaid += p.Amount
+		}
+
+		balance -= p.Principal
+		principalPaid += p.Principal
+		interestPaid += p.Interest
+		p.RemainingBalance = balance
+
+		item.PrincipalToDate = principalPaid
+		item.InterestToDate = interestPaid
+		item.RemainingBalance = balance
+	}
+
+	l.Balance = balance
+	l.PrincipalPaid = principalPaid
+	l.InterestPaid = interestPaid
+	l.FeesPaid = feesPaid
+	l.PaymentsMade = months
+	l.LastPaymentDt = lastPaymentDt
+	l.PaymentHistory = history
+
+	klogger.Exit(method)
+}
+
+// Function GetRemainingBalance returns the balance left on the loan after its recorded payments.
+// Loans without any payment history return their original total
+func (l *Loan) GetRemainingBalance() float64 {
+	method := "Loan.GetRemainingBalance"
+	klogger.Enter(method)
+
+	if len(l.PaymentHistory) == 0 {
+		klogger.Exit(method)
+		return l.Total
+	}
+
+	klogger.Exit(method)
+	return l.Balance
+}
+
+// Function GetPaymentRecurrence returns the monthly recurrence the loan's payments are due on. Payments are due on the day of the
+// most recent recorded regular payment, or on the day the loan was created if none have been recorded
+func (l *Loan) GetPaymentRecurrence() fmUtil.Recurrence {
+	method := "Loan.GetPaymentRecurrence"
+	klogger.Enter(method)
+
+	r := fmUtil.Recurrence{
+		Frequency: constants.RecurrenceFreqMonthly,
+		StartDt:   l.CreateDt,
+	}
+
+	if !l.LastPaymentDt.IsZero() {
+		r.StartDt = l.LastPaymentDt
+	}
+
+	klogger.Exit(method)
+	return r
+}
+
+// Function GetMonthForDate returns the month of the loan that a payment made on d falls in, counting the first month after
+// the loan was created as month 1
+func (l *Loan) GetMonthForDate(d time.Time) int {
+	method := "Loan.GetMonthForDate"
+	klogger.Enter(method)
+
+	m := fmUtil.GetMonthsBetween(l.CreateDt, d)
+
+	klogger.Exit(method)
+	return max(m, 1)
+}
+
+// Function GetProjectedBalance returns the balance the loan is projected to have after m more monthly payments.
+// Each payment pays that month's interest before principal at the rate of the loan's next month
+func (l *Loan) GetProjectedBalance(m int) float64 {
+	method := "Loan.GetProjectedBalance"
+	klogger.Enter(method)
+
+	b := l.GetRemainingBalance()
+
+	for k := 1; k <= m && b > 0.009; k++ {
+		interest := (b * (l.GetInterestRateForMonth(l.PaymentsMade+k) / 100)) / 12
+		pay := math.Min(l.MonthlyPayment, b+interest)
+		b -= pay - interest
+	}
+
+	if b < 0.009 {
+		b = 0
+	}
+
+	klogger.Exit(method)
+	return b
+}
+
+// Function GetProjectedPayment returns the payment due on the loan in the month m months from now. The final payment only covers what is
+// left on the loan, and loans projected to be paid off have no payment
+func (l *Loan) GetProjectedPayment(m int) float64 {
+	method := "Loan.GetProjectedPayment"
+	klogger.Enter(method)
+
+	b := l.GetProjectedBalance(m)
+
+	if b <= 0.009 {
+		klogger.Exit(method)
+		return 0
+	}
+
+	interest := (b * (l.GetInterestRateForMonth(l.PaymentsMade+m+1) / 100)) / 12
+
+	klogger.Exit(method)
+	return math.Min(l.MonthlyPayment, b+interest)
+}
+
+// Function CompareActualPayments compares the loan's scheduled amortization against its payment history.
+// The loan must have its payment schedule calculated and its payments loaded.
+// Scheduled months after the most recent actual payment are not included
+func (l *Loan) CompareActualPayments() []PaymentScheduleComparisonItem {
+	method := "Loan.CompareActualPayments"
+	klogger.Enter(method)
+
+	scheduled := *l
+	if len(scheduled.PaymentSchedule) > len(l.PaymentHistory) {
+		scheduled.PaymentSchedule = scheduled.PaymentSchedule[:len(l.PaymentHistory)]
+	}
+
+	actual := Loan{PaymentSchedule: l.PaymentHistory}
+
+	klogger.Exit(method)
+	return scheduled.CompareLoanPayments(actual)
+}
+
 func (l *Loan) PerformPaymentCalc() error {
 	method := "Loan.PerformPaymentCalc"
 	klogger.Enter(method)
@@ -141,13 +505,122 @@ func (l *Loan) PerformPaymentCalc() error {
 		return err
 	}
 
+	//Variable rate loans are amortized at their first month's rate and re-amortized when the rate changes
+	l.MonthlyPayment = CalcAmortizedPayment(l.Total, l.GetInterestRateForMonth(1), l.LoanTerm)
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function CalcAmortizedPayment returns the monthly payment that pays off principal p at annual interest rate r over n months
+func CalcAmortizedPayment(p float64, r float64, n int) float64 {
+	method := "Loan.CalcAmortizedPayment"
+	klogger.Enter(method)
+
+	if r == 0 {
+		klogger.Exit(method)
+		return p / float64(n)
+	}
+
 	//int = (i+1)^n
 
 	// Payment is P / {[(1+i)^n]-1} / [i(1+i)^n] where P is starting principal, i is the interest rate divided by 12, and n is the number of payments
-	i := (l.InterestRate / 100) / 12
-	p := l.Total
-	n := l.LoanTerm
-	l.MonthlyPayment = p / ((math.Pow((i+1), float64(n)) - 1) / (i * math.Pow((i+1), float64(n))))
+	i := (r / 100) / 12
+
+	klogger.Exit(method)
+	return p / ((math.Pow((i+1), float64(n)) - 1) / (i * math.Pow((i+1), float64(n))))
+}
+
+// Function GetInterestRateForMonth returns the interest rate charged in month m of the loan according to its rate schedule
+func (l *Loan) GetInterestRateForMonth(m int) float64 {
+	method := "Loan.GetInterestRateForMonth"
+	klogger.Enter(method)
+
+	s := l.RateSchedule
+	rate := l.InterestRate
+	interval := s.AdjustmentInterval
+	adjustments := 0
+
+	if interval <= 0 {
+		interval = constants.LoanDefaultAdjustmentInterval
+	}
+
+	for i := 1; i <= m; i++ {
+		if s.InitialFixedMonths > 0 && i > s.InitialFixedMonths && (i-s.InitialFixedMonths-1)%interval == 0 {
+			c := s.PeriodicCap
+			if adjustments == 0 && s.InitialCap > 0 {
+				c = s.InitialCap
+			}
+
+			delta := s.AdjustedRate - rate
+			if c > 0 {
+				delta = math.Max(math.Min(delta, c), -c)
+			}
+
+			rate += delta
+
+			if s.LifetimeCap > 0 {
+				rate = math.Max(math.Min(rate, l.InterestRate+s.LifetimeCap), l.InterestRate-s.LifetimeCap)
+			}
+
+			rate = math.Max(rate, 0)
+			adjustments++
+		}
+
+		for _, st := range s.Steps {
+			if st.Month == i {
+				rate = st.InterestRate
+			}
+		}
+	}
+
+	klogger.Exit(method)
+	return rate
+}
+
+func (l *Loan) ValidateRateSchedule() error {
+	method := "Loan.ValidateRateSchedule"
+	klogger.Enter(method)
+
+	s := l.RateSchedule
+
+	if s.InitialFixedMonths < 0 {
+		errMsg := "initial fixed months cannot be negative"
+		klogger.ExitError(method, errMsg)
+		return errors.New(errMsg)
+	}
+
+	if s.AdjustmentInterval < 0 {
+		errMsg := "adjustment interval cannot be negative"
+		klogger.ExitError(method, errMsg)
+		return errors.New(errMsg)
+	}
+
+	if s.AdjustedRate < 0 {
+		errMsg := "adjusted rate cannot be negative"
+		klogger.ExitError(method, errMsg)
+		return errors.New(errMsg)
+	}
+
+	if s.InitialCap < 0 || s.PeriodicCap < 0 || s.LifetimeCap < 0 {
+		errMsg := "rate caps cannot be negative"
+		klogger.ExitError(method, errMsg)
+		return errors.New(errMsg)
+	}
+
+	for _, st := range s.Steps {
+		if st.Month < 1 {
+			errMsg := "rate step month must be at least 1"
+			klogger.ExitError(method, errMsg)
+			return errors.New(errMsg)
+		}
+
+		if st.InterestRate < 0 {
+			errMsg := "rate step interest rate cannot be negative"
+			klogger.ExitError(method, errMsg)
+			return errors.New(errMsg)
+		}
+	}
 
 	klogger.Exit(method)
 	return nil
@@ -231,6 +704,12 @@ func (l *Loan) ValidateCanSaveLoan() error {
 		return errors.New(errMsg)
 	}
 
+	err := l.ValidateRateSchedule()
+	if err != nil {
+		klogger.ExitError(method, "cannot save loan with invalid rate schedule:\n%v", err)
+		return err
+	}
+
 	klogger.Exit(method)
 	return nil
 }
@@ -263,6 +742,9 @@ func (l *Loan) CompareLoanPayments(c Loan) []PaymentScheduleComparisonItem {
 				Principal:             lv.Principal,
 				PrincipalNew:          cv.Principal,
 				PrincipalDelta:        cv.Principal - lv.Principal,
+				ExtraPrincipal:        lv.ExtraPrincipal,
+				ExtraPrincipalNew:     cv.ExtraPrincipal,
+				ExtraPrincipalDelta:   cv.ExtraPrincipal - lv.ExtraPrincipal,
 				Interest:              lv.Interest,
 				InterestNew:           cv.Interest,
 				InterestDelta:         cv.Interest - lv.Interest,
@@ -291,6 +773,9 @@ func (l *Loan) CompareLoanPayments(c Loan) []PaymentScheduleComparisonItem {
 				Principal:             lv.Principal,
 				PrincipalNew:          0,
 				PrincipalDelta:        0 - lv.Principal,
+				ExtraPrincipal:        lv.ExtraPrincipal,
+				ExtraPrincipalNew:     0,
+				ExtraPrincipalDelta:   0 - lv.ExtraPrincipal,
 				Interest:              lv.Interest,
 				InterestNew:           0,
 				InterestDelta:         0 - lv.Interest,
@@ -314,6 +799,9 @@ func (l *Loan) CompareLoanPayments(c Loan) []PaymentScheduleComparisonItem {
 				Principal:             0,
 				PrincipalNew:          cv.Principal,
 				PrincipalDelta:        cv.Principal,
+				ExtraPrincipal:        0,
+				ExtraPrincipalNew:     cv.ExtraPrincipal,
+				ExtraPrincipalDelta:   cv.ExtraPrincipal,
 				Interest:              0,
 				InterestNew:           cv.Interest,
 				InterestDelta:         cv.Interest,
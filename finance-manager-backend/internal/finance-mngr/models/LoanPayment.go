@@ -0,0 +1,71 @@
+package models
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"strings"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type LoanPayment is an actual payment, late fee, or extra principal payment recorded against a loan.
+// Principal, Interest and RemainingBalance are derived from the loan's payment history and are not persisted
+type LoanPayment struct {
+	ID               int       `json:"id"`
+	LoanID           int       `json:"loanId"`
+	UserID           int       `json:"userId"`
+	Type             string    `json:"type"`
+	Amount           float64   `json:"amount"`
+	Principal        float64   `json:"principal" gorm:"-"`
+	Interest         float64   `json:"interest" gorm:"-"`
+	RemainingBalance float64   `json:"remainingBalance" gorm:"-"`
+	PaymentDt        time.Time `json:"paymentDate"`
+	CreateDt         time.Time `json:"-"`
+	LastUpdateDt     time.Time `json:"-"`
+}
+
+func (p *LoanPayment) ValidateCanSaveLoanPayment() error {
+	method := "LoanPayment.ValidateCanSaveLoanPayment"
+	klogger.Enter(method)
+
+	isValidType := false
+	for _, t := range constants.ValidLoanPaymentTypes {
+		if strings.Compare(p.Type, t) == 0 {
+			isValidType = true
+		}
+	}
+
+	if !isValidType {
+		errMsg := "loan payment type is invalid"
+		klogger.ExitError(method, errMsg)
+		return errors.New(errMsg)
+	}
+
+	if p.Amount <= 0 {
+		errMsg := "cannot save loan payment without amount"
+		klogger.ExitError(method, errMsg)
+		return errors.New(errMsg)
+	}
+
+	if p.PaymentDt.IsZero() {
+		errMsg := "cannot save loan payment without payment date"
+		klogger.ExitError(method, errMsg)
+		return errors.New(errMsg)
+	}
+
+	if p.LoanID <= 0 {
+		errMsg := "cannot save loan payment without loanId"
+		klogger.ExitError(method, errMsg)
+		return errors.New(errMsg)
+	}
+
+	if p.UserID <= 0 {
+		errMsg := "cannot save loan payment without userId"
+		klogger.ExitError(method, errMsg)
+		return errors.New(errMsg)
+	}
+
+	klogger.Exit(method)
+	return nil
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCanSaveLoanPayment(t *testing.T) {
+	method := "LoanPayment_test.TestValidateCanSaveLoanPayment"
+	klogger.Enter(method)
+
+	p := LoanPayment{
+		LoanID:    1,
+		UserID:    1,
+		Type:      constants.LoanPaymentTypePayment,
+		Amount:    100,
+		PaymentDt: time.Now(),
+	}
+
+	assert.Nil(t, p.ValidateCanSaveLoanPayment())
+
+	p.Type = "invalid"
+	assert.NotNil(t, p.ValidateCanSaveLoanPayment())
+	p.Type = constants.LoanPaymentTypeLateFee
+
+	p.Amount = 0
+	assert.NotNil(t, p.ValidateCanSaveLoanPayment())
+	p.Amount = 100
+
+	p.PaymentDt = time.Time{}
+	assert.NotNil(t, p.ValidateCanSaveLoanPayment())
+	p.PaymentDt = time.Now()
+
+	p.LoanID = 0
+	assert.NotNil(t, p.ValidateCanSaveLoanPayment())
+	p.LoanID = 1
+
+	p.UserID = 0
+	assert.NotNil(t, p.ValidateCanSaveLoanPayment())
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,162 @@
+package models
+
+import (
+	"errors"
+	"math"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type LoanRefinance compares keeping the remaining balance of an existing loan against refinancing it
+// into a new loan with a different rate and term. Cash out is added to the new loan's principal, and
+// closing costs are either paid up front or added to the new loan's principal when FinanceClosingCosts is set.
+// The break even month is the first month in which the interest saved by refinancing covers the closing costs
+type LoanRefinance struct {
+	InterestRate        float64                         `json:"interestRate"`
+	LoanTerm            int                             `json:"loanTerm"`
+	ClosingCosts        float64                         `json:"closingCosts"`
+	CashOut             float64                         `json:"cashOut"`
+	FinanceClosingCosts bool                            `json:"financeClosingCosts"`
+	CurrentBalance      float64                         `json:"currentBalance"`
+	CurrentPayment      float64                         `json:"currentPayment"`
+	NewPayment          float64                         `json:"newPayment"`
+	MonthlyPaymentDelta float64                         `json:"monthlyPaymentDelta"`
+	CurrentInterest     float64                         `json:"currentInterest"`
+	NewInterest         float64                         `json:"newInterest"`
+	InterestDelta       float64                         `json:"interestDelta"`
+	TotalCostDelta      float64                         `json:"totalCostDelta"`
+	BreaksEven          bool                            `json:"breaksEven"`
+	BreakEvenMonth      int                             `json:"breakEvenMonth"`
+	PaymentComparison   []PaymentScheduleComparisonItem `json:"paymentComparison"`
+}
+
+func (r *LoanRefinance) ValidateCanPerformCalc() error {
+	method := "LoanRefinance.ValidateCanPerformCalc"
+	klogger.Enter(method)
+
+	if r.InterestRate < 0 {
+		errMsg := "cannot perform calculation without interest rate"
+		klogger.ExitError(method, errMsg)
+		return errors.New(errMsg)
+	}
+
+	if r.LoanTerm <= 0 {
+		errMsg := "cannot perform calculation without loan term"
+		klogger.ExitError(method, errMsg)
+		return errors.New(errMsg)
+	}
+
+	if r.ClosingCosts < 0 {
+		errMsg := "closing costs cannot be negative"
+		klogger.ExitError(method, errMsg)
+		return errors.New(errMsg)
+	}
+
+	if r.CashOut < 0 {
+		errMsg := "cash out cannot be negative"
+		klogger.ExitError(method, errMsg)
+		return errors.New(errMsg)
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function Calculate compares the remaining balance and term of loan l against refinancing it.
+// l should have its payments loaded so that its remaining balance and payments made are current.
+// Variable rate loans are compared at the rates of their schedule for the months remaining after their next payment
+func (r *LoanRefinance) Calculate(l Loan) error {
+	method := "LoanRefinance.Calculate"
+	klogger.Enter(method)
+
+	err := r.ValidateCanPerformCalc()
+	if err != nil {
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	balance := l.GetRemainingBalance()
+
+	if balance <= 0.009 {
+		err := errors.New("cannot refinance a loan that has been paid off")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	term := int(math.Max(float64(l.LoanTerm-l.PaymentsMade), 1))
+
+	//The remaining loan keeps the rate schedule of the months it has left, so variable rate loans are re-amortized as their rate changes
+	current := Loan{
+		Total:        balance,
+		InterestRate: l.GetInterestRateForMonth(l.PaymentsMade + 1),
+		LoanTerm:     term,
+		RateSchedule: l.getRemainingRateSchedule(term),
+	}
+
+	principal := balance + r.CashOut
+	if r.FinanceClosingCosts {
+		principal += r.ClosingCosts
+	}
+
+	refinanced := Loan{
+		Total:        principal,
+		InterestRate: r.InterestRate,
+		LoanTerm:     r.LoanTerm,
+	}
+
+	err = current.PerformCalc()
+	if err != nil {
+		klogger.ExitError(method, "failed to calculate current loan:\n%v", err)
+		return err
+	}
+
+	err = refinanced.PerformCalc()
+	if err != nil {
+		klogger.ExitError(method, "failed to calculate refinanced loan:\n%v", err)
+		return err
+	}
+
+	r.CurrentBalance = balance
+	r.CurrentPayment = current.MonthlyPayment
+	r.NewPayment = refinanced.MonthlyPayment
+	r.MonthlyPaymentDelta = refinanced.MonthlyPayment - current.MonthlyPayment
+	r.CurrentInterest = current.Interest
+	r.NewInterest = refinanced.Interest
+	r.InterestDelta = refinanced.Interest - current.Interest
+	r.TotalCostDelta = r.InterestDelta + r.ClosingCosts
+	r.PaymentComparison = current.CompareLoanPayments(refinanced)
+	r.BreaksEven = false
+	r.BreakEvenMonth = 0
+
+	for _, c := range r.PaymentComparison {
+		//InterestToDateDelta is negative while refinancing has saved interest
+		if -c.InterestToDateDelta >= r.ClosingCosts {
+			r.BreaksEven = true
+			r.BreakEvenMonth = c.Month
+			break
+		}
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function getRemainingRateSchedule returns the rate changes of the n months of the loan after its payments made as steps
+// numbered from its next payment. The rate of the next payment is the starting rate of the remaining loan and is not a step
+func (l *Loan) getRemainingRateSchedule(n int) LoanRateSchedule {
+	method := "LoanRefinance.getRemainingRateSchedule"
+	klogger.Enter(method)
+
+	var s LoanRateSchedule
+	rate := l.GetInterestRateForMonth(l.PaymentsMade + 1)
+
+	for m := 2; m <= n; m++ {
+		if r := l.GetInterestRateForMonth(l.PaymentsMade + m); r != rate {
+			rate = r
+			s.Steps = append(s.Steps, LoanRateStep{Month: m, InterestRate: r})
+		}
+	}
+
+	klogger.Exit(method)
+	return s
+}
@@ -0,0 +1,175 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoanRefinanceValidateCanPerformCalc(t *testing.T) {
+	method := "LoanRefinance_test.TestLoanRefinanceValidateCanPerformCalc"
+	klogger.Enter(method)
+
+	r := LoanRefinance{
+		InterestRate: 4,
+		LoanTerm:     60,
+	}
+
+	assert.Nil(t, r.ValidateCanPerformCalc())
+
+	r.LoanTerm = 0
+	assert.NotNil(t, r.ValidateCanPerformCalc())
+	r.LoanTerm = 60
+
+	r.InterestRate = -1
+	assert.NotNil(t, r.ValidateCanPerformCalc())
+	r.InterestRate = 4
+
+	r.ClosingCosts = -1
+	assert.NotNil(t, r.ValidateCanPerformCalc())
+	r.ClosingCosts = 0
+
+	r.CashOut = -1
+	assert.NotNil(t, r.ValidateCanPerformCalc())
+
+	klogger.Exit(method)
+}
+
+func TestLoanRefinanceCalculate(t *testing.T) {
+	method := "LoanRefinance_test.TestLoanRefinanceCalculate"
+	klogger.Enter(method)
+
+	l := Loan{
+		ID:           1,
+		Total:        10000,
+		InterestRate: 8,
+		LoanTerm:     60,
+	}
+
+	r := LoanRefinance{
+		InterestRate: 4,
+		LoanTerm:     60,
+		ClosingCosts: 200,
+	}
+
+	err := r.Calculate(l)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 10000.0, r.CurrentBalance)
+	assert.Equal(t, 202.76, math.Round(r.CurrentPayment*100)/100)
+	assert.Equal(t, 184.17, math.Round(r.NewPayment*100)/100)
+	assert.Equal(t, -18.6, math.Round(r.MonthlyPaymentDelta*100)/100)
+	assert.Less(t, r.InterestDelta, 0.0)
+	assert.Equal(t, r.InterestDelta+200, r.TotalCostDelta)
+	assert.Equal(t, 60, len(r.PaymentComparison))
+
+	//Interest saved first covers the closing costs in the break even month
+	assert.True(t, r.BreaksEven)
+	assert.Greater(t, r.BreakEvenMonth, 1)
+	assert.GreaterOrEqual(t, -r.PaymentComparison[r.BreakEvenMonth-1].InterestToDateDelta, 200.0)
+	assert.Less(t, -r.PaymentComparison[r.BreakEvenMonth-2].InterestToDateDelta, 200.0)
+
+	//A higher rate never breaks even
+	r.InterestRate = 10
+	err = r.Calculate(l)
+	assert.Nil(t, err)
+	assert.False(t, r.BreaksEven)
+	assert.Equal(t, 0, r.BreakEvenMonth)
+
+	klogger.Exit(method)
+}
+
+func TestLoanRefinanceCalculate_payments(t *testing.T) {
+	method := "LoanRefinance_test.TestLoanRefinanceCalculate_payments"
+	klogger.Enter(method)
+
+	l := Loan{
+		ID:           1,
+		Total:        10000,
+		InterestRate: 8,
+		LoanTerm:     60,
+	}
+
+	l.LoadPayments([]*LoanPayment{
+		{LoanID: 1, Type: constants.LoanPaymentTypeExtraPrincipal, Amount: 4000, PaymentDt: time.Now()},
+	})
+
+	r := LoanRefinance{
+		InterestRate:        4,
+		LoanTerm:            36,
+		ClosingCosts:        100,
+		CashOut:             1000,
+		FinanceClosingCosts: true,
+	}
+
+	err := r.Calculate(l)
+	assert.Nil(t, err)
+
+	//Refinance starts from the remaining balance and finances cash out and closing costs
+	assert.Equal(t, 6000.0, r.CurrentBalance)
+	assert.Equal(t, 60, len(r.PaymentComparison))
+	assert.Equal(t, 7100.0, math.Round(r.PaymentComparison[0].PrincipalNew+r.PaymentComparison[0].RemainingBalanceNew))
+
+	//Paid off loans cannot be refinanced
+	l.LoadPayments([]*LoanPayment{
+		{LoanID: 1, Type: constants.LoanPaymentTypeExtraPrincipal, Amount: 10000, PaymentDt: time.Now()},
+	})
+
+	err = r.Calculate(l)
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
+
+func TestLoanRefinanceCalculate_variableRate(t *testing.T) {
+	method := "LoanRefinance_test.TestLoanRefinanceCalculate_variableRate"
+	klogger.Enter(method)
+
+	l := Loan{
+		ID:           1,
+		Total:        10000,
+		InterestRate: 4,
+		LoanTerm:     60,
+		RateSchedule: LoanRateSchedule{
+			InitialFixedMonths: 12,
+			AdjustedRate:       8,
+		},
+	}
+
+	full := l
+	err := full.PerformCalc()
+	assert.Nil(t, err)
+
+	var parr []*LoanPayment
+	for i := 0; i < 6; i++ {
+		parr = append(parr, &LoanPayment{LoanID: 1, Type: constants.LoanPaymentTypePayment, Amount: full.MonthlyPayment, PaymentDt: time.Date(2024, time.Month(i+1), 1, 0, 0, 0, 0, time.UTC)})
+	}
+
+	l.LoadPayments(parr)
+
+	r := LoanRefinance{
+		InterestRate: 4,
+		LoanTerm:     54,
+		ClosingCosts: 50,
+	}
+
+	err = r.Calculate(l)
+	assert.Nil(t, err)
+
+	//The remaining loan is paid at the fixed rate until the rate adjusts in its seventh month
+	assert.Equal(t, math.Round(full.PaymentSchedule[5].RemainingBalance*100)/100, math.Round(r.CurrentBalance*100)/100)
+	assert.Equal(t, math.Round(full.MonthlyPayment*100)/100, math.Round(r.CurrentPayment*100)/100)
+	assert.InDelta(t, full.Interest-full.PaymentSchedule[5].InterestToDate, r.CurrentInterest, 0.01)
+
+	//Refinancing at the fixed rate only saves interest once the rate would have adjusted
+	assert.Less(t, r.InterestDelta, 0.0)
+	assert.Equal(t, 0.0, math.Round(r.PaymentComparison[5].InterestToDateDelta*100)/100)
+	assert.True(t, r.BreaksEven)
+	assert.Greater(t, r.BreakEvenMonth, 6)
+
+	klogger.Exit(method)
+}
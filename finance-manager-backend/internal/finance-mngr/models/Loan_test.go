@@ -1,8 +1,10 @@
 package models
 
 import (
+	"finance-manager-backend/internal/finance-mngr/constants"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/jon-kamis/klogger"
 	"github.com/stretchr/testify/assert"
@@ -164,3 +166,389 @@ func TestValidateCanSaveLoan(t *testing.T) {
 
 	klogger.Exit(method)
 }
+
+func TestPerformCalc_prepayments(t *testing.T) {
+	method := "Loan_test.TestPerformCalc_prepayments"
+	klogger.Enter(method)
+
+	l := Loan{
+		Total:        10000,
+		InterestRate: 4,
+		LoanTerm:     60,
+		Prepayments: []LoanPrepayment{
+			{Type: constants.LoanPrepaymentTypeRecurring, Amount: 200, StartMonth: 12},
+			{Type: constants.LoanPrepaymentTypeLumpSum, Amount: 1000, StartMonth: 30},
+		},
+	}
+
+	err := l.PerformCalc()
+	assert.Nil(t, err)
+
+	//Contractual term is unchanged but the loan is paid off early
+	assert.Equal(t, 60, l.LoanTerm)
+	assert.Greater(t, l.MonthsSaved, 0)
+	assert.Equal(t, l.LoanTerm-l.MonthsSaved, len(l.PaymentSchedule))
+	assert.Greater(t, l.InterestSaved, 0.0)
+	assert.Equal(t, 10000.0, math.Round(l.TotalPayment*100)/100)
+
+	//Months before the first prepayment have no extra principal
+	assert.Equal(t, 0.0, l.PaymentSchedule[10].ExtraPrincipal)
+	assert.Equal(t, 200.0, l.PaymentSchedule[11].ExtraPrincipal)
+	assert.Equal(t, 1200.0, l.PaymentSchedule[29].ExtraPrincipal)
+	assert.Equal(t, 0.0, l.PaymentSchedule[len(l.PaymentSchedule)-1].RemainingBalance)
+
+	//Baseline values match a loan without prepayments
+	b := Loan{
+		Total:        10000,
+		InterestRate: 4,
+		LoanTerm:     60,
+	}
+	err = b.PerformCalc()
+	assert.Nil(t, err)
+	assert.Equal(t, math.Round(b.Interest*100)/100, math.Round((l.Interest+l.InterestSaved)*100)/100)
+
+	//Invalid prepayments are rejected
+	l.Prepayments = []LoanPrepayment{{Type: constants.LoanPrepaymentTypeLumpSum, Amount: 0, StartMonth: 1}}
+	err = l.PerformCalc()
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
+
+func TestGetPrepaymentForMonth(t *testing.T) {
+	method := "Loan_test.TestGetPrepaymentForMonth"
+	klogger.Enter(method)
+
+	l := Loan{
+		Prepayments: []LoanPrepayment{
+			{Type: constants.LoanPrepaymentTypeRecurring, Amount: 100, StartMonth: 2, EndMonth: 4},
+			{Type: constants.LoanPrepaymentTypeLumpSum, Amount: 500, StartMonth: 3},
+		},
+	}
+
+	assert.Equal(t, 0.0, l.GetPrepaymentForMonth(1))
+	assert.Equal(t, 100.0, l.GetPrepaymentForMonth(2))
+	assert.Equal(t, 600.0, l.GetPrepaymentForMonth(3))
+	assert.Equal(t, 100.0, l.GetPrepaymentForMonth(4))
+	assert.Equal(t, 0.0, l.GetPrepaymentForMonth(5))
+
+	klogger.Exit(method)
+}
+
+func TestValidatePrepayments(t *testing.T) {
+	method := "Loan_test.TestValidatePrepayments"
+	klogger.Enter(method)
+
+	var lt Loan
+	l := Loan{
+		Prepayments: []LoanPrepayment{
+			{Type: constants.LoanPrepaymentTypeRecurring, Amount: 100, StartMonth: 2, EndMonth: 4},
+		},
+	}
+
+	err := l.ValidatePrepayments()
+	assert.Nil(t, err)
+
+	//Type must be valid
+	lt = Loan{Prepayments: []LoanPrepayment{{Type: "invalid", Amount: 100, StartMonth: 1}}}
+	err = lt.ValidatePrepayments()
+	assert.NotNil(t, err)
+
+	//Amount must be greater than 0
+	lt = Loan{Prepayments: []LoanPrepayment{{Type: constants.LoanPrepaymentTypeLumpSum, Amount: 0, StartMonth: 1}}}
+	err = lt.ValidatePrepayments()
+	assert.NotNil(t, err)
+
+	//Start month is required
+	lt = Loan{Prepayments: []LoanPrepayment{{Type: constants.LoanPrepaymentTypeLumpSum, Amount: 100}}}
+	err = lt.ValidatePrepayments()
+	assert.NotNil(t, err)
+
+	//End month cannot be before start month
+	lt = Loan{Prepayments: []LoanPrepayment{{Type: constants.LoanPrepaymentTypeRecurring, Amount: 100, StartMonth: 5, EndMonth: 4}}}
+	err = lt.ValidatePrepayments()
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
+
+func TestCompareLoanPayments_prepayments(t *testing.T) {
+	method := "Loan_test.TestCompareLoanPayments_prepayments"
+	klogger.Enter(method)
+
+	l := Loan{
+		Total:        10000,
+		InterestRate: 4,
+		LoanTerm:     60,
+	}
+
+	c := l
+	c.Prepayments = []LoanPrepayment{{Type: constants.LoanPrepaymentTypeLumpSum, Amount: 5000, StartMonth: 30}}
+
+	assert.Nil(t, l.PerformCalc())
+	assert.Nil(t, c.PerformCalc())
+
+	cs := l.CompareLoanPayments(c)
+
+	//Comparison covers the longer baseline schedule
+	assert.Equal(t, len(l.PaymentSchedule), len(cs))
+	assert.Equal(t, 5000.0, cs[29].ExtraPrincipalDelta)
+	assert.Less(t, cs[29].RemainingBalanceDelta, 0.0)
+	assert.Equal(t, 0.0, cs[len(cs)-1].RemainingBalanceNew)
+
+	klogger.Exit(method)
+}
+
+func TestGetInterestRateForMonth(t *testing.T) {
+	method := "Loan_test.TestGetInterestRateForMonth"
+	klogger.Enter(method)
+
+	l := Loan{
+		Total:        10000,
+		InterestRate: 4,
+		LoanTerm:     60,
+	}
+
+	//Fixed rate loans never change
+	assert.Equal(t, 4.0, l.GetInterestRateForMonth(1))
+	assert.Equal(t, 4.0, l.GetInterestRateForMonth(60))
+
+	l.RateSchedule = LoanRateSchedule{
+		InitialFixedMonths: 12,
+		AdjustmentInterval: 12,
+		AdjustedRate:       8,
+		InitialCap:         2,
+		PeriodicCap:        1,
+		LifetimeCap:        3,
+		Steps:              []LoanRateStep{{Month: 50, InterestRate: 5}},
+	}
+
+	assert.Equal(t, 4.0, l.GetInterestRateForMonth(12))
+	assert.Equal(t, 6.0, l.GetInterestRateForMonth(13))
+	assert.Equal(t, 6.0, l.GetInterestRateForMonth(24))
+	assert.Equal(t, 7.0, l.GetInterestRateForMonth(25))
+
+	//Lifetime cap holds the rate below the adjusted rate
+	assert.Equal(t, 7.0, l.GetInterestRateForMonth(37))
+	assert.Equal(t, 7.0, l.GetInterestRateForMonth(49))
+
+	//Explicit steps set the rate directly
+	assert.Equal(t, 5.0, l.GetInterestRateForMonth(50))
+	assert.Equal(t, 5.0, l.GetInterestRateForMonth(60))
+
+	//Promotional rate loan
+	l.RateSchedule = LoanRateSchedule{
+		Steps: []LoanRateStep{{Month: 1, InterestRate: 0}, {Month: 13, InterestRate: 9}},
+	}
+
+	assert.Equal(t, 0.0, l.GetInterestRateForMonth(1))
+	assert.Equal(t, 0.0, l.GetInterestRateForMonth(12))
+	assert.Equal(t, 9.0, l.GetInterestRateForMonth(13))
+
+	klogger.Exit(method)
+}
+
+func TestPerformCalc_variableRate(t *testing.T) {
+	method := "Loan_test.TestPerformCalc_variableRate"
+	klogger.Enter(method)
+
+	fixed := Loan{
+		Total:        10000,
+		InterestRate: 4,
+		LoanTerm:     60,
+	}
+
+	l := fixed
+	l.RateSchedule = LoanRateSchedule{
+		InitialFixedMonths: 12,
+		AdjustmentInterval: 12,
+		AdjustedRate:       8,
+		InitialCap:         2,
+		PeriodicCap:        1,
+	}
+
+	err := fixed.PerformCalc()
+	assert.Nil(t, err)
+
+	err = l.PerformCalc()
+	assert.Nil(t, err)
+
+	assert.Equal(t, 60, l.LoanTerm)
+	assert.Equal(t, 60, len(l.PaymentSchedule))
+	assert.Equal(t, 0.0, l.PaymentSchedule[59].RemainingBalance)
+	assert.Equal(t, 10000.0, math.Round(l.TotalPayment*100)/100)
+	assert.Greater(t, l.Interest, fixed.Interest)
+
+	//Payment is re-amortized when the rate changes
+	assert.Equal(t, 4.0, l.PaymentSchedule[11].InterestRate)
+	assert.Equal(t, 6.0, l.PaymentSchedule[12].InterestRate)
+	assert.Equal(t, 184.17, math.Round(l.PaymentSchedule[11].Payment*100)/100)
+	assert.Greater(t, l.PaymentSchedule[12].Payment, l.PaymentSchedule[11].Payment)
+	assert.Equal(t, math.Round(l.PaymentSchedule[12].Payment*100)/100, math.Round(l.PaymentSchedule[23].Payment*100)/100)
+
+	//Invalid rate schedule
+	l.RateSchedule.PeriodicCap = -1
+	err = l.PerformCalc()
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
+
+func TestPerformPaymentCalc_variableRate(t *testing.T) {
+	method := "Loan_test.TestPerformPaymentCalc_variableRate"
+	klogger.Enter(method)
+
+	l := Loan{
+		Total:        12000,
+		InterestRate: 6,
+		LoanTerm:     12,
+		RateSchedule: LoanRateSchedule{
+			Steps: []LoanRateStep{{Month: 1, InterestRate: 0}, {Month: 7, InterestRate: 6}},
+		},
+	}
+
+	//Payment is amortized at the first month's rate
+	err := l.PerformPaymentCalc()
+	assert.Nil(t, err)
+	assert.Equal(t, 1000.0, l.MonthlyPayment)
+
+	klogger.Exit(method)
+}
+
+func TestValidateRateSchedule(t *testing.T) {
+	method := "Loan_test.TestValidateRateSchedule"
+	klogger.Enter(method)
+
+	l := Loan{}
+	assert.Nil(t, l.ValidateRateSchedule())
+
+	l.RateSchedule = LoanRateSchedule{InitialFixedMonths: -1}
+	assert.NotNil(t, l.ValidateRateSchedule())
+
+	l.RateSchedule = LoanRateSchedule{AdjustmentInterval: -1}
+	assert.NotNil(t, l.ValidateRateSchedule())
+
+	l.RateSchedule = LoanRateSchedule{AdjustedRate: -1}
+	assert.NotNil(t, l.ValidateRateSchedule())
+
+	l.RateSchedule = LoanRateSchedule{LifetimeCap: -1}
+	assert.NotNil(t, l.ValidateRateSchedule())
+
+	l.RateSchedule = LoanRateSchedule{Steps: []LoanRateStep{{Month: 0, InterestRate: 5}}}
+	assert.NotNil(t, l.ValidateRateSchedule())
+
+	l.RateSchedule = LoanRateSchedule{Steps: []LoanRateStep{{Month: 1, InterestRate: -5}}}
+	assert.NotNil(t, l.ValidateRateSchedule())
+
+	klogger.Exit(method)
+}
+
+func TestLoadPayments(t *testing.T) {
+	method := "Loan_test.TestLoadPayments"
+	klogger.Enter(method)
+
+	l := Loan{
+		ID:           1,
+		Total:        12000,
+		InterestRate: 12,
+		LoanTerm:     12,
+	}
+
+	//Loans without payments keep their original balance
+	assert.Equal(t, 12000.0, l.GetRemainingBalance())
+
+	d := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	parr := []*LoanPayment{
+		{ID: 3, LoanID: 1, Type: constants.LoanPaymentTypeExtraPrincipal, Amount: 500, PaymentDt: d.AddDate(0, 1, 5)},
+		{ID: 4, LoanID: 1, Type: constants.LoanPaymentTypeLateFee, Amount: 25, PaymentDt: d.AddDate(0, 1, 10)},
+		{ID: 2, LoanID: 1, Type: constants.LoanPaymentTypePayment, Amount: 1200, PaymentDt: d.AddDate(0, 1, 0)},
+		{ID: 1, LoanID: 1, Type: constants.LoanPaymentTypePayment, Amount: 1200, PaymentDt: d},
+		{ID: 5, LoanID: 2, Type: constants.LoanPaymentTypePayment, Amount: 1200, PaymentDt: d},
+	}
+
+	l.LoadPayments(parr)
+
+	//First payment pays 120 interest on 12000
+	assert.Equal(t, 120.0, math.Round(parr[3].Interest*100)/100)
+	assert.Equal(t, 1080.0, math.Round(parr[3].Principal*100)/100)
+	assert.Equal(t, 10920.0, math.Round(parr[3].RemainingBalance*100)/100)
+
+	//Second payment pays 109.20 interest on 10920
+	assert.Equal(t, 109.2, math.Round(parr[2].Interest*100)/100)
+	assert.Equal(t, 9829.2, math.Round(parr[2].RemainingBalance*100)/100)
+
+	//Extra principal goes entirely to principal and late fees do not reduce the balance
+	assert.Equal(t, 500.0, parr[0].Principal)
+	assert.Equal(t, 0.0, parr[1].Principal)
+
+	assert.Equal(t, 9329.2, math.Round(l.GetRemainingBalance()*100)/100)
+	assert.Equal(t, 2, l.PaymentsMade)
+	assert.Equal(t, 25.0, l.FeesPaid)
+	assert.Equal(t, 229.2, math.Round(l.InterestPaid*100)/100)
+	assert.Equal(t, 2670.8, math.Round(l.PrincipalPaid*100)/100)
+
+	//Payments for other loans are ignored
+	assert.Equal(t, 0.0, parr[4].Principal)
+
+	//Extra principal and late fees are grouped with the most recent regular payment
+	assert.Equal(t, 2, len(l.PaymentHistory))
+	assert.Equal(t, 500.0, l.PaymentHistory[1].ExtraPrincipal)
+	assert.Equal(t, 25.0, l.PaymentHistory[1].Fees)
+	assert.Equal(t, 1200.0, l.PaymentHistory[1].Payment)
+
+	klogger.Exit(method)
+}
+
+func TestCompareActualPayments(t *testing.T) {
+	method := "Loan_test.TestCompareActualPayments"
+	klogger.Enter(method)
+
+	l := Loan{
+		ID:           1,
+		Total:        10000,
+		InterestRate: 4,
+		LoanTerm:     60,
+	}
+
+	err := l.PerformCalc()
+	assert.Nil(t, err)
+
+	d := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	parr := []*LoanPayment{
+		{ID: 1, LoanID: 1, Type: constants.LoanPaymentTypePayment, Amount: l.MonthlyPayment, PaymentDt: d},
+		{ID: 2, LoanID: 1, Type: constants.LoanPaymentTypePayment, Amount: l.MonthlyPayment, PaymentDt: d.AddDate(0, 1, 0)},
+		{ID: 3, LoanID: 1, Type: constants.LoanPaymentTypeExtraPrincipal, Amount: 100, PaymentDt: d.AddDate(0, 1, 0)},
+	}
+
+	l.LoadPayments(parr)
+	c := l.CompareActualPayments()
+
+	//Only months with actual payments are compared
+	assert.Equal(t, 2, len(c))
+	assert.Equal(t, 0.0, math.Round(c[0].RemainingBalanceDelta*100)/100)
+	assert.Equal(t, 100.0, c[1].ExtraPrincipalDelta)
+	assert.Equal(t, -100.0, math.Round(c[1].RemainingBalanceDelta*100)/100)
+
+	klogger.Exit(method)
+}
+
+func TestGetProjectedBalance(t *testing.T) {
+	method := "Loan_test.TestGetProjectedBalance"
+	klogger.Enter(method)
+
+	l := Loan{
+		Total:          1200,
+		InterestRate:   12,
+		MonthlyPayment: 112,
+	}
+
+	assert.Equal(t, 1200.0, l.GetProjectedBalance(0))
+
+	//First month accrues 12 in interest so 100 goes to principal
+	assert.Equal(t, 1100.0, l.GetProjectedBalance(1))
+
+	//Loans are never projected below 0
+	assert.Equal(t, 0.0, l.GetProjectedBalance(24))
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type PaystubItem is a single line of a Paystub
+type PaystubItem struct {
+	Name   string  `json:"name"`
+	Amount float64 `json:"amount"`
+}
+
+// Type Paystub breaks a single paycheck of an Income down from gross pay to net pay.
+// Pre-tax deductions are removed before income tax is calculated, and post-tax deductions are removed after.
+// Hours are only set for hourly incomes, and OvertimePay is included in GrossPay
+type Paystub struct {
+	Hours             float64       `json:"hours"`
+	OvertimeHours     float64       `json:"overtimeHours"`
+	OvertimePay       float64       `json:"overtimePay"`
+	GrossPay          float64       `json:"grossPay"`
+	PreTaxDeductions  []PaystubItem `json:"preTaxDeductions"`
+	TaxableGross      float64       `json:"taxableGross"`
+	Taxes             []PaystubItem `json:"taxes"`
+	PostTaxDeductions []PaystubItem `json:"postTaxDeductions"`
+	TotalDeductions   float64       `json:"totalDeductions"`
+	NetPay            float64       `json:"netPay"`
+}
+
+// Names of the deductions on a paystub, in the order they are listed
+var preTaxDeductionNames = []string{constants.DeductionNameRetirement, constants.DeductionNameHSA, constants.DeductionNameHealthPremium}
+var postTaxDeductionNames = []string{constants.DeductionNameRoth, constants.DeductionNameGarnishment}
+
+// Function NewPaystub creates a Paystub for a single paycheck of income i. The income's deductions and taxes must already be calculated
+func NewPaystub(i *Income) Paystub {
+	method := "Paystub.NewPaystub"
+	klogger.Enter(method)
+
+	p := Paystub{
+		Hours:             i.PaidHours,
+		OvertimeHours:     i.OvertimeHours,
+		OvertimePay:       i.OvertimePay,
+		GrossPay:          i.GrossPay,
+		PreTaxDeductions:  []PaystubItem{},
+		TaxableGross:      i.TaxableGross,
+		Taxes:             []PaystubItem{},
+		PostTaxDeductions: []PaystubItem{},
+		NetPay:            i.NetPay,
+	}
+
+	deductions := i.GetDeductionItems()
+
+	for _, name := range preTaxDeductionNames {
+		if deductions[name] > 0 {
+			p.PreTaxDeductions = append(p.PreTaxDeductions, PaystubItem{Name: name, Amount: deductions[name]})
+			p.TotalDeductions += deductions[name]
+		}
+	}
+
+	taxes := i.GetTaxItems()
+
+	for _, name := range taxNames {
+		if taxes[name] > 0 {
+			p.Taxes = append(p.Taxes, PaystubItem{Name: name, Amount: taxes[name]})
+			p.TotalDeductions += taxes[name]
+		}
+	}
+
+	for _, name := range postTaxDeductionNames {
+		if deductions[name] > 0 {
+			p.PostTaxDeductions = append(p.PostTaxDeductions, PaystubItem{Name: name, Amount: deductions[name]})
+			p.TotalDeductions += deductions[name]
+		}
+	}
+
+	klogger.Exit(method)
+	return p
+}
@@ -0,0 +1,413 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type PerformanceDay is the value of a position or portfolio at the close of Date. Flow is the value at that close of the shares
+// bought less the shares sold that day, and Dividend is the dividends paid that day
+type PerformanceDay struct {
+	Date     time.Time `json:"date"`
+	Value    float64   `json:"value"`
+	Flow     float64   `json:"flow"`
+	Dividend float64   `json:"dividend"`
+}
+
+// Type CashFlow is an amount paid into or out of an investment on Date. Amounts paid into the investment are negative and amounts
+// received from it are positive
+type CashFlow struct {
+	Date   time.Time `json:"date"`
+	Amount float64   `json:"amount"`
+}
+
+// Type PeriodReturn is the performance of a position or portfolio from the close of StartDt to the close of EndDt. Returns are percentages.
+// TimeWeightedReturn removes the effect of buying and selling shares during the period, and MoneyWeightedReturn is the annualized internal
+// rate of return of the period's cash flows. MoneyWeightedReturn is null when it cannot be calculated
+type PeriodReturn struct {
+	Period              string    `json:"period"`
+	StartDt             time.Time `json:"startDt"`
+	EndDt               time.Time `json:"endDt"`
+	StartValue          float64   `json:"startValue"`
+	EndValue            float64   `json:"endValue"`
+	NetFlows            float64   `json:"netFlows"`
+	Dividends           float64   `json:"dividends"`
+	Gain                float64   `json:"gain"`
+	TimeWeightedReturn  float64   `json:"timeWeightedReturn"`
+	MoneyWeightedReturn *float64  `json:"moneyWeightedReturn"`
+}
+
+// Type PositionPerformance holds the returns of a single stock for each period
+type PositionPerformance struct {
+	Ticker  string         `json:"ticker"`
+	Returns []PeriodReturn `json:"returns"`
+}
+
+// Type PortfolioPerformance holds the returns of a user's whole portfolio and of each of its positions for each period
+type PortfolioPerformance struct {
+	AsOfDate  time.Time             `json:"asOf"`
+	Returns   []PeriodReturn        `json:"returns"`
+	Positions []PositionPerformance `json:"positions"`
+}
+
+// Function BuildPerformanceDays values the shares of ticker held at each of its bars. Bars are adjusted for every split in sarr, so the
+// shares held on each bar are counted as the shares they have split into. Changes in the number of shares held are valued at the bar's
+// close, and splits do not change it. Shares held on the first bar are treated as bought on it
+func BuildPerformanceDays(ticker string, usl []*UserStock, bars []Stock, sarr []StockSplit, parr []DividendPayment) []PerformanceDay {
+	method := "PortfolioPerformance.BuildPerformanceDays"
+	klogger.Enter(method)
+
+	days := []PerformanceDay{}
+	var pq float64
+	var pd time.Time
+
+	for i, b := range bars {
+		q := getHeldQuantity(ticker, usl, b.Date) * GetSplitRatioAfter(ticker, sarr, b.Date)
+
+		d := PerformanceDay{
+			Date:  b.Date,
+			Value: math.Round(q*b.Close*100) / 100,
+		}
+
+		if i == 0 {
+			d.Flow = d.Value
+		} else {
+			d.Flow = math.Round((q-pq)*b.Close*100) / 100
+
+			for _, p := range parr {
+				if p.Ticker == ticker && p.PayDt.After(pd) && !p.PayDt.After(b.Date) {
+					d.Dividend += p.Amount
+				}
+			}
+
+			d.Dividend = math.Round(d.Dividend*100) / 100
+		}
+
+		days = append(days, d)
+		pq = q
+		pd = b.Date
+	}
+
+	klogger.Exit(method)
+	return days
+}
+
+// Function getHeldQuantity returns the number of shares of ticker owned on d
+func getHeldQuantity(ticker string, usl []*UserStock, d time.Time) float64 {
+	method := "PortfolioPerformance.getHeldQuantity"
+	klogger.Enter(method)
+
+	q := 0.0
+
+	for _, us := range usl {
+		if us.Ticker != ticker || us.Type == constants.UserStockTypeWatch || us.EffectiveDt.After(d) {
+			continue
+		}
+
+		if us.ExpirationDt.Valid && !us.ExpirationDt.Time.IsZero() && us.ExpirationDt.Time.Before(d) {
+			continue
+		}
+
+		q += us.Quantity
+	}
+
+	klogger.Exit(method)
+	return q
+}
+
+// Function CombinePerformanceDays adds up the days of each position that fall on the same date into the days of the portfolio
+func CombinePerformanceDays(darrs [][]PerformanceDay) []PerformanceDay {
+	method := "PortfolioPerformance.CombinePerformanceDays"
+	klogger.Enter(method)
+
+	dm := make(map[time.Time]*PerformanceDay)
+	days := []PerformanceDay{}
+
+	for _, darr := range darrs {
+		for _, d := range darr {
+			if dm[d.Date] == nil {
+				dm[d.Date] = &PerformanceDay{Date: d.Date}
+			}
+
+			dm[d.Date].Value += d.Value
+			dm[d.Date].Flow += d.Flow
+			dm[d.Date].Dividend += d.Dividend
+		}
+	}
+
+	for _, d := range dm {
+		d.Value = math.Round(d.Value*100) / 100
+		d.Flow = math.Round(d.Flow*100) / 100
+		d.Dividend = math.Round(d.Dividend*100) / 100
+		days = append(days, *d)
+	}
+
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Date.Before(days[j].Date)
+	})
+
+	klogger.Exit(method)
+	return days
+}
+
+// Function GetPerformancePeriodStartDate returns the date that period p measured up to t starts from. Since inception starts from the zero time
+func GetPerformancePeriodStartDate(p string, t time.Time) time.Time {
+	method := "PortfolioPerformance.GetPerformancePeriodStartDate"
+	klogger.Enter(method)
+
+	var sd time.Time
+
+	switch p {
+	case constants.PerformancePeriodOneMonth:
+		sd = t.AddDate(0, -1, 0)
+	case constants.PerformancePeriodThreeMonths:
+		sd = t.AddDate(0, -3, 0)
+	case constants.PerformancePeriodYearToDate:
+		sd = time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
+	case constants.PerformancePeriodOneYear:
+		sd = t.AddDate(-1, 0, 0)
+	}
+
+	klogger.Exit(method)
+	return sd
+}
+
+// Function CalcPeriodReturn calculates the return of days over period p measured up to t. The period starts from the close of the last
+// day on or before its start date, or from nothing if days begin after it. Days must be ordered by date
+func CalcPeriodReturn(p string, days []PerformanceDay, t time.Time) PeriodReturn {
+	method := "PortfolioPerformance.CalcPeriodReturn"
+	klogger.Enter(method)
+
+	sd := GetPerformancePeriodStartDate(p, t)
+	r := PeriodReturn{
+		Period:  p,
+		StartDt: sd,
+		EndDt:   sd,
+	}
+
+	b := -1
+	for i, d := range days {
+		if d.Date.After(sd) {
+			break
+		}
+
+		b = i
+	}
+
+	pv := 0.0
+	if b >= 0 {
+		pv = days[b].Value
+		r.StartDt = days[b].Date
+	} else if len(days) > 0 {
+		r.StartDt = days[0].Date
+	}
+
+	r.StartValue = pv
+	r.EndValue = pv
+	r.EndDt = r.StartDt
+
+	var flows []CashFlow
+	if pv > 0 {
+		flows = append(flows, CashFlow{Date: r.StartDt, Amount: -pv})
+	}
+
+	growth := 1.0
+
+	//Each day's return excludes the shares bought and sold that day, which are treated as trading at its close
+	for _, d := range days[b+1:] {
+		if d.Date.After(t) {
+			break
+		}
+
+		if pv > 0 {
+			growth *= (d.Value - d.Flow + d.Dividend) / pv
+		}
+
+		if d.Flow != 0 {
+			flows = append(flows, CashFlow{Date: d.Date, Amount: -d.Flow})
+		}
+
+		if d.Dividend != 0 {
+			flows = append(flows, CashFlow{Date: d.Date, Amount: d.Dividend})
+		}
+
+		r.NetFlows += d.Flow
+		r.Dividends += d.Dividend
+		r.EndValue = d.Value
+		r.EndDt = d.Date
+		pv = d.Value
+	}
+
+	flows = append(flows, CashFlow{Date: r.EndDt, Amount: r.EndValue})
+
+	r.NetFlows = math.Round(r.NetFlows*100) / 100
+	r.Dividends = math.Round(r.Dividends*100) / 100
+	r.Gain = math.Round((r.EndValue-r.StartValue-r.NetFlows+r.Dividends)*100) / 100
+	r.TimeWeightedReturn = math.Round((growth-1)*10000) / 100
+
+	if x, ok := CalcXIRR(flows); ok {
+		mwr := math.Round(x*10000) / 100
+		r.MoneyWeightedReturn = &mwr
+	}
+
+	klogger.Exit(method)
+	return r
+}
+
+// Function CalcXIRR returns the annualized rate at which the net present value of flows is zero. Returns false if flows do not include both
+// an amount paid in and an amount received, or if no rate can be found
+func CalcXIRR(flows []CashFlow) (float64, bool) {
+	method := "PortfolioPerformance.CalcXIRR"
+	klogger.Enter(method)
+
+	hasIn := false
+	hasOut := false
+
+	for _, f := range flows {
+		hasIn = hasIn || f.Amount < 0
+		hasOut = hasOut || f.Amount > 0
+	}
+
+	if !hasIn || !hasOut {
+		klogger.Exit(method)
+		return 0, false
+	}
+
+	//Try Newton's method first, since it converges quickly from a reasonable guess
+	r := 0.1
+	for i := 0; i < 100; i++ {
+		v, dv := xnpv(flows, r)
+
+		if math.Abs(v) < 1e-7 {
+			klogger.Exit(method)
+			return r, true
+		}
+
+		if dv == 0 {
+			break
+		}
+
+		n := r - v/dv
+		if n <= -1 || math.IsNaN(n) || math.IsInf(n, 0) {
+			break
+		}
+
+		if math.Abs(n-r) < 1e-10 {
+			klogger.Exit(method)
+			return n, true
+		}
+
+		r = n
+	}
+
+	//Fall back to bisection, widening the upper bound for short periods with large annualized rates
+	lo := -0.9999
+	hi := 10.0
+	vlo, _ := xnpv(flows, lo)
+	vhi, _ := xnpv(flows, hi)
+
+	for vlo*vhi > 0 && hi < 1e6 {
+		hi *= 10
+		vhi, _ = xnpv(flows, hi)
+	}
+
+	if vlo*vhi > 0 {
+		klogger.Exit(method)
+		return 0, false
+	}
+
+	for i := 0; i < 200; i++ {
+		r = (lo + hi) / 2
+		v, _ := xnpv(flows, r)
+
+		if math.Abs(v) < 1e-7 || hi-lo < 1e-12 {
+			break
+		}
+
+		if v*vlo > 0 {
+			lo = r
+			vlo = v
+		} else {
+			hi = r
+		}
+	}
+
+	klogger.Exit(method)
+	return r, true
+}
+
+// Function xnpv returns the net present value of flows discounted to the first flow at annual rate r, and its derivative with respect to r
+func xnpv(flows []CashFlow, r float64) (float64, float64) {
+	method := "PortfolioPerformance.xnpv"
+	klogger.Enter(method)
+
+	var v float64
+	var dv float64
+	t0 := flows[0].Date
+
+	for _, f := range flows {
+		if f.Date.Before(t0) {
+			t0 = f.Date
+		}
+	}
+
+	for _, f := range flows {
+		y := f.Date.Sub(t0).Hours() / 24 / 365
+		d := math.Pow(1+r, y)
+
+		v += f.Amount / d
+		dv -= y * f.Amount / (d * (1 + r))
+	}
+
+	klogger.Exit(method)
+	return v, dv
+}
+
+// Function NewPortfolioPerformance calculates the returns of each position in series and of the portfolio they make up for each period
+// measured up to t. Positions are ordered by ticker
+func NewPortfolioPerformance(periods []string, series map[string][]PerformanceDay, t time.Time) PortfolioPerformance {
+	method := "PortfolioPerformance.NewPortfolioPerformance"
+	klogger.Enter(method)
+
+	pp := PortfolioPerformance{
+		AsOfDate:  t,
+		Returns:   []PeriodReturn{},
+		Positions: []PositionPerformance{},
+	}
+
+	var tickers []string
+	for ticker := range series {
+		tickers = append(tickers, ticker)
+	}
+
+	sort.Strings(tickers)
+
+	var darrs [][]PerformanceDay
+
+	for _, ticker := range tickers {
+		pos := PositionPerformance{
+			Ticker:  ticker,
+			Returns: []PeriodReturn{},
+		}
+
+		for _, p := range periods {
+			pos.Returns = append(pos.Returns, CalcPeriodReturn(p, series[ticker], t))
+		}
+
+		pp.Positions = append(pp.Positions, pos)
+		darrs = append(darrs, series[ticker])
+	}
+
+	days := CombinePerformanceDays(darrs)
+
+	for _, p := range periods {
+		pp.Returns = append(pp.Returns, CalcPeriodReturn(p, days, t))
+	}
+
+	klogger.Exit(method)
+	return pp
+}
@@ -0,0 +1,244 @@
+package models
+
+import (
+	"database/sql"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockPerformanceBars(ticker string, closes ...float64) []Stock {
+	var bars []Stock
+
+	for i, c := range closes {
+		bars = append(bars, Stock{Ticker: ticker, Close: c, Date: time.Date(2024, 1, 2+i, 5, 0, 0, 0, time.UTC)})
+	}
+
+	return bars
+}
+
+func mockPerformanceUserStocks() []*UserStock {
+	us1 := UserStock{
+		ID:           1,
+		Ticker:       "AAPL",
+		Quantity:     10,
+		Type:         constants.UserStockTypeOwn,
+		EffectiveDt:  time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		ExpirationDt: sql.NullTime{Time: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC).Add(-1 * time.Millisecond), Valid: true},
+	}
+
+	us2 := UserStock{
+		ID:          2,
+		Ticker:      "AAPL",
+		Quantity:    20,
+		Type:        constants.UserStockTypeOwn,
+		EffectiveDt: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+	}
+
+	us3 := UserStock{
+		ID:          3,
+		Ticker:      "AAPL",
+		Quantity:    50,
+		Type:        constants.UserStockTypeWatch,
+		EffectiveDt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	return []*UserStock{&us1, &us2, &us3}
+}
+
+func TestBuildPerformanceDays(t *testing.T) {
+	method := "PortfolioPerformance_test.TestBuildPerformanceDays"
+	klogger.Enter(method)
+
+	days := BuildPerformanceDays("AAPL", mockPerformanceUserStocks(), mockPerformanceBars("AAPL", 10, 11, 12, 12), nil, nil)
+	assert.Equal(t, 4, len(days))
+
+	//Shares held on the first day are treated as bought on it
+	assert.Equal(t, PerformanceDay{Date: days[0].Date, Value: 100, Flow: 100}, days[0])
+	assert.Equal(t, PerformanceDay{Date: days[1].Date, Value: 110}, days[1])
+
+	//Shares bought are valued at the day's close
+	assert.Equal(t, PerformanceDay{Date: days[2].Date, Value: 240, Flow: 120}, days[2])
+	assert.Equal(t, PerformanceDay{Date: days[3].Date, Value: 240}, days[3])
+
+	klogger.Exit(method)
+}
+
+func TestBuildPerformanceDays_splitAndDividend(t *testing.T) {
+	method := "PortfolioPerformance_test.TestBuildPerformanceDays_splitAndDividend"
+	klogger.Enter(method)
+
+	//User stocks are split on the execution date. Bars are adjusted for the split, so shares held before it are counted as split
+	sarr := []StockSplit{{Ticker: "AAPL", ExecutionDt: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), SplitFrom: 1, SplitTo: 2}}
+	parr := []DividendPayment{
+		{Ticker: "AAPL", Amount: 5, PayDt: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{Ticker: "MSFT", Amount: 7, PayDt: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)},
+	}
+
+	days := BuildPerformanceDays("AAPL", mockPerformanceUserStocks(), mockPerformanceBars("AAPL", 12, 12, 12), sarr, parr)
+	assert.Equal(t, 3, len(days))
+	assert.Equal(t, 240.0, days[0].Value)
+	assert.Equal(t, 5.0, days[1].Dividend)
+	assert.Equal(t, 240.0, days[1].Value)
+
+	//The split neither adds a flow nor changes the value
+	assert.Equal(t, 240.0, days[2].Value)
+	assert.Equal(t, 0.0, days[2].Flow)
+
+	r := CalcPeriodReturn(constants.PerformancePeriodInception, days, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, 2.08, r.TimeWeightedReturn)
+
+	klogger.Exit(method)
+}
+
+func TestCombinePerformanceDays(t *testing.T) {
+	method := "PortfolioPerformance_test.TestCombinePerformanceDays"
+	klogger.Enter(method)
+
+	d1 := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	d2 := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	days := CombinePerformanceDays([][]PerformanceDay{
+		{{Date: d1, Value: 100, Flow: 100}, {Date: d2, Value: 110, Dividend: 1}},
+		{{Date: d2, Value: 50, Flow: 50}},
+	})
+
+	assert.Equal(t, []PerformanceDay{{Date: d1, Value: 100, Flow: 100}, {Date: d2, Value: 160, Flow: 50, Dividend: 1}}, days)
+
+	klogger.Exit(method)
+}
+
+func TestGetPerformancePeriodStartDate(t *testing.T) {
+	method := "PortfolioPerformance_test.TestGetPerformancePeriodStartDate"
+	klogger.Enter(method)
+
+	d := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC), GetPerformancePeriodStartDate(constants.PerformancePeriodOneMonth, d))
+	assert.Equal(t, time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), GetPerformancePeriodStartDate(constants.PerformancePeriodThreeMonths, d))
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), GetPerformancePeriodStartDate(constants.PerformancePeriodYearToDate, d))
+	assert.Equal(t, time.Date(2023, 5, 15, 0, 0, 0, 0, time.UTC), GetPerformancePeriodStartDate(constants.PerformancePeriodOneYear, d))
+	assert.True(t, GetPerformancePeriodStartDate(constants.PerformancePeriodInception, d).IsZero())
+
+	klogger.Exit(method)
+}
+
+func TestCalcPeriodReturn(t *testing.T) {
+	method := "PortfolioPerformance_test.TestCalcPeriodReturn"
+	klogger.Enter(method)
+
+	days := BuildPerformanceDays("AAPL", mockPerformanceUserStocks(), mockPerformanceBars("AAPL", 10, 11, 12, 12), nil, nil)
+	d := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	//The price rose 20% and buying more shares does not change the time-weighted return
+	r := CalcPeriodReturn(constants.PerformancePeriodInception, days, d)
+	assert.Equal(t, constants.PerformancePeriodInception, r.Period)
+	assert.Equal(t, days[0].Date, r.StartDt)
+	assert.Equal(t, days[3].Date, r.EndDt)
+	assert.Equal(t, 0.0, r.StartValue)
+	assert.Equal(t, 240.0, r.EndValue)
+	assert.Equal(t, 220.0, r.NetFlows)
+	assert.Equal(t, 20.0, r.Gain)
+	assert.Equal(t, 20.0, r.TimeWeightedReturn)
+	assert.NotNil(t, r.MoneyWeightedReturn)
+	assert.Greater(t, *r.MoneyWeightedReturn, 0.0)
+
+	//Periods start from the close of the last day on or before their start date
+	r = CalcPeriodReturn(constants.PerformancePeriodOneMonth, days, time.Date(2024, 2, 2, 12, 0, 0, 0, time.UTC))
+	assert.Equal(t, days[0].Date, r.StartDt)
+	assert.Equal(t, 100.0, r.StartValue)
+	assert.Equal(t, 120.0, r.NetFlows)
+	assert.Equal(t, 20.0, r.Gain)
+	assert.Equal(t, 20.0, r.TimeWeightedReturn)
+
+	//Days after the end date are not included
+	r = CalcPeriodReturn(constants.PerformancePeriodInception, days, days[1].Date)
+	assert.Equal(t, 110.0, r.EndValue)
+	assert.Equal(t, 10.0, r.TimeWeightedReturn)
+
+	//Nothing was held during the period
+	r = CalcPeriodReturn(constants.PerformancePeriodYearToDate, nil, d)
+	assert.Equal(t, 0.0, r.TimeWeightedReturn)
+	assert.Nil(t, r.MoneyWeightedReturn)
+
+	klogger.Exit(method)
+}
+
+func TestCalcPeriodReturn_dividends(t *testing.T) {
+	method := "PortfolioPerformance_test.TestCalcPeriodReturn_dividends"
+	klogger.Enter(method)
+
+	//A sale and a dividend are both returns of the position
+	days := []PerformanceDay{
+		{Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Value: 100, Flow: 100},
+		{Date: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), Value: 100, Dividend: 10},
+		{Date: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), Value: 0, Flow: -100},
+	}
+
+	r := CalcPeriodReturn(constants.PerformancePeriodInception, days, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, 10.0, r.TimeWeightedReturn)
+	assert.Equal(t, 10.0, r.Dividends)
+	assert.Equal(t, 0.0, r.NetFlows)
+	assert.Equal(t, 10.0, r.Gain)
+
+	klogger.Exit(method)
+}
+
+func TestCalcXIRR(t *testing.T) {
+	method := "PortfolioPerformance_test.TestCalcXIRR"
+	klogger.Enter(method)
+
+	d1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	d2 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	x, ok := CalcXIRR([]CashFlow{{Date: d1, Amount: -1000}, {Date: d2, Amount: 1100}})
+	assert.True(t, ok)
+	assert.InDelta(t, 0.1, x, 0.000001)
+
+	x, ok = CalcXIRR([]CashFlow{{Date: d1, Amount: -1000}, {Date: d2, Amount: 900}})
+	assert.True(t, ok)
+	assert.InDelta(t, -0.1, x, 0.000001)
+
+	//Money paid in midway through the year only earns for half of it
+	x, ok = CalcXIRR([]CashFlow{{Date: d1, Amount: -1000}, {Date: d1.AddDate(0, 6, 0), Amount: -1000}, {Date: d2, Amount: 2150}})
+	assert.True(t, ok)
+	assert.Greater(t, x, 0.1)
+
+	//Rates cannot be found without both amounts paid in and received
+	_, ok = CalcXIRR([]CashFlow{{Date: d1, Amount: -1000}, {Date: d2, Amount: -100}})
+	assert.False(t, ok)
+
+	klogger.Exit(method)
+}
+
+func TestNewPortfolioPerformance(t *testing.T) {
+	method := "PortfolioPerformance_test.TestNewPortfolioPerformance"
+	klogger.Enter(method)
+
+	series := map[string][]PerformanceDay{
+		"MSFT": BuildPerformanceDays("MSFT", []*UserStock{{Ticker: "MSFT", Quantity: 10, EffectiveDt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}}, mockPerformanceBars("MSFT", 10, 10, 10, 10), nil, nil),
+		"AAPL": BuildPerformanceDays("AAPL", mockPerformanceUserStocks(), mockPerformanceBars("AAPL", 10, 11, 12, 12), nil, nil),
+	}
+
+	d := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	pp := NewPortfolioPerformance([]string{constants.PerformancePeriodOneMonth, constants.PerformancePeriodInception}, series, d)
+
+	assert.Equal(t, d, pp.AsOfDate)
+	assert.Equal(t, 2, len(pp.Positions))
+	assert.Equal(t, "AAPL", pp.Positions[0].Ticker)
+	assert.Equal(t, "MSFT", pp.Positions[1].Ticker)
+	assert.Equal(t, 2, len(pp.Positions[0].Returns))
+	assert.Equal(t, 0.0, pp.Positions[1].Returns[1].TimeWeightedReturn)
+
+	//The portfolio's return is weighted by the value of each position
+	assert.Equal(t, 2, len(pp.Returns))
+	assert.Equal(t, 340.0, pp.Returns[1].EndValue)
+	assert.Equal(t, 20.0, pp.Returns[1].Gain)
+	assert.Greater(t, pp.Returns[1].TimeWeightedReturn, 0.0)
+	assert.Less(t, pp.Returns[1].TimeWeightedReturn, 20.0)
+
+	klogger.Exit(method)
+}
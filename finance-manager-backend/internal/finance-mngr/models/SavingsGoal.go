@@ -0,0 +1,317 @@
+package models
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/enums/payfrequency"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"finance-manager-backend/internal/finance-mngr/models/restmodels"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type SavingsGoal is an amount a user is saving toward by a deadline. ContributionPerPay is set aside from each paycheck
+// of the linked income, and goals without a linked income are contributed to once a month.
+// Saved, Remaining, PercentComplete, PerPay, NumPays, ProjectedSavings and OnTrack are derived from the goal's contributions and are not persisted
+type SavingsGoal struct {
+	ID                 int                       `json:"id"`
+	UserID             int                       `json:"userId"`
+	Name               string                    `json:"name"`
+	Target             float64                   `json:"target"`
+	Deadline           time.Time                 `json:"deadline"`
+	IncomeID           int                       `json:"incomeId"`
+	ContributionPerPay float64                   `json:"contributionPerPay"`
+	Contributions      []SavingsGoalContribution `json:"contributions" gorm:"-"`
+	Saved              float64                   `json:"saved"`
+	Remaining          float64                   `json:"remaining"`
+	PercentComplete    float64                   `json:"percentComplete"`
+	PerPay             float64                   `json:"perPay"`
+	NumPays            int                       `json:"numPays"`
+	ProjectedSavings   float64                   `json:"projectedSavings"`
+	OnTrack            bool                      `json:"onTrack"`
+	CreateDt           time.Time                 `json:"-"`
+	LastUpdateDt       time.Time                 `json:"-"`
+	income             *Income
+	progressDt         time.Time
+}
+
+func (g *SavingsGoal) ValidateCanSaveSavingsGoal() error {
+	method := "SavingsGoal.ValidateCanSaveSavingsGoal"
+	klogger.Enter(method)
+
+	if g.Name == "" {
+		err := errors.New("cannot save savings goal without a name")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if g.Target <= 0 {
+		err := errors.New("target is required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if g.Deadline.IsZero() {
+		err := errors.New("deadline is required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if g.ContributionPerPay < 0 {
+		err := errors.New("contribution per pay cannot be negative")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if g.IncomeID < 0 {
+		err := errors.New("incomeId is invalid")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if g.UserID <= 0 {
+		err := errors.New("userId is required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function LoadContributions attaches the contributions in carr made toward the goal in the order they were made
+func (g *SavingsGoal) LoadContributions(carr []*SavingsGoalContribution) {
+	method := "SavingsGoal.LoadContributions"
+	klogger.Enter(method)
+
+	g.Contributions = []SavingsGoalContribution{}
+	for _, c := range carr {
+		if c.SavingsGoalID == g.ID {
+			g.Contributions = append(g.Contributions, *c)
+		}
+	}
+
+	sort.SliceStable(g.Contributions, func(a, b int) bool {
+		return g.Contributions[a].ContributionDt.Before(g.Contributions[b].ContributionDt)
+	})
+
+	klogger.Exit(method)
+}
+
+// Function LoadIncome links the goal to its income in iarr. Goals whose income is not found are contributed to once a month
+func (g *SavingsGoal) LoadIncome(iarr []*Income) {
+	method := "SavingsGoal.LoadIncome"
+	klogger.Enter(method)
+
+	g.income = nil
+	for _, i := range iarr {
+		if g.IncomeID != 0 && i.ID == g.IncomeID {
+			g.income = i
+		}
+	}
+
+	klogger.Exit(method)
+}
+
+// Function CalcProgress totals the goal's contributions and calculates the amount required from each pay before the deadline
+// to reach the target. The goal is on track if contributing ContributionPerPay from each remaining pay reaches the target.
+// Contributions and the income must already be loaded
+func (g *SavingsGoal) CalcProgress(t time.Time) error {
+	method := "SavingsGoal.CalcProgress"
+	klogger.Enter(method)
+
+	g.Saved = 0
+	for _, c := range g.Contributions {
+		g.Saved += c.Amount
+	}
+
+	g.Remaining = math.Max(g.Target-g.Saved, 0)
+	g.PercentComplete = 0
+	g.PerPay = 0
+	g.NumPays = 0
+	g.ProjectedSavings = g.Saved
+	g.progressDt = t
+
+	if g.Target > 0 {
+		g.PercentComplete = math.Round(math.Min(g.Saved/g.Target, 1)*10000) / 100
+	}
+
+	if g.Remaining == 0 {
+		g.OnTrack = true
+		klogger.Exit(method)
+		return nil
+	}
+
+	req := restmodels.SavingsCalculationRequest{
+		Goal:         g.Remaining,
+		Amount:       g.ContributionPerPay,
+		Deadline:     g.Deadline,
+		PayFrequency: g.getPayFrequency(),
+		NextPay:      g.getNextPay(t),
+	}
+
+	//Pays are taken from the linked income's schedule so that its end date and business day paydays are respected
+	if !req.NextPay.IsZero() {
+		req.PayDates = g.getPayDatesBetween(req.NextPay, g.Deadline)
+	}
+
+	//Whatever is left is due now when there are no pays left before the deadline
+	if len(req.PayDates) == 0 {
+		g.PerPay = g.Remaining
+		g.OnTrack = false
+		klogger.Exit(method)
+		return nil
+	}
+
+	r, err := req.Calculate()
+	if err != nil {
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	g.PerPay = r.PerPay
+	g.NumPays = r.NumPays
+	g.ProjectedSavings = g.Saved + r.Actual
+	g.OnTrack = r.Actual >= g.Remaining-0.005
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function GetPaysForMonthContainingDate returns the number of pays the goal is contributed to from in the month containing t.
+// Goals are not contributed to after their deadline
+func (g *SavingsGoal) GetPaysForMonthContainingDate(t time.Time) int {
+	method := "SavingsGoal.GetPaysForMonthContainingDate"
+	klogger.Enter(method)
+
+	n := g.getPaysBetween(fmUtil.GetMonthBeginDate(t), fmUtil.GetMonthEndDate(t))
+
+	klogger.Exit(method)
+	return n
+}
+
+// Function GetContributionsForMonthContainingDate returns the total contributed to the goal in the month containing t
+func (g *SavingsGoal) GetContributionsForMonthContainingDate(t time.Time) float64 {
+	method := "SavingsGoal.GetContributionsForMonthContainingDate"
+	klogger.Enter(method)
+
+	s := fmUtil.GetMonthBeginDate(t)
+	e := fmUtil.GetMonthEndDate(t)
+
+	total := 0.0
+	for _, c := range g.Contributions {
+		if !c.ContributionDt.Before(s) && !c.ContributionDt.After(e) {
+			total += c.Amount
+		}
+	}
+
+	klogger.Exit(method)
+	return total
+}
+
+// Function GetMonthlyContribution returns the amount set aside for the goal in the month containing t.
+// This is the larger of what was contributed that month and what is planned from each pay, and nothing more is planned once the target would be reached.
+// Progress must already be calculated
+func (g *SavingsGoal) GetMonthlyContribution(t time.Time) float64 {
+	method := "SavingsGoal.GetMonthlyContribution"
+	klogger.Enter(method)
+
+	planned := g.ContributionPerPay * float64(g.GetPaysForMonthContainingDate(t))
+
+	//Only plan what is left after the pays between the last progress calculation and this month
+	s := fmUtil.GetMonthBeginDate(t)
+	left := g.Remaining
+
+	if !g.progressDt.IsZero() && s.After(g.progressDt) {
+		left -= g.ContributionPerPay * float64(g.getPaysBetween(g.progressDt.Add(time.Nanosecond), s.Add(-time.Nanosecond)))
+	}
+
+	planned = math.Min(planned, math.Max(left, 0))
+
+	amount := math.Max(g.GetContributionsForMonthContainingDate(t), planned)
+
+	klogger.Exit(method)
+	return amount
+}
+
+// Function getPayFrequency returns the frequency of the linked income, or monthly if the goal is not linked to an income
+func (g *SavingsGoal) getPayFrequency() payfrequency.PayFrequency {
+	method := "SavingsGoal.getPayFrequency"
+	klogger.Enter(method)
+
+	f := payfrequency.Monthly
+	if g.income != nil {
+		f = payfrequency.GetPayFrequency(g.income.Frequency)
+	}
+
+	klogger.Exit(method)
+	return f
+}
+
+// Function getNextPay returns the next payday of the linked income after t, or the first of next month if the goal is not linked to an income
+func (g *SavingsGoal) getNextPay(t time.Time) time.Time {
+	method := "SavingsGoal.getNextPay"
+	klogger.Enter(method)
+
+	if g.income == nil {
+		klogger.Exit(method)
+		return fmUtil.AddMonths(fmUtil.GetMonthBeginDate(t), 1)
+	}
+
+	r := g.income.GetRecurrence()
+	d := r.GetNextDate(t)
+
+	klogger.Exit(method)
+	return d
+}
+
+// Function getPaysBetween returns the number of pays between s and e inclusively that the goal is contributed to from
+func (g *SavingsGoal) getPaysBetween(s time.Time, e time.Time) int {
+	method := "SavingsGoal.getPaysBetween"
+	klogger.Enter(method)
+
+	n := len(g.getPayDatesBetween(s, e))
+
+	klogger.Exit(method)
+	return n
+}
+
+// Function getPayDatesBetween returns the date of each pay between s and e inclusively that the goal is contributed to from.
+// Goals without a linked income are contributed to on the first of each month, and no pays are counted after the deadline
+func (g *SavingsGoal) getPayDatesBetween(s time.Time, e time.Time) []time.Time {
+	method := "SavingsGoal.getPayDatesBetween"
+	klogger.Enter(method)
+
+	dates := []time.Time{}
+
+	if g.Deadline.Before(e) {
+		e = g.Deadline
+	}
+
+	if s.After(e) {
+		klogger.Exit(method)
+		return dates
+	}
+
+	if g.income != nil {
+		dates = append(dates, g.income.GetPayDatesBetween(s, e)...)
+		klogger.Exit(method)
+		return dates
+	}
+
+	d := fmUtil.GetMonthBeginDate(s)
+
+	if d.Before(s) {
+		d = fmUtil.AddMonths(d, 1)
+	}
+
+	for !d.After(e) {
+		dates = append(dates, d)
+		d = fmUtil.AddMonths(d, 1)
+	}
+
+	klogger.Exit(method)
+	return dates
+}
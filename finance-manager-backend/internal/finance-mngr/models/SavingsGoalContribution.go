@@ -0,0 +1,45 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type SavingsGoalContribution is an amount set aside toward a SavingsGoal. Withdrawals from a goal are recorded as negative amounts
+type SavingsGoalContribution struct {
+	ID             int       `json:"id"`
+	SavingsGoalID  int       `json:"savingsGoalId"`
+	UserID         int       `json:"userId"`
+	Amount         float64   `json:"amount"`
+	ContributionDt time.Time `json:"contributionDate"`
+	CreateDt       time.Time `json:"-"`
+	LastUpdateDt   time.Time `json:"-"`
+}
+
+func (c *SavingsGoalContribution) ValidateCanSaveSavingsGoalContribution() error {
+	method := "SavingsGoalContribution.ValidateCanSaveSavingsGoalContribution"
+	klogger.Enter(method)
+
+	if c.SavingsGoalID <= 0 {
+		err := errors.New("cannot save contribution without savingsGoalId")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if c.Amount == 0 {
+		err := errors.New("cannot save contribution without amount")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if c.ContributionDt.IsZero() {
+		err := errors.New("cannot save contribution without contribution date")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
@@ -0,0 +1,264 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+var savingsGoalTestDate = time.Date(2099, 3, 10, 0, 0, 0, 0, time.UTC)
+
+func mockSavingsGoalContributions() []*SavingsGoalContribution {
+	c1 := SavingsGoalContribution{
+		ID:             1,
+		SavingsGoalID:  1,
+		Amount:         100,
+		ContributionDt: time.Date(2099, 2, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	c2 := SavingsGoalContribution{
+		ID:             2,
+		SavingsGoalID:  1,
+		Amount:         100,
+		ContributionDt: time.Date(2099, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	c3 := SavingsGoalContribution{
+		ID:             3,
+		SavingsGoalID:  2,
+		Amount:         500,
+		ContributionDt: time.Date(2099, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	return []*SavingsGoalContribution{&c1, &c2, &c3}
+}
+
+func mockSavingsGoal() SavingsGoal {
+	return SavingsGoal{
+		ID:                 1,
+		UserID:             1,
+		Name:               "Vacation",
+		Target:             1200,
+		Deadline:           time.Date(2099, 12, 31, 0, 0, 0, 0, time.UTC),
+		ContributionPerPay: 100,
+	}
+}
+
+func TestValidateCanSaveSavingsGoal(t *testing.T) {
+	method := "SavingsGoal_test.TestValidateCanSaveSavingsGoal"
+	klogger.Enter(method)
+
+	var gt SavingsGoal
+	g := mockSavingsGoal()
+
+	err := g.ValidateCanSaveSavingsGoal()
+	assert.Nil(t, err)
+
+	//Name is required
+	gt = g
+	gt.Name = ""
+	err = gt.ValidateCanSaveSavingsGoal()
+	assert.NotNil(t, err)
+
+	//Target must be greater than 0
+	gt = g
+	gt.Target = 0
+	err = gt.ValidateCanSaveSavingsGoal()
+	assert.NotNil(t, err)
+
+	//Deadline is required
+	gt = g
+	gt.Deadline = time.Time{}
+	err = gt.ValidateCanSaveSavingsGoal()
+	assert.NotNil(t, err)
+
+	//Contribution per pay cannot be negative
+	gt = g
+	gt.ContributionPerPay = -1
+	err = gt.ValidateCanSaveSavingsGoal()
+	assert.NotNil(t, err)
+
+	//UserId is required
+	gt = g
+	gt.UserID = 0
+	err = gt.ValidateCanSaveSavingsGoal()
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
+
+func TestValidateCanSaveSavingsGoalContribution(t *testing.T) {
+	method := "SavingsGoal_test.TestValidateCanSaveSavingsGoalContribution"
+	klogger.Enter(method)
+
+	var ct SavingsGoalContribution
+	c := *mockSavingsGoalContributions()[0]
+
+	err := c.ValidateCanSaveSavingsGoalContribution()
+	assert.Nil(t, err)
+
+	//Withdrawals are negative contributions
+	ct = c
+	ct.Amount = -50
+	err = ct.ValidateCanSaveSavingsGoalContribution()
+	assert.Nil(t, err)
+
+	//Amount is required
+	ct.Amount = 0
+	err = ct.ValidateCanSaveSavingsGoalContribution()
+	assert.NotNil(t, err)
+
+	//Contribution date is required
+	ct = c
+	ct.ContributionDt = time.Time{}
+	err = ct.ValidateCanSaveSavingsGoalContribution()
+	assert.NotNil(t, err)
+
+	//SavingsGoalId is required
+	ct = c
+	ct.SavingsGoalID = 0
+	err = ct.ValidateCanSaveSavingsGoalContribution()
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
+
+func TestLoadContributions(t *testing.T) {
+	method := "SavingsGoal_test.TestLoadContributions"
+	klogger.Enter(method)
+
+	g := mockSavingsGoal()
+	g.LoadContributions(mockSavingsGoalContributions())
+
+	//Only contributions to the goal are loaded in the order they were made
+	assert.Equal(t, 2, len(g.Contributions))
+	assert.Equal(t, 2, g.Contributions[0].ID)
+	assert.Equal(t, 1, g.Contributions[1].ID)
+
+	klogger.Exit(method)
+}
+
+func TestCalcProgress(t *testing.T) {
+	method := "SavingsGoal_test.TestCalcProgress"
+	klogger.Enter(method)
+
+	g := mockSavingsGoal()
+	g.LoadContributions(mockSavingsGoalContributions())
+	g.LoadIncome(nil)
+
+	//Goals without an income are contributed to on the first of April through December
+	err := g.CalcProgress(savingsGoalTestDate)
+	assert.Nil(t, err)
+	assert.Equal(t, 200.0, g.Saved)
+	assert.Equal(t, 1000.0, g.Remaining)
+	assert.Equal(t, 16.67, g.PercentComplete)
+	assert.Equal(t, 9, g.NumPays)
+	assert.Equal(t, 111.11, g.PerPay)
+	assert.Equal(t, 1100.0, g.ProjectedSavings)
+	assert.False(t, g.OnTrack)
+
+	//Contributing enough from each pay puts the goal on track
+	g.ContributionPerPay = 125
+	err = g.CalcProgress(savingsGoalTestDate)
+	assert.Nil(t, err)
+	assert.Equal(t, 1325.0, g.ProjectedSavings)
+	assert.True(t, g.OnTrack)
+
+	//Goals that have reached their target are complete
+	g.Target = 150
+	err = g.CalcProgress(savingsGoalTestDate)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, g.Remaining)
+	assert.Equal(t, 100.0, g.PercentComplete)
+	assert.Equal(t, 0.0, g.PerPay)
+	assert.True(t, g.OnTrack)
+
+	//Whatever is left is due now when there are no pays before the deadline
+	g.Target = 1200
+	g.Deadline = time.Date(2099, 3, 20, 0, 0, 0, 0, time.UTC)
+	err = g.CalcProgress(savingsGoalTestDate)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, g.NumPays)
+	assert.Equal(t, 1000.0, g.PerPay)
+	assert.False(t, g.OnTrack)
+
+	klogger.Exit(method)
+}
+
+func TestCalcProgress_linkedIncome(t *testing.T) {
+	method := "SavingsGoal_test.TestCalcProgress_linkedIncome"
+	klogger.Enter(method)
+
+	i := Income{
+		ID:        1,
+		Rate:      1000,
+		Type:      constants.IncomeTypeSalary,
+		Frequency: constants.IncomeFreqWeekly,
+		StartDt:   time.Date(2099, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	g := mockSavingsGoal()
+	g.IncomeID = 1
+	g.Target = 1000
+	g.Deadline = time.Date(2099, 3, 31, 0, 0, 0, 0, time.UTC)
+	g.LoadContributions(mockSavingsGoalContributions())
+	g.LoadIncome([]*Income{&i})
+
+	//The income is paid on March 13th, 20th and 27th before the deadline
+	err := g.CalcProgress(savingsGoalTestDate)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, g.NumPays)
+	assert.Equal(t, 266.67, g.PerPay)
+	assert.Equal(t, 500.0, g.ProjectedSavings)
+	assert.False(t, g.OnTrack)
+
+	//Each payday in the month is planned for
+	assert.Equal(t, 4, g.GetPaysForMonthContainingDate(savingsGoalTestDate))
+	assert.Equal(t, 400.0, g.GetMonthlyContribution(savingsGoalTestDate))
+
+	//Goals are not contributed to after their deadline
+	assert.Equal(t, 0, g.GetPaysForMonthContainingDate(time.Date(2099, 4, 10, 0, 0, 0, 0, time.UTC)))
+
+	//Goals are not contributed to after the income ends
+	end := time.Date(2099, 4, 10, 0, 0, 0, 0, time.UTC)
+	i.EndDt = &end
+	g.Deadline = time.Date(2099, 12, 31, 0, 0, 0, 0, time.UTC)
+	err = g.CalcProgress(savingsGoalTestDate)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, g.NumPays)
+	assert.Equal(t, 160.0, g.PerPay)
+	assert.Equal(t, 700.0, g.ProjectedSavings)
+	assert.False(t, g.OnTrack)
+
+	klogger.Exit(method)
+}
+
+func TestGetMonthlyContribution(t *testing.T) {
+	method := "SavingsGoal_test.TestGetMonthlyContribution"
+	klogger.Enter(method)
+
+	g := mockSavingsGoal()
+	g.ContributionPerPay = 125
+	g.LoadContributions(mockSavingsGoalContributions())
+	g.LoadIncome(nil)
+
+	err := g.CalcProgress(savingsGoalTestDate)
+	assert.Nil(t, err)
+
+	//Months with contributions use what was contributed
+	assert.Equal(t, 100.0, g.GetContributionsForMonthContainingDate(time.Date(2099, 2, 10, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 125.0, g.GetMonthlyContribution(time.Date(2099, 2, 10, 0, 0, 0, 0, time.UTC)))
+
+	//Planned contributions stop once the target would be reached
+	assert.Equal(t, 125.0, g.GetMonthlyContribution(time.Date(2099, 4, 10, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 125.0, g.GetMonthlyContribution(time.Date(2099, 11, 10, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 0.0, g.GetMonthlyContribution(time.Date(2099, 12, 10, 0, 0, 0, 0, time.UTC)))
+
+	//Nothing is contributed after the deadline
+	assert.Equal(t, 0.0, g.GetMonthlyContribution(time.Date(2100, 1, 10, 0, 0, 0, 0, time.UTC)))
+
+	klogger.Exit(method)
+}
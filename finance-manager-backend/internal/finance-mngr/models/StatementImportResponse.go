@@ -0,0 +1,9 @@
+package models
+
+// Type StatementImportResponse holds the transactions imported from a bank statement with the income, bill or credit card each
+// appears to be for. Transactions already imported from an earlier statement are counted in Duplicates and not imported again
+type StatementImportResponse struct {
+	Imported     int                      `json:"imported"`
+	Duplicates   int                      `json:"duplicates"`
+	Transactions []BankAccountTransaction `json:"transactions"`
+}
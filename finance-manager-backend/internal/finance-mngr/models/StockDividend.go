@@ -0,0 +1,90 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type StockDividend is a cash dividend of CashAmount per share paid on PayDt to those that held the stock before ExDividendDt
+type StockDividend struct {
+	ID           int       `json:"id"`
+	Ticker       string    `json:"ticker"`
+	CashAmount   float64   `json:"cashAmount"`
+	ExDividendDt time.Time `json:"exDividendDt"`
+	PayDt        time.Time `json:"payDt"`
+	CreateDt     time.Time `json:"-"`
+	LastUpdateDt time.Time `json:"-"`
+}
+
+// Type DividendPayment is the income a user receives from a dividend for the Quantity shares they held before its ex-dividend date
+type DividendPayment struct {
+	Ticker       string    `json:"ticker"`
+	ExDividendDt time.Time `json:"exDividendDt"`
+	PayDt        time.Time `json:"payDt"`
+	Quantity     float64   `json:"quantity"`
+	CashAmount   float64   `json:"cashAmount"`
+	Amount       float64   `json:"amount"`
+}
+
+// Function GetDividendPayments returns the payment a user receives from each dividend of a stock they owned at the end of the day
+// before its ex-dividend date, ordered by pay date. Watched stocks are not owned and receive no dividends
+func GetDividendPayments(usl []*UserStock, darr []StockDividend) []DividendPayment {
+	method := "StockDividend.GetDividendPayments"
+	klogger.Enter(method)
+
+	parr := []DividendPayment{}
+
+	for _, d := range darr {
+		h := d.ExDividendDt.Add(-1 * time.Millisecond)
+
+		for _, us := range usl {
+			if us.Ticker != d.Ticker || us.Type == constants.UserStockTypeWatch || us.Quantity <= 0 {
+				continue
+			}
+
+			if us.EffectiveDt.After(h) || (us.ExpirationDt.Valid && !us.ExpirationDt.Time.IsZero() && us.ExpirationDt.Time.Before(h)) {
+				continue
+			}
+
+			p := DividendPayment{
+				Ticker:       d.Ticker,
+				ExDividendDt: d.ExDividendDt,
+				PayDt:        d.PayDt,
+				Quantity:     us.Quantity,
+				CashAmount:   d.CashAmount,
+				Amount:       math.Round(us.Quantity*d.CashAmount*100) / 100,
+			}
+
+			parr = append(parr, p)
+			break
+		}
+	}
+
+	sort.SliceStable(parr, func(i, j int) bool {
+		return parr[i].PayDt.Before(parr[j].PayDt)
+	})
+
+	klogger.Exit(method)
+	return parr
+}
+
+// Function GetDividendIncome totals the payments of ticker paid on or before t. All tickers are totaled if ticker is empty
+func GetDividendIncome(ticker string, parr []DividendPayment, t time.Time) float64 {
+	method := "StockDividend.GetDividendIncome"
+	klogger.Enter(method)
+
+	total := 0.0
+
+	for _, p := range parr {
+		if (ticker == "" || p.Ticker == ticker) && !p.PayDt.After(t) {
+			total += p.Amount
+		}
+	}
+
+	klogger.Exit(method)
+	return math.Round(total*100) / 100
+}
@@ -0,0 +1,115 @@
+package models
+
+import (
+	"database/sql"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockStockDividends() []StockDividend {
+	d1 := StockDividend{
+		ID:           1,
+		Ticker:       "AAPL",
+		CashAmount:   0.24,
+		ExDividendDt: time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC),
+		PayDt:        time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	d2 := StockDividend{
+		ID:           2,
+		Ticker:       "AAPL",
+		CashAmount:   0.25,
+		ExDividendDt: time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC),
+		PayDt:        time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC),
+	}
+
+	d3 := StockDividend{
+		ID:           3,
+		Ticker:       "MSFT",
+		CashAmount:   0.75,
+		ExDividendDt: time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC),
+		PayDt:        time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC),
+	}
+
+	return []StockDividend{d1, d2, d3}
+}
+
+func mockDividendUserStocks() []*UserStock {
+	us1 := UserStock{
+		ID:           1,
+		Ticker:       "AAPL",
+		Quantity:     10,
+		Type:         constants.UserStockTypeOwn,
+		EffectiveDt:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ExpirationDt: sql.NullTime{Time: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC).Add(-1 * time.Millisecond), Valid: true},
+	}
+
+	us2 := UserStock{
+		ID:          2,
+		Ticker:      "AAPL",
+		Quantity:    20,
+		Type:        constants.UserStockTypeOwn,
+		EffectiveDt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	//Bought on the ex-dividend date, which is too late to receive the dividend
+	us3 := UserStock{
+		ID:          3,
+		Ticker:      "MSFT",
+		Quantity:    5,
+		Type:        constants.UserStockTypeOwn,
+		EffectiveDt: time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC),
+	}
+
+	return []*UserStock{&us1, &us2, &us3}
+}
+
+func TestGetDividendPayments(t *testing.T) {
+	method := "StockDividend_test.TestGetDividendPayments"
+	klogger.Enter(method)
+
+	parr := GetDividendPayments(mockDividendUserStocks(), mockStockDividends())
+	assert.Equal(t, 2, len(parr))
+
+	assert.Equal(t, "AAPL", parr[0].Ticker)
+	assert.Equal(t, 10.0, parr[0].Quantity)
+	assert.Equal(t, 2.4, parr[0].Amount)
+
+	assert.Equal(t, 20.0, parr[1].Quantity)
+	assert.Equal(t, 5.0, parr[1].Amount)
+
+	//Watched stocks receive no dividends
+	usl := mockDividendUserStocks()
+	usl[1].Type = constants.UserStockTypeWatch
+	usl[2].EffectiveDt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	parr = GetDividendPayments(usl, mockStockDividends())
+	assert.Equal(t, 2, len(parr))
+	assert.Equal(t, "AAPL", parr[0].Ticker)
+	assert.Equal(t, "MSFT", parr[1].Ticker)
+	assert.Equal(t, 3.75, parr[1].Amount)
+
+	klogger.Exit(method)
+}
+
+func TestGetDividendIncome(t *testing.T) {
+	method := "StockDividend_test.TestGetDividendIncome"
+	klogger.Enter(method)
+
+	parr := []DividendPayment{
+		{Ticker: "AAPL", Amount: 2.4, PayDt: time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)},
+		{Ticker: "MSFT", Amount: 3.75, PayDt: time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC)},
+		{Ticker: "AAPL", Amount: 5, PayDt: time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC)},
+	}
+
+	d := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 6.15, GetDividendIncome("", parr, d))
+	assert.Equal(t, 2.4, GetDividendIncome("AAPL", parr, d))
+	assert.Equal(t, 7.4, GetDividendIncome("AAPL", parr, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 0.0, GetDividendIncome("GOOG", parr, d))
+
+	klogger.Exit(method)
+}
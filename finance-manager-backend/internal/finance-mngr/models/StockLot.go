@@ -0,0 +1,268 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type StockLot is the shares bought by a single stock purchase. ID is the ID of the purchase and Remaining is the number of its shares
+// that have not been sold. CostPerShare includes the purchase's fees. Value, UnrealizedGain and Term are set when the lot is loaded into a position
+type StockLot struct {
+	ID             int       `json:"id"`
+	Ticker         string    `json:"ticker"`
+	AcquiredDt     time.Time `json:"acquiredDt"`
+	Quantity       float64   `json:"quantity"`
+	Remaining      float64   `json:"remaining"`
+	CostPerShare   float64   `json:"costPerShare"`
+	CostBasis      float64   `json:"costBasis"`
+	Value          float64   `json:"value"`
+	UnrealizedGain float64   `json:"unrealizedGain"`
+	Term           string    `json:"term"`
+}
+
+// Type RealizedGain is the gain or loss realized by selling Quantity shares of the lot LotID
+type RealizedGain struct {
+	Ticker     string    `json:"ticker"`
+	LotID      int       `json:"lotId"`
+	AcquiredDt time.Time `json:"acquiredDt"`
+	SoldDt     time.Time `json:"soldDt"`
+	Quantity   float64   `json:"quantity"`
+	Proceeds   float64   `json:"proceeds"`
+	CostBasis  float64   `json:"costBasis"`
+	Gain       float64   `json:"gain"`
+	Term       string    `json:"term"`
+}
+
+// Type TaxYearGains totals the gains realized by sales made during Year by their holding term
+type TaxYearGains struct {
+	Year      int     `json:"year"`
+	ShortTerm float64 `json:"shortTerm"`
+	LongTerm  float64 `json:"longTerm"`
+	Total     float64 `json:"total"`
+}
+
+// Function BuildStockLots replays stock transactions in the order they were made and returns the lots that are still open and the gains
+// realized by each sale. Sales are taken from the lots of their ticker bought before them. Shares sold beyond the lots that are open, such as
+// shares recorded before purchases were tracked, have no cost basis and realize no gain. Lots bought before a split of their stock are split
+// on its execution date, so sales made after it are taken in split shares. Returns an error if a sale selects a lot that does not have the
+// shares it selects
+func BuildStockLots(tarr []*StockTransaction, sarr []StockSplit) ([]*StockLot, []RealizedGain, error) {
+	method := "StockLot.BuildStockLots"
+	klogger.Enter(method)
+
+	sorted := slices.Clone(tarr)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].TransactionDt.Equal(sorted[j].TransactionDt) {
+			return sorted[i].ID < sorted[j].ID
+		}
+
+		return sorted[i].TransactionDt.Before(sorted[j].TransactionDt)
+	})
+
+	var lots []*StockLot
+	garr := []RealizedGain{}
+	splits := sortStockSplits(sarr)
+	si := 0
+
+	for _, t := range sorted {
+		//Trades made on the day of a split are in split shares
+		for ; si < len(splits) && !splits[si].ExecutionDt.After(t.TransactionDt); si++ {
+			splitLots(splits[si], lots)
+		}
+
+		if t.Type == constants.StockTransactionTypeBuy {
+			l := StockLot{
+				ID:         t.ID,
+				Ticker:     t.Ticker,
+				AcquiredDt: t.TransactionDt,
+				Quantity:   t.Quantity,
+				Remaining:  t.Quantity,
+			}
+
+			if t.Quantity > 0 {
+				l.CostPerShare = t.Price + t.Fees/t.Quantity
+			}
+
+			lots = append(lots, &l)
+			continue
+		}
+
+		g, err := sellFromLots(t, lots)
+		if err != nil {
+			klogger.ExitError(method, err.Error())
+			return nil, nil, err
+		}
+
+		garr = append(garr, g...)
+	}
+
+	for ; si < len(splits); si++ {
+		splitLots(splits[si], lots)
+	}
+
+	open := []*StockLot{}
+
+	for _, l := range lots {
+		if l.Remaining > 0.00005 {
+			l.CostBasis = math.Round(l.Remaining*l.CostPerShare*100) / 100
+			open = append(open, l)
+		}
+	}
+
+	klogger.Exit(method)
+	return open, garr, nil
+}
+
+// Function sellFromLots takes the shares of sale t from the open lots of its ticker and returns the gain realized from each lot
+func sellFromLots(t *StockTransaction, lots []*StockLot) ([]RealizedGain, error) {
+	method := "StockLot.sellFromLots"
+	klogger.Enter(method)
+
+	var garr []RealizedGain
+
+	if t.Quantity <= 0 {
+		klogger.Exit(method)
+		return garr, nil
+	}
+
+	//Fees are taken from the proceeds of each share sold
+	proceedsPerShare := t.Price - t.Fees/t.Quantity
+
+	take := func(l *StockLot, q float64) {
+		l.Remaining -= q
+
+		g := RealizedGain{
+			Ticker:     t.Ticker,
+			LotID:      l.ID,
+			AcquiredDt: l.AcquiredDt,
+			SoldDt:     t.TransactionDt,
+			Quantity:   q,
+			Proceeds:   math.Round(q*proceedsPerShare*100) / 100,
+			CostBasis:  math.Round(q*l.CostPerShare*100) / 100,
+			Term:       getGainTerm(l.AcquiredDt, t.TransactionDt),
+		}
+
+		g.Gain = math.Round((g.Proceeds-g.CostBasis)*100) / 100
+		garr = append(garr, g)
+	}
+
+	if t.LotMethod == constants.LotMethodSpecificID {
+		for _, s := range t.Lots {
+			i := slices.IndexFunc(lots, func(l *StockLot) bool {
+				return l.ID == s.LotID && l.Ticker == t.Ticker
+			})
+
+			if i < 0 || lots[i].Remaining < s.Quantity-0.00005 {
+				err := fmt.Errorf("lot %d does not have %g shares of %s available", s.LotID, s.Quantity, t.Ticker)
+				klogger.ExitError(method, err.Error())
+				return nil, err
+			}
+
+			take(lots[i], math.Min(s.Quantity, lots[i].Remaining))
+		}
+
+		klogger.Exit(method)
+		return garr, nil
+	}
+
+	order := slices.Clone(lots)
+	if t.LotMethod == constants.LotMethodLIFO {
+		slices.Reverse(order)
+	}
+
+	q := t.Quantity
+
+	for _, l := range order {
+		if q <= 0.00005 {
+			break
+		}
+
+		if l.Ticker != t.Ticker || l.Remaining <= 0.00005 {
+			continue
+		}
+
+		n := math.Min(q, l.Remaining)
+		take(l, n)
+		q -= n
+	}
+
+	klogger.Exit(method)
+	return garr, nil
+}
+
+// Function splitLots splits the lots of a stock bought before its split s. Each lot keeps its cost basis over its split shares
+func splitLots(s StockSplit, lots []*StockLot) {
+	method := "StockLot.splitLots"
+	klogger.Enter(method)
+
+	r := s.GetRatio()
+
+	for _, l := range lots {
+		if l.Ticker != s.Ticker || !l.AcquiredDt.Before(s.ExecutionDt) {
+			continue
+		}
+
+		l.Quantity *= r
+		l.Remaining *= r
+		l.CostPerShare /= r
+	}
+
+	klogger.Exit(method)
+}
+
+// Function getGainTerm returns whether shares acquired on a and sold or valued on s were held long-term, which is more than one year
+func getGainTerm(a time.Time, s time.Time) string {
+	method := "StockLot.getGainTerm"
+	klogger.Enter(method)
+
+	if s.After(a.AddDate(1, 0, 0)) {
+		klogger.Exit(method)
+		return constants.GainTermLong
+	}
+
+	klogger.Exit(method)
+	return constants.GainTermShort
+}
+
+// Function GetRealizedGainsByTaxYear totals realized gains by the year of their sale, ordered by year
+func GetRealizedGainsByTaxYear(garr []RealizedGain) []TaxYearGains {
+	method := "StockLot.GetRealizedGainsByTaxYear"
+	klogger.Enter(method)
+
+	years := make(map[int]*TaxYearGains)
+	tarr := []TaxYearGains{}
+
+	for _, g := range garr {
+		y := g.SoldDt.Year()
+
+		if years[y] == nil {
+			years[y] = &TaxYearGains{Year: y}
+		}
+
+		if g.Term == constants.GainTermLong {
+			years[y].LongTerm += g.Gain
+		} else {
+			years[y].ShortTerm += g.Gain
+		}
+	}
+
+	for _, t := range years {
+		t.ShortTerm = math.Round(t.ShortTerm*100) / 100
+		t.LongTerm = math.Round(t.LongTerm*100) / 100
+		t.Total = math.Round((t.ShortTerm+t.LongTerm)*100) / 100
+		tarr = append(tarr, *t)
+	}
+
+	sort.Slice(tarr, func(i, j int) bool {
+		return tarr[i].Year < tarr[j].Year
+	})
+
+	klogger.Exit(method)
+	return tarr
+}
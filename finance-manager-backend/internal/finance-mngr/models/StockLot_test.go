@@ -0,0 +1,226 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models/restmodels"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockStockTransactions() []*StockTransaction {
+	t1 := StockTransaction{
+		ID:            1,
+		Ticker:        "AAPL",
+		Type:          constants.StockTransactionTypeBuy,
+		Quantity:      10,
+		Price:         100,
+		Fees:          10,
+		TransactionDt: time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC),
+	}
+
+	t2 := StockTransaction{
+		ID:            2,
+		Ticker:        "AAPL",
+		Type:          constants.StockTransactionTypeBuy,
+		Quantity:      10,
+		Price:         150,
+		TransactionDt: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	t3 := StockTransaction{
+		ID:            3,
+		Ticker:        "MSFT",
+		Type:          constants.StockTransactionTypeBuy,
+		Quantity:      2,
+		Price:         50,
+		TransactionDt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	return []*StockTransaction{&t1, &t2, &t3}
+}
+
+func mockStockSale(m string) *StockTransaction {
+	return &StockTransaction{
+		ID:            4,
+		Ticker:        "AAPL",
+		Type:          constants.StockTransactionTypeSell,
+		Quantity:      5,
+		Price:         200,
+		Fees:          10,
+		LotMethod:     m,
+		TransactionDt: time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestBuildStockLots(t *testing.T) {
+	method := "StockLot_test.TestBuildStockLots"
+	klogger.Enter(method)
+
+	lots, garr, err := BuildStockLots(mockStockTransactions(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(garr))
+	assert.Equal(t, 3, len(lots))
+
+	//Lots are returned in the order they were bought and fees are added to their cost
+	assert.Equal(t, 1, lots[0].ID)
+	assert.Equal(t, 3, lots[1].ID)
+	assert.Equal(t, 2, lots[2].ID)
+	assert.Equal(t, 101.0, lots[0].CostPerShare)
+	assert.Equal(t, 1010.0, lots[0].CostBasis)
+	assert.Equal(t, 1500.0, lots[2].CostBasis)
+
+	//Shares sold without a purchase realize no gain
+	s := mockStockSale(constants.LotMethodFIFO)
+	s.Ticker = "GOOG"
+	lots, garr, err = BuildStockLots(append(mockStockTransactions(), s), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(garr))
+	assert.Equal(t, 3, len(lots))
+
+	klogger.Exit(method)
+}
+
+func TestBuildStockLots_fifo(t *testing.T) {
+	method := "StockLot_test.TestBuildStockLots_fifo"
+	klogger.Enter(method)
+
+	lots, garr, err := BuildStockLots(append(mockStockTransactions(), mockStockSale(constants.LotMethodFIFO)), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(lots))
+	assert.Equal(t, 5.0, lots[0].Remaining)
+	assert.Equal(t, 505.0, lots[0].CostBasis)
+	assert.Equal(t, 10.0, lots[2].Remaining)
+
+	assert.Equal(t, 1, len(garr))
+	assert.Equal(t, 1, garr[0].LotID)
+	assert.Equal(t, 990.0, garr[0].Proceeds)
+	assert.Equal(t, 505.0, garr[0].CostBasis)
+	assert.Equal(t, 485.0, garr[0].Gain)
+	assert.Equal(t, constants.GainTermLong, garr[0].Term)
+
+	klogger.Exit(method)
+}
+
+func TestBuildStockLots_lifo(t *testing.T) {
+	method := "StockLot_test.TestBuildStockLots_lifo"
+	klogger.Enter(method)
+
+	lots, garr, err := BuildStockLots(append(mockStockTransactions(), mockStockSale(constants.LotMethodLIFO)), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(lots))
+	assert.Equal(t, 10.0, lots[0].Remaining)
+	assert.Equal(t, 5.0, lots[2].Remaining)
+
+	assert.Equal(t, 1, len(garr))
+	assert.Equal(t, 2, garr[0].LotID)
+	assert.Equal(t, 750.0, garr[0].CostBasis)
+	assert.Equal(t, 240.0, garr[0].Gain)
+	assert.Equal(t, constants.GainTermShort, garr[0].Term)
+
+	//Sales larger than a lot continue into the next lot
+	s := mockStockSale(constants.LotMethodLIFO)
+	s.Quantity = 12
+	s.Fees = 0
+	lots, garr, err = BuildStockLots(append(mockStockTransactions(), s), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(lots))
+	assert.Equal(t, 8.0, lots[0].Remaining)
+	assert.Equal(t, 2, len(garr))
+	assert.Equal(t, 1, garr[1].LotID)
+	assert.Equal(t, 2.0, garr[1].Quantity)
+
+	klogger.Exit(method)
+}
+
+func TestBuildStockLots_specificId(t *testing.T) {
+	method := "StockLot_test.TestBuildStockLots_specificId"
+	klogger.Enter(method)
+
+	s := mockStockSale(constants.LotMethodSpecificID)
+	s.Lots = []restmodels.StockLotSelection{{LotID: 1, Quantity: 2}, {LotID: 2, Quantity: 3}}
+
+	lots, garr, err := BuildStockLots(append(mockStockTransactions(), s), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 8.0, lots[0].Remaining)
+	assert.Equal(t, 7.0, lots[2].Remaining)
+
+	assert.Equal(t, 2, len(garr))
+	assert.Equal(t, 194.0, garr[0].Gain)
+	assert.Equal(t, constants.GainTermLong, garr[0].Term)
+	assert.Equal(t, 144.0, garr[1].Gain)
+	assert.Equal(t, constants.GainTermShort, garr[1].Term)
+
+	//Lots must have the shares selected from them
+	s.Lots = []restmodels.StockLotSelection{{LotID: 2, Quantity: 11}}
+	_, _, err = BuildStockLots(append(mockStockTransactions(), s), nil)
+	assert.NotNil(t, err)
+
+	//Lots must be of the ticker being sold
+	s.Lots = []restmodels.StockLotSelection{{LotID: 3, Quantity: 1}}
+	_, _, err = BuildStockLots(append(mockStockTransactions(), s), nil)
+	assert.NotNil(t, err)
+
+	//Lots cannot be sold before they are bought
+	s.Lots = []restmodels.StockLotSelection{{LotID: 2, Quantity: 1}}
+	s.TransactionDt = time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	_, _, err = BuildStockLots(append(mockStockTransactions(), s), nil)
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
+
+func TestGetRealizedGainsByTaxYear(t *testing.T) {
+	method := "StockLot_test.TestGetRealizedGainsByTaxYear"
+	klogger.Enter(method)
+
+	garr := []RealizedGain{
+		{Gain: -10, Term: constants.GainTermShort, SoldDt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{Gain: 194, Term: constants.GainTermLong, SoldDt: time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{Gain: 144, Term: constants.GainTermShort, SoldDt: time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	tarr := GetRealizedGainsByTaxYear(garr)
+	assert.Equal(t, 2, len(tarr))
+	assert.Equal(t, TaxYearGains{Year: 2023, ShortTerm: 144, LongTerm: 194, Total: 338}, tarr[0])
+	assert.Equal(t, TaxYearGains{Year: 2024, ShortTerm: -10, LongTerm: 0, Total: -10}, tarr[1])
+
+	assert.Equal(t, 0, len(GetRealizedGainsByTaxYear(nil)))
+
+	klogger.Exit(method)
+}
+
+func TestBuildStockLots_split(t *testing.T) {
+	method := "StockLot_test.TestBuildStockLots_split"
+	klogger.Enter(method)
+
+	//Lot 1 is split 2:1 and 4:1, and lot 2 is only split 4:1
+	lots, garr, err := BuildStockLots(mockStockTransactions(), mockStockSplits())
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(lots))
+	assert.Equal(t, 80.0, lots[0].Remaining)
+	assert.Equal(t, 12.625, lots[0].CostPerShare)
+	assert.Equal(t, 1010.0, lots[0].CostBasis)
+	assert.Equal(t, 40.0, lots[2].Remaining)
+	assert.Equal(t, 1500.0, lots[2].CostBasis)
+
+	//The reverse split of MSFT halves its shares
+	assert.Equal(t, 1.0, lots[1].Remaining)
+	assert.Equal(t, 100.0, lots[1].CostBasis)
+
+	//Sales after a split are taken in split shares
+	s := mockStockSale(constants.LotMethodFIFO)
+	s.Quantity = 40
+	s.Price = 25
+	s.Fees = 0
+	lots, garr, err = BuildStockLots(append(mockStockTransactions(), s), mockStockSplits())
+	assert.Nil(t, err)
+	assert.Equal(t, 40.0, lots[0].Remaining)
+	assert.Equal(t, 1, len(garr))
+	assert.Equal(t, 505.0, garr[0].CostBasis)
+	assert.Equal(t, 1000.0, garr[0].Proceeds)
+
+	klogger.Exit(method)
+}
@@ -9,4 +9,5 @@ type StockPortfolioHistoryResponse struct {
 	DeltaPercentage float64                   `json:"deltaPercentage"`
 	Items           []PortfolioBalanceHistory `json:"items"`
 	Count           int                       `json:"count"`
+	Benchmarks      []BenchmarkComparison     `json:"benchmarks"`
 }
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"sort"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type StockSplit is a split of a stock that took effect on ExecutionDt. Each SplitFrom shares held before the split became
+// SplitTo shares
+type StockSplit struct {
+	ID           int       `json:"id"`
+	Ticker       string    `json:"ticker"`
+	ExecutionDt  time.Time `json:"executionDt"`
+	SplitFrom    float64   `json:"splitFrom"`
+	SplitTo      float64   `json:"splitTo"`
+	CreateDt     time.Time `json:"-"`
+	LastUpdateDt time.Time `json:"-"`
+}
+
+// Function GetRatio returns the number of shares each share held before the split became
+func (s *StockSplit) GetRatio() float64 {
+	method := "StockSplit.GetRatio"
+	klogger.Enter(method)
+
+	if s.SplitFrom <= 0 || s.SplitTo <= 0 {
+		klogger.Exit(method)
+		return 1
+	}
+
+	klogger.Exit(method)
+	return s.SplitTo / s.SplitFrom
+}
+
+// Function GetSplitRatio returns the number of shares of ticker t that each share held on d1 had become by d2
+func GetSplitRatio(t string, sarr []StockSplit, d1 time.Time, d2 time.Time) float64 {
+	method := "StockSplit.GetSplitRatio"
+	klogger.Enter(method)
+
+	r := 1.0
+
+	for _, s := range sarr {
+		if s.Ticker == t && s.ExecutionDt.After(d1) && !s.ExecutionDt.After(d2) {
+			r *= s.GetRatio()
+		}
+	}
+
+	klogger.Exit(method)
+	return r
+}
+
+// Function GetSplitRatioAfter returns the number of shares of ticker t that each share held on d has become after every later split in sarr.
+// Saved stock data is adjusted for every saved split, so quantities held on d are multiplied by this to be valued at its prices
+func GetSplitRatioAfter(t string, sarr []StockSplit, d time.Time) float64 {
+	method := "StockSplit.GetSplitRatioAfter"
+	klogger.Enter(method)
+
+	r := 1.0
+
+	for _, s := range sarr {
+		if s.Ticker == t && s.ExecutionDt.After(d) {
+			r *= s.GetRatio()
+		}
+	}
+
+	klogger.Exit(method)
+	return r
+}
+
+// Function sortStockSplits returns a copy of sarr ordered by execution date
+func sortStockSplits(sarr []StockSplit) []StockSplit {
+	method := "StockSplit.sortStockSplits"
+	klogger.Enter(method)
+
+	sorted := make([]StockSplit, len(sarr))
+	copy(sorted, sarr)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ExecutionDt.Before(sorted[j].ExecutionDt)
+	})
+
+	klogger.Exit(method)
+	return sorted
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockStockSplits() []StockSplit {
+	s1 := StockSplit{
+		ID:          1,
+		Ticker:      "AAPL",
+		ExecutionDt: time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+		SplitFrom:   1,
+		SplitTo:     2,
+	}
+
+	s2 := StockSplit{
+		ID:          2,
+		Ticker:      "AAPL",
+		ExecutionDt: time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC),
+		SplitFrom:   1,
+		SplitTo:     4,
+	}
+
+	s3 := StockSplit{
+		ID:          3,
+		Ticker:      "MSFT",
+		ExecutionDt: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+		SplitFrom:   2,
+		SplitTo:     1,
+	}
+
+	return []StockSplit{s1, s2, s3}
+}
+
+func TestStockSplitGetRatio(t *testing.T) {
+	method := "StockSplit_test.TestStockSplitGetRatio"
+	klogger.Enter(method)
+
+	sarr := mockStockSplits()
+	assert.Equal(t, 2.0, sarr[0].GetRatio())
+	assert.Equal(t, 4.0, sarr[1].GetRatio())
+
+	//Reverse splits reduce the number of shares
+	assert.Equal(t, 0.5, sarr[2].GetRatio())
+
+	//Splits without a ratio do not change the number of shares
+	s := StockSplit{}
+	assert.Equal(t, 1.0, s.GetRatio())
+
+	klogger.Exit(method)
+}
+
+func TestGetSplitRatio(t *testing.T) {
+	method := "StockSplit_test.TestGetSplitRatio"
+	klogger.Enter(method)
+
+	sarr := mockStockSplits()
+	d1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	d2 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 8.0, GetSplitRatio("AAPL", sarr, d1, d2))
+	assert.Equal(t, 0.5, GetSplitRatio("MSFT", sarr, d1, d2))
+	assert.Equal(t, 1.0, GetSplitRatio("GOOG", sarr, d1, d2))
+
+	//Shares held on the day of a split are already split
+	assert.Equal(t, 4.0, GetSplitRatio("AAPL", sarr, sarr[0].ExecutionDt, d2))
+	assert.Equal(t, 2.0, GetSplitRatio("AAPL", sarr, d1, sarr[0].ExecutionDt))
+
+	klogger.Exit(method)
+}
+
+func TestGetSplitRatioAfter(t *testing.T) {
+	method := "StockSplit_test.TestGetSplitRatioAfter"
+	klogger.Enter(method)
+
+	sarr := mockStockSplits()
+
+	assert.Equal(t, 8.0, GetSplitRatioAfter("AAPL", sarr, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 4.0, GetSplitRatioAfter("AAPL", sarr, sarr[0].ExecutionDt))
+	assert.Equal(t, 1.0, GetSplitRatioAfter("AAPL", sarr, sarr[1].ExecutionDt))
+	assert.Equal(t, 0.5, GetSplitRatioAfter("MSFT", sarr, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 1.0, GetSplitRatioAfter("GOOG", sarr, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/enums/stockoperation"
+	"finance-manager-backend/internal/finance-mngr/models/restmodels"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type StockTransaction records a purchase or sale of Quantity shares of a stock at Price per share. Fees are added to the cost of
+// a purchase and taken from the proceeds of a sale. Each purchase opens a lot whose ID is the ID of the transaction, and each sale
+// is taken from the open lots chosen by LotMethod, or from Lots when LotMethod is specific-id
+type StockTransaction struct {
+	ID            int                            `json:"id"`
+	UserID        int                            `json:"userId"`
+	Ticker        string                         `json:"ticker"`
+	Type          string                         `json:"type"`
+	Quantity      float64                        `json:"quantity"`
+	Price         float64                        `json:"price"`
+	Fees          float64                        `json:"fees"`
+	LotMethod     string                         `json:"lotMethod"`
+	Lots          []restmodels.StockLotSelection `json:"lots" gorm:"type:jsonb;serializer:json"`
+	TransactionDt time.Time                      `json:"transactionDt"`
+	CreateDt      time.Time                      `json:"-"`
+	LastUpdateDt  time.Time                      `json:"-"`
+}
+
+// Function NewStockTransaction returns the transaction recorded by a user's stock operation. Sales without a lot method are first in, first out
+func NewStockTransaction(uId int, r restmodels.ModifyStockRequest) StockTransaction {
+	method := "StockTransaction.NewStockTransaction"
+	klogger.Enter(method)
+
+	t := StockTransaction{
+		UserID:        uId,
+		Ticker:        r.Ticker,
+		Type:          constants.StockTransactionTypeBuy,
+		Quantity:      r.Amount,
+		Price:         r.Price,
+		Fees:          r.Fees,
+		Lots:          r.Lots,
+		TransactionDt: r.Date,
+	}
+
+	if r.Operation == stockoperation.Remove {
+		t.Type = constants.StockTransactionTypeSell
+		t.LotMethod = r.LotMethod
+
+		if t.LotMethod == "" {
+			t.LotMethod = constants.LotMethodFIFO
+		}
+	}
+
+	klogger.Exit(method)
+	return t
+}
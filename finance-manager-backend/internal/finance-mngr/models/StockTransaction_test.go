@@ -0,0 +1,50 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/enums/stockoperation"
+	"finance-manager-backend/internal/finance-mngr/models/restmodels"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStockTransaction(t *testing.T) {
+	method := "StockTransaction_test.TestNewStockTransaction"
+	klogger.Enter(method)
+
+	r := restmodels.ModifyStockRequest{
+		Ticker:    "AAPL",
+		Amount:    5,
+		Price:     100,
+		Fees:      1,
+		Operation: stockoperation.Add,
+		Date:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	st := NewStockTransaction(1, r)
+	assert.Equal(t, 1, st.UserID)
+	assert.Equal(t, "AAPL", st.Ticker)
+	assert.Equal(t, constants.StockTransactionTypeBuy, st.Type)
+	assert.Equal(t, 5.0, st.Quantity)
+	assert.Equal(t, 100.0, st.Price)
+	assert.Equal(t, 1.0, st.Fees)
+	assert.Equal(t, "", st.LotMethod)
+	assert.Equal(t, r.Date, st.TransactionDt)
+
+	//Sales are first in, first out by default
+	r.Operation = stockoperation.Remove
+	st = NewStockTransaction(1, r)
+	assert.Equal(t, constants.StockTransactionTypeSell, st.Type)
+	assert.Equal(t, constants.LotMethodFIFO, st.LotMethod)
+
+	r.LotMethod = constants.LotMethodSpecificID
+	r.Lots = []restmodels.StockLotSelection{{LotID: 1, Quantity: 5}}
+	st = NewStockTransaction(1, r)
+	assert.Equal(t, constants.LotMethodSpecificID, st.LotMethod)
+	assert.Equal(t, r.Lots, st.Lots)
+
+	klogger.Exit(method)
+}
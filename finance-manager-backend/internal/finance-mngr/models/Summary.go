@@ -1,6 +1,8 @@
 package models
 
 import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
 	"math"
 	"sort"
 	"time"
@@ -14,8 +16,15 @@ const loanSrc = "loan"
 const incomeSrc = "income"
 const taxSrc = "taxes"
 const taxName = "income tax"
+const retirementSrc = "retirement"
+const deductionSrc = "deductions"
 const billSrc = "bill"
 const ccSrc = "credit-card"
+const savingsGoalSrc = "savings-goal"
+const dividendSrc = "dividend"
+
+// Names of the tax expenses a summary itemizes, in the order they are added
+var taxNames = []string{taxName, constants.TaxNameFederal, constants.TaxNameState, constants.TaxNameSocialSecurity, constants.TaxNameMedicare}
 
 type SummaryItem struct {
 	Type    string  `json:"type"`
@@ -26,21 +35,29 @@ type SummaryItem struct {
 }
 
 type ExpenseSummary struct {
-	Expenses          []SummaryItem `json:"expenses"`
-	TotalCost         float64       `json:"totalCost"`
-	TotalBalance      float64       `json:"totalBalance"`
-	LoanCost          float64       `json:"loanCost"`
-	LoanBalance       float64       `json:"loanBalance"`
-	Taxes             float64       `json:"taxes"`
-	BillCost          float64       `json:"bills"`
-	CreditCardCost    float64       `json:"creditCards"`
-	CreditCardBalance float64       `json:"creditCardBalance"`
-	OverallBalance    float64       `json:"overallBalance"`
+	Expenses                []SummaryItem   `json:"expenses"`
+	TotalCost               float64         `json:"totalCost"`
+	TotalBalance            float64         `json:"totalBalance"`
+	LoanCost                float64         `json:"loanCost"`
+	LoanBalance             float64         `json:"loanBalance"`
+	Taxes                   float64         `json:"taxes"`
+	RetirementContributions float64         `json:"retirementContributions"`
+	Deductions              float64         `json:"deductions"`
+	BillCost                float64         `json:"bills"`
+	ActualSpending          float64         `json:"actualSpending"`
+	CategoryTotals          []CategoryTotal `json:"categoryTotals"`
+	CreditCardCost          float64         `json:"creditCards"`
+	CreditCardBalance       float64         `json:"creditCardBalance"`
+	SavingsGoalCost         float64         `json:"savingsGoals"`
+	OverallBalance          float64         `json:"overallBalance"`
+	AccountBalance          float64         `json:"accountBalance"`
+	NetWorth                float64         `json:"netWorth"`
 }
 
 type IncomeSummary struct {
-	Incomes     []SummaryItem `json:"incomes"`
-	TotalIncome float64       `json:"totalIncome"`
+	Incomes        []SummaryItem `json:"incomes"`
+	TotalIncome    float64       `json:"totalIncome"`
+	DividendIncome float64       `json:"dividendIncome"`
 }
 
 type CreditSummary struct {
@@ -49,19 +66,26 @@ type CreditSummary struct {
 	Utilization float64 `json:"utilization"`
 }
 
+// Type Summary totals a user's income and expenses for the month containing Date. Month is the number of months
+// after the current month the summary projects, and loan and credit card balances are projected forward that many months.
+// OverspentBudgets flags the budgets whose spending for the month is more than is available to them
 type Summary struct {
-	IncomeSummary  IncomeSummary  `json:"incomeSummary"`
-	ExpenseSummary ExpenseSummary `json:"expenseSummary"`
-	CreditSummary  CreditSummary  `json:"creditSummary"`
-	NetFunds       float64        `json:"netFunds"`
+	Month            int            `json:"month"`
+	Date             time.Time      `json:"date"`
+	IncomeSummary    IncomeSummary  `json:"incomeSummary"`
+	ExpenseSummary   ExpenseSummary `json:"expenseSummary"`
+	CreditSummary    CreditSummary  `json:"creditSummary"`
+	OverspentBudgets []BudgetStatus `json:"overspentBudgets"`
+	NetFunds         float64        `json:"netFunds"`
 }
 
 func (e *ExpenseSummary) CalculateExpenses() {
 	method := "Summary.CalculateExpenses"
 	klogger.Enter(method)
 
-	e.TotalCost = e.LoanCost + e.Taxes + e.BillCost + e.CreditCardCost
+	e.TotalCost = e.LoanCost + e.Taxes + e.RetirementContributions + e.Deductions + e.BillCost + e.CreditCardCost + e.SavingsGoalCost
 	e.TotalBalance = e.LoanBalance + e.CreditCardBalance
+	e.NetWorth = e.AccountBalance - e.TotalBalance
 
 	klogger.Exit(method)
 }
@@ -94,18 +118,25 @@ func (s *Summary) LoadLoans(larr []*Loan) {
 
 	//Loop through each loan and create an item for it
 	for _, l := range larr {
+		b := l.GetProjectedBalance(s.Month)
+
+		//Skip loans that have been paid off
+		if b <= 0.009 {
+			continue
+		}
+
 		i := SummaryItem{
 			Type:    expenseType,
 			Source:  loanSrc,
 			Name:    l.Name,
-			Amount:  l.MonthlyPayment,
-			Balance: l.Total,
+			Amount:  l.GetProjectedPayment(s.Month),
+			Balance: b,
 		}
 
 		//Add new item and increment total values
 		s.ExpenseSummary.Expenses = append(s.ExpenseSummary.Expenses, i)
 		loanBalance += i.Balance
-		loanCost += l.MonthlyPayment
+		loanCost += i.Amount
 	}
 
 	//Set total values
@@ -122,6 +153,9 @@ func (s *Summary) LoadIncomes(iarr []*Income) {
 
 	totalIncome := 0.0
 	taxes := 0.0
+	taxItems := make(map[string]float64)
+	deductionItems := make(map[string]float64)
+	t := s.getDate()
 
 	//Loop through each income and add up values
 	for _, i := range iarr {
@@ -129,31 +163,75 @@ func (s *Summary) LoadIncomes(iarr []*Income) {
 			Type:   incomeType,
 			Source: incomeSrc,
 			Name:   i.Name,
-			Amount: i.GetMonthlyGrossPay(time.Now()),
+		}
+
+		//Each paycheck is paid at the rate in effect on its payday
+		for _, p := range i.GetPaychecksForMonthContainingDate(t) {
+			j.Amount += p.GrossPay
+			taxes += p.Taxes
+
+			for name, amount := range p.GetTaxItems() {
+				taxItems[name] += amount
+			}
+
+			for name, amount := range p.GetDeductionItems() {
+				deductionItems[name] += amount
+			}
 		}
 
 		//Add new item and increment total values
 		s.IncomeSummary.Incomes = append(s.IncomeSummary.Incomes, j)
 		totalIncome += j.Amount
-		taxes += i.GetMonthlyTaxes(time.Now())
 	}
 
 	//Set Gross income for this month
 	s.IncomeSummary.TotalIncome = totalIncome
 
-	//Add Tax expense to expenses
-	if taxes > 0 {
+	//Add an expense for each type of tax withheld
+	for _, name := range taxNames {
+		if taxItems[name] <= 0 {
+			continue
+		}
+
 		taxItem := SummaryItem{
 			Type:   expenseType,
 			Source: taxSrc,
-			Name:   taxName,
-			Amount: taxes,
+			Name:   name,
+			Amount: taxItems[name],
 		}
 
 		s.ExpenseSummary.Expenses = append(s.ExpenseSummary.Expenses, taxItem)
 	}
 
+	//Add an expense for each deduction taken, keeping retirement contributions separate from other deductions
+	retirement := 0.0
+	deductions := 0.0
+
+	for _, name := range append(preTaxDeductionNames, postTaxDeductionNames...) {
+		if deductionItems[name] <= 0 {
+			continue
+		}
+
+		deductionItem := SummaryItem{
+			Type:   expenseType,
+			Source: deductionSrc,
+			Name:   name,
+			Amount: deductionItems[name],
+		}
+
+		if name == constants.DeductionNameRetirement || name == constants.DeductionNameRoth {
+			deductionItem.Source = retirementSrc
+			retirement += deductionItem.Amount
+		} else {
+			deductions += deductionItem.Amount
+		}
+
+		s.ExpenseSummary.Expenses = append(s.ExpenseSummary.Expenses, deductionItem)
+	}
+
 	s.ExpenseSummary.Taxes = taxes
+	s.ExpenseSummary.RetirementContributions = retirement
+	s.ExpenseSummary.Deductions = deductions
 	s.ExpenseSummary.CalculateExpenses()
 
 	klogger.Exit(method)
@@ -164,19 +242,27 @@ func (s *Summary) LoadBills(barr []*Bill) {
 	klogger.Enter(method)
 
 	totalCost := 0.0
+	t := s.getDate()
 
-	//Loop through each bill and add up values
+	//Loop through each bill and add up values for each time it is due this month
 	for _, b := range barr {
+		n := b.GetOccurrencesForMonthContainingDate(t)
+
+		//Skip bills that are not due this month
+		if n == 0 {
+			continue
+		}
+
 		i := SummaryItem{
 			Type:   expenseType,
 			Source: billSrc,
 			Name:   b.Name,
-			Amount: b.Amount,
+			Amount: b.Amount * float64(n),
 		}
 
 		//Add new item and increment total values
 		s.ExpenseSummary.Expenses = append(s.ExpenseSummary.Expenses, i)
-		totalCost += b.Amount
+		totalCost += i.Amount
 	}
 
 	//Set total cost for the month
@@ -198,21 +284,21 @@ func (s *Summary) LoadCreditCards(carr []*CreditCard) {
 
 	//Loop through each credit card and add up the values
 	for _, cc := range carr {
-		cc.CalcPayment()
+		b := cc.GetProjectedBalance(s.Month)
 
 		i := SummaryItem{
 			Type:    expenseType,
 			Source:  ccSrc,
 			Name:    cc.Name,
-			Amount:  cc.Payment,
-			Balance: cc.Balance,
+			Amount:  math.Min(cc.GetMinPaymentForBalance(b), b),
+			Balance: b,
 		}
 
 		//Add new item and increment total values
 		s.ExpenseSummary.Expenses = append(s.ExpenseSummary.Expenses, i)
-		tcost += cc.Payment
+		tcost += i.Amount
 		tcredit += cc.Limit
-		tbalance += cc.Balance
+		tbalance += b
 	}
 
 	//Set totals for the month
@@ -238,3 +324,213 @@ func (s *Summary) LoadCreditCards(carr []*CreditCard) {
 
 	klogger.Exit(method)
 }
+
+func (s *Summary) LoadSavingsGoals(garr []*SavingsGoal) {
+	method := "Summary.LoadSavingsGoals"
+	klogger.Enter(method)
+
+	totalCost := 0.0
+	t := s.getDate()
+
+	//Loop through each goal and add what is set aside for it this month
+	for _, g := range garr {
+		amount := g.GetMonthlyContribution(t)
+
+		//Skip goals that are not contributed to this month
+		if amount <= 0 {
+			continue
+		}
+
+		i := SummaryItem{
+			Type:    expenseType,
+			Source:  savingsGoalSrc,
+			Name:    g.Name,
+			Amount:  amount,
+			Balance: g.Saved,
+		}
+
+		//Add new item and increment total values
+		s.ExpenseSummary.Expenses = append(s.ExpenseSummary.Expenses, i)
+		totalCost += i.Amount
+	}
+
+	//Set total cost for the month
+	s.ExpenseSummary.SavingsGoalCost = totalCost
+
+	//Recalculate total cost
+	s.ExpenseSummary.CalculateExpenses()
+
+	klogger.Exit(method)
+}
+
+// Function LoadBankAccounts adds up the balance of each account at the end of the month into the summary's net worth, and totals
+// the transactions made during the month by category so that actual spending can be compared to the planned costs.
+// Transactions must already be loaded
+func (s *Summary) LoadBankAccounts(aarr []*BankAccount) {
+	method := "Summary.LoadBankAccounts"
+	klogger.Enter(method)
+
+	total := 0.0
+	t := fmUtil.GetMonthEndDate(s.getDate())
+	var tarr []*BankAccountTransaction
+
+	for _, a := range aarr {
+		total += a.GetBalanceForDate(t)
+
+		for i := range a.Transactions {
+			tarr = append(tarr, &a.Transactions[i])
+		}
+	}
+
+	s.ExpenseSummary.AccountBalance = math.Round(total*100) / 100
+	s.ExpenseSummary.CategoryTotals = GetCategoryTotals(tarr, fmUtil.GetMonthBeginDate(t), t)
+	s.ExpenseSummary.ActualSpending = 0
+
+	for _, ct := range s.ExpenseSummary.CategoryTotals {
+		s.ExpenseSummary.ActualSpending += ct.Spent
+	}
+
+	s.ExpenseSummary.ActualSpending = math.Round(s.ExpenseSummary.ActualSpending*100) / 100
+
+	//Recalculate net worth
+	s.ExpenseSummary.CalculateExpenses()
+
+	klogger.Exit(method)
+}
+
+// Function LoadBudgets flags the budgets that are overspent for the month. Bank account transactions must already be loaded
+func (s *Summary) LoadBudgets(budgets []*Budget, barr []*Bill, larr []*Loan, aarr []*BankAccount) {
+	method := "Summary.LoadBudgets"
+	klogger.Enter(method)
+
+	var tarr []*BankAccountTransaction
+
+	for _, a := range aarr {
+		for i := range a.Transactions {
+			tarr = append(tarr, &a.Transactions[i])
+		}
+	}
+
+	s.OverspentBudgets = []BudgetStatus{}
+
+	for _, bs := range GetBudgetStatuses(s.getDate(), s.Month, budgets, barr, larr, tarr) {
+		if bs.Overspent {
+			s.OverspentBudgets = append(s.OverspentBudgets, bs)
+		}
+	}
+
+	klogger.Exit(method)
+}
+
+// Function LoadDividends adds an income for each stock that pays the user dividends during the month. Must be loaded after incomes
+func (s *Summary) LoadDividends(parr []DividendPayment) {
+	method := "Summary.LoadDividends"
+	klogger.Enter(method)
+
+	t := s.getDate()
+	sd := fmUtil.GetMonthBeginDate(t)
+	ed := fmUtil.GetMonthEndDate(t)
+	items := make(map[string]*SummaryItem)
+	var tickers []string
+	total := 0.0
+
+	for _, p := range parr {
+		if p.PayDt.Before(sd) || p.PayDt.After(ed) {
+			continue
+		}
+
+		if items[p.Ticker] == nil {
+			items[p.Ticker] = &SummaryItem{
+				Type:   incomeType,
+				Source: dividendSrc,
+				Name:   p.Ticker,
+			}
+
+			tickers = append(tickers, p.Ticker)
+		}
+
+		items[p.Ticker].Amount += p.Amount
+		total += p.Amount
+	}
+
+	for _, ticker := range tickers {
+		items[ticker].Amount = math.Round(items[ticker].Amount*100) / 100
+		s.IncomeSummary.Incomes = append(s.IncomeSummary.Incomes, *items[ticker])
+	}
+
+	s.IncomeSummary.DividendIncome = math.Round(total*100) / 100
+	s.IncomeSummary.TotalIncome += s.IncomeSummary.DividendIncome
+
+	klogger.Exit(method)
+}
+
+// Function getDate returns the date the summary is calculated for. Summaries without a date are for the current month
+func (s *Summary) getDate() time.Time {
+	method := "Summary.getDate"
+	klogger.Enter(method)
+
+	if s.Date.IsZero() {
+		klogger.Exit(method)
+		return time.Now()
+	}
+
+	klogger.Exit(method)
+	return s.Date
+}
+
+// Type SummaryData holds everything a user's summaries are built from. Loans, credit cards and savings goals must be loaded
+// in the same state they would be for a single Summary. Dividends are only added to income when they are set
+type SummaryData struct {
+	Loans        []*Loan
+	Incomes      []*Income
+	Bills        []*Bill
+	CreditCards  []*CreditCard
+	SavingsGoals []*SavingsGoal
+	BankAccounts []*BankAccount
+	Budgets      []*Budget
+	Dividends    []DividendPayment
+}
+
+// Function LoadData loads everything in d into the summary. The summary must still be finalized
+func (s *Summary) LoadData(d SummaryData) {
+	method := "Summary.LoadData"
+	klogger.Enter(method)
+
+	s.LoadLoans(d.Loans)
+	s.LoadIncomes(d.Incomes)
+	s.LoadBills(d.Bills)
+	s.LoadCreditCards(d.CreditCards)
+	s.LoadSavingsGoals(d.SavingsGoals)
+	s.LoadBankAccounts(d.BankAccounts)
+	s.LoadBudgets(d.Budgets, d.Bills, d.Loans, d.BankAccounts)
+
+	if d.Dividends != nil {
+		s.LoadDividends(d.Dividends)
+	}
+
+	klogger.Exit(method)
+}
+
+// Function ProjectSummaries returns a Summary of d for each of the n months beginning with the month containing t
+func ProjectSummaries(t time.Time, n int, d SummaryData) []Summary {
+	method := "Summary.ProjectSummaries"
+	klogger.Enter(method)
+
+	start := fmUtil.GetMonthBeginDate(t)
+	var sarr []Summary
+
+	for k := 0; k < n; k++ {
+		s := Summary{
+			Month: k,
+			Date:  fmUtil.AddMonths(start, k),
+		}
+
+		s.LoadData(d)
+		s.Finalize()
+
+		sarr = append(sarr, s)
+	}
+
+	klogger.Exit(method)
+	return sarr
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type SummarySnapshot records the totals of a user's Summary at the end of a month so they can be charted over time
+type SummarySnapshot struct {
+	ID                int       `json:"id"`
+	UserID            int       `json:"userId"`
+	SnapshotDt        time.Time `json:"snapshotDt"`
+	TotalIncome       float64   `json:"totalIncome"`
+	TotalCost         float64   `json:"totalCost"`
+	NetFunds          float64   `json:"netFunds"`
+	LoanBalance       float64   `json:"loanBalance"`
+	CreditCardBalance float64   `json:"creditCardBalance"`
+	TotalBalance      float64   `json:"totalBalance"`
+	CreditUtilization float64   `json:"creditUtilization"`
+	AccountBalance    float64   `json:"accountBalance"`
+	NetWorth          float64   `json:"netWorth"`
+	CreateDt          time.Time `json:"createDt"`
+}
+
+// Function NewSummarySnapshot creates a snapshot of summary s for user u taken on date t
+func NewSummarySnapshot(u int, s Summary, t time.Time) SummarySnapshot {
+	method := "SummarySnapshot.NewSummarySnapshot"
+	klogger.Enter(method)
+
+	ss := SummarySnapshot{
+		UserID:            u,
+		SnapshotDt:        t,
+		TotalIncome:       s.IncomeSummary.TotalIncome,
+		TotalCost:         s.ExpenseSummary.TotalCost,
+		NetFunds:          s.NetFunds,
+		LoanBalance:       s.ExpenseSummary.LoanBalance,
+		CreditCardBalance: s.ExpenseSummary.CreditCardBalance,
+		TotalBalance:      s.ExpenseSummary.TotalBalance,
+		CreditUtilization: s.CreditSummary.Utilization,
+		AccountBalance:    s.ExpenseSummary.AccountBalance,
+		NetWorth:          s.ExpenseSummary.NetWorth,
+	}
+
+	klogger.Exit(method)
+	return ss
+}
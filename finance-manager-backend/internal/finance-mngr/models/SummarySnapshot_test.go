@@ -0,0 +1,38 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSummarySnapshot(t *testing.T) {
+	method := "SummarySnapshot_test.TestNewSummarySnapshot"
+	klogger.Enter(method)
+
+	d := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	var s Summary
+	s.LoadLoans(mockLoans())
+	s.LoadCreditCards(mockCreditCards())
+	s.LoadIncomes(mockIncomes())
+	s.Finalize()
+
+	ss := NewSummarySnapshot(2, s, d)
+
+	assert.Equal(t, 2, ss.UserID)
+	assert.Equal(t, d, ss.SnapshotDt)
+	assert.Equal(t, 2.0, ss.TotalIncome)
+	assert.Equal(t, 6.0, ss.TotalCost)
+	assert.Equal(t, -4.0, ss.NetFunds)
+	assert.Equal(t, 2.0, ss.LoanBalance)
+	assert.Equal(t, 2.0, ss.CreditCardBalance)
+	assert.Equal(t, 4.0, ss.TotalBalance)
+	assert.Equal(t, 100.0, ss.CreditUtilization)
+	assert.Equal(t, 0.0, ss.AccountBalance)
+	assert.Equal(t, -4.0, ss.NetWorth)
+
+	klogger.Exit(method)
+}
@@ -1,7 +1,10 @@
 package models
 
 import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
 	"testing"
+	"time"
 
 	"github.com/jon-kamis/klogger"
 	"github.com/stretchr/testify/assert"
@@ -68,6 +71,35 @@ func TestLoadLoans(t *testing.T) {
 	klogger.Exit(method)
 }
 
+func TestLoadLoans_payments(t *testing.T) {
+	method := "Summary_test.TestLoadLoans_payments"
+	klogger.Enter(method)
+
+	var s Summary
+	larr := []*Loan{
+		{ID: 1, Name: "Loan1", Total: 1000, MonthlyPayment: 100},
+		{ID: 2, Name: "Loan2", Total: 500, MonthlyPayment: 50},
+	}
+
+	parr := []*LoanPayment{
+		{LoanID: 1, Type: constants.LoanPaymentTypeExtraPrincipal, Amount: 400, PaymentDt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{LoanID: 2, Type: constants.LoanPaymentTypeExtraPrincipal, Amount: 500, PaymentDt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, l := range larr {
+		l.LoadPayments(parr)
+	}
+
+	s.LoadLoans(larr)
+
+	//Remaining balance is used and paid off loans are skipped
+	assert.Equal(t, 1, len(s.ExpenseSummary.Expenses))
+	assert.Equal(t, 600.0, s.ExpenseSummary.LoanBalance)
+	assert.Equal(t, 100.0, s.ExpenseSummary.LoanCost)
+
+	klogger.Exit(method)
+}
+
 func TestLoadBills(t *testing.T) {
 	method := "Summary_test.TestLoadBills"
 	klogger.Enter(method)
@@ -88,6 +120,29 @@ func TestLoadBills(t *testing.T) {
 	klogger.Exit(method)
 }
 
+func TestLoadBills_frequency(t *testing.T) {
+	method := "Summary_test.TestLoadBills_frequency"
+	klogger.Enter(method)
+
+	var s Summary
+	now := time.Now()
+
+	barr := []*Bill{
+		{Name: "Insurance", Amount: 1200, Frequency: constants.BillFreqAnnual, DueDt: now},
+		{Name: "Taxes", Amount: 500, Frequency: constants.BillFreqQuarterly, DueDt: fmUtil.AddMonths(now, 1)},
+		{Name: "Groceries", Amount: 100, Frequency: constants.BillFreqWeekly, DueDt: fmUtil.GetMonthBeginDate(now)},
+	}
+
+	s.LoadBills(barr)
+
+	//Bills that are not due this month are skipped
+	weeks := barr[2].GetOccurrencesForMonthContainingDate(now)
+	assert.Equal(t, 2, len(s.ExpenseSummary.Expenses))
+	assert.Equal(t, 1200+100*float64(weeks), s.ExpenseSummary.BillCost)
+
+	klogger.Exit(method)
+}
+
 func TestLoadCreditCards(t *testing.T) {
 	method := "Summary_test.TestLoadCreditCards"
 	klogger.Enter(method)
@@ -131,6 +186,204 @@ func TestLoadIncomes(t *testing.T) {
 	klogger.Exit(method)
 }
 
+func TestLoadIncomes_taxItems(t *testing.T) {
+	method := "Summary_test.TestLoadIncomes_taxItems"
+	klogger.Enter(method)
+
+	s := Summary{Date: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)}
+
+	i := Income{
+		Name:         "i1",
+		GrossPay:     1000,
+		Frequency:    constants.IncomeFreqMonthly,
+		FilingStatus: constants.FilingStatusSingle,
+	}
+
+	err := i.CalcTaxes(stubTaxEngine{})
+	assert.Nil(t, err)
+
+	s.LoadIncomes([]*Income{&i})
+
+	//Each tax owed is its own expense and state tax is not listed since none is owed
+	assert.InDelta(t, 180.0, s.ExpenseSummary.Taxes, 0.001)
+	assert.Equal(t, 3, len(s.ExpenseSummary.Expenses))
+
+	names := make(map[string]float64)
+	for _, e := range s.ExpenseSummary.Expenses {
+		names[e.Name] = e.Amount
+	}
+
+	assert.InDelta(t, 100.0, names[constants.TaxNameFederal], 0.001)
+	assert.InDelta(t, 60.0, names[constants.TaxNameSocialSecurity], 0.001)
+	assert.InDelta(t, 20.0, names[constants.TaxNameMedicare], 0.001)
+
+	klogger.Exit(method)
+}
+
+func TestLoadIncomes_deductions(t *testing.T) {
+	method := "Summary_test.TestLoadIncomes_deductions"
+	klogger.Enter(method)
+
+	s := Summary{Date: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)}
+
+	i := Income{
+		Name:                 "i1",
+		Rate:                 1000,
+		Type:                 constants.IncomeTypeSalary,
+		Frequency:            constants.IncomeFreqMonthly,
+		TaxPercentage:        0.10,
+		RetirementPercentage: 0.05,
+		RothPercentage:       0.05,
+		HealthPremium:        100,
+	}
+
+	err := i.PopulateEmptyValues(s.Date)
+	assert.Nil(t, err)
+
+	s.LoadIncomes([]*Income{&i})
+
+	//Retirement contributions are reported separately from taxes and other deductions
+	assert.InDelta(t, 85.0, s.ExpenseSummary.Taxes, 0.001)
+	assert.InDelta(t, 100.0, s.ExpenseSummary.RetirementContributions, 0.001)
+	assert.InDelta(t, 100.0, s.ExpenseSummary.Deductions, 0.001)
+	assert.InDelta(t, 285.0, s.ExpenseSummary.TotalCost, 0.001)
+	assert.Equal(t, 4, len(s.ExpenseSummary.Expenses))
+
+	sources := make(map[string]string)
+	for _, e := range s.ExpenseSummary.Expenses {
+		sources[e.Name] = e.Source
+	}
+
+	assert.Equal(t, retirementSrc, sources[constants.DeductionNameRetirement])
+	assert.Equal(t, retirementSrc, sources[constants.DeductionNameRoth])
+	assert.Equal(t, deductionSrc, sources[constants.DeductionNameHealthPremium])
+
+	//Net funds only include the pay left after deductions
+	s.Finalize()
+	assert.InDelta(t, i.NetPay, s.NetFunds, 0.001)
+
+	klogger.Exit(method)
+}
+
+func TestLoadIncomes_versions(t *testing.T) {
+	method := "Summary_test.TestLoadIncomes_versions"
+	klogger.Enter(method)
+
+	s := Summary{Date: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)}
+
+	i := Income{
+		ID:            1,
+		Name:          "i1",
+		Rate:          1000,
+		Type:          constants.IncomeTypeSalary,
+		Frequency:     constants.IncomeFreqBiWeekly,
+		TaxPercentage: 0.1,
+		StartDt:       time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+	}
+
+	i.LoadVersions([]*IncomeVersion{{IncomeID: 1, Rate: 1200, EffectiveDt: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)}})
+
+	err := i.PopulateEmptyValues(s.Date)
+	assert.Nil(t, err)
+
+	s.LoadIncomes([]*Income{&i})
+
+	//Paid on March 1st before the raise and March 15th and 29th after it
+	assert.InDelta(t, 3400.0, s.IncomeSummary.TotalIncome, 0.001)
+	assert.InDelta(t, 340.0, s.ExpenseSummary.Taxes, 0.001)
+
+	klogger.Exit(method)
+}
+
+func TestLoadSavingsGoals(t *testing.T) {
+	method := "Summary_test.TestLoadSavingsGoals"
+	klogger.Enter(method)
+
+	s := Summary{Date: time.Date(2099, 3, 10, 0, 0, 0, 0, time.UTC)}
+
+	g1 := SavingsGoal{
+		ID:                 1,
+		Name:               "g1",
+		Target:             1200,
+		Deadline:           time.Date(2099, 12, 31, 0, 0, 0, 0, time.UTC),
+		ContributionPerPay: 100,
+	}
+
+	//Goals that have reached their target are not contributed to
+	g2 := SavingsGoal{
+		ID:                 2,
+		Name:               "g2",
+		Target:             500,
+		Deadline:           time.Date(2099, 12, 31, 0, 0, 0, 0, time.UTC),
+		ContributionPerPay: 100,
+	}
+
+	garr := []*SavingsGoal{&g1, &g2}
+	for _, g := range garr {
+		g.LoadContributions(mockSavingsGoalContributions())
+		g.CalcProgress(s.Date)
+	}
+
+	s.LoadSavingsGoals(garr)
+
+	assert.Equal(t, 1, len(s.ExpenseSummary.Expenses))
+	assert.Equal(t, savingsGoalSrc, s.ExpenseSummary.Expenses[0].Source)
+	assert.Equal(t, 200.0, s.ExpenseSummary.Expenses[0].Balance)
+	assert.Equal(t, 100.0, s.ExpenseSummary.SavingsGoalCost)
+	assert.Equal(t, 100.0, s.ExpenseSummary.TotalCost)
+
+	klogger.Exit(method)
+}
+
+func TestLoadBankAccounts(t *testing.T) {
+	method := "Summary_test.TestLoadBankAccounts"
+	klogger.Enter(method)
+
+	s := Summary{Date: time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)}
+	s.ExpenseSummary.LoanBalance = 500
+	s.ExpenseSummary.CreditCardBalance = 300.55
+
+	a1 := mockBankAccount()
+	a1.LoadTransactions(mockBankAccountTransactions())
+
+	a2 := mockBankAccount()
+	a2.ID = 2
+	a2.OpeningBalance = 250
+	a2.LoadTransactions(mockBankAccountTransactions())
+
+	s.LoadBankAccounts([]*BankAccount{&a1, &a2})
+
+	//Balances are taken at the end of the month
+	assert.Equal(t, 1550.55, s.ExpenseSummary.AccountBalance)
+	assert.Equal(t, 750.0, s.ExpenseSummary.NetWorth)
+
+	//Transactions made during the month are totaled by category
+	assert.Equal(t, 200.0, s.ExpenseSummary.ActualSpending)
+	assert.Equal(t, []CategoryTotal{{Category: constants.UncategorizedCategory, Spent: 200, Net: 200}}, s.ExpenseSummary.CategoryTotals)
+
+	klogger.Exit(method)
+}
+
+func TestLoadBudgets(t *testing.T) {
+	method := "Summary_test.TestLoadBudgets"
+	klogger.Enter(method)
+
+	s := Summary{Date: time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)}
+	barr, larr, tarr := mockBudgetRecords()
+
+	a := mockBankAccount()
+	a.LoadTransactions(tarr)
+
+	s.LoadBudgets(mockBudgets(), barr, larr, []*BankAccount{&a})
+
+	//Only overspent budgets are flagged
+	assert.Equal(t, 2, len(s.OverspentBudgets))
+	assert.Equal(t, "rent", s.OverspentBudgets[0].Category)
+	assert.Equal(t, "groceries", s.OverspentBudgets[1].Category)
+
+	klogger.Exit(method)
+}
+
 func mockLoans() []*Loan {
 
 	l1 := Loan{
@@ -215,3 +468,77 @@ func mockIncomes() []*Income {
 	iarr = append(iarr, &i2)
 	return iarr
 }
+
+func TestProjectSummaries(t *testing.T) {
+	method := "Summary_test.TestProjectSummaries"
+	klogger.Enter(method)
+
+	d := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	larr := []*Loan{{Name: "Loan1", Total: 250, MonthlyPayment: 100}}
+	carr := []*CreditCard{{Name: "CC1", Balance: 100, Limit: 1000, MinPayment: 40}}
+
+	//Bi-weekly income with three paydays in March 2024
+	iarr := []*Income{{Name: "Income1", GrossPay: 1000, Taxes: 100, Frequency: constants.IncomeFreqBiWeekly, StartDt: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)}}
+	barr := []*Bill{{Name: "Bill1", Amount: 10}}
+
+	sarr := ProjectSummaries(d, 4, SummaryData{Loans: larr, Incomes: iarr, Bills: barr, CreditCards: carr})
+
+	assert.Equal(t, 4, len(sarr))
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), sarr[2].Date)
+	assert.Equal(t, 2, sarr[2].Month)
+
+	//Loan balance declines each month and the final payment only covers what is left
+	assert.Equal(t, 250.0, sarr[0].ExpenseSummary.LoanBalance)
+	assert.Equal(t, 150.0, sarr[1].ExpenseSummary.LoanBalance)
+	assert.Equal(t, 50.0, sarr[2].ExpenseSummary.LoanCost)
+	assert.Equal(t, 0.0, sarr[3].ExpenseSummary.LoanCost)
+
+	//Credit card follows minimum payments until it is paid off
+	assert.Equal(t, 60.0, sarr[1].ExpenseSummary.CreditCardBalance)
+	assert.Equal(t, 20.0, sarr[2].ExpenseSummary.CreditCardCost)
+	assert.Equal(t, 0.0, sarr[3].ExpenseSummary.CreditCardCost)
+
+	//Pay counts vary by month
+	assert.Equal(t, 2000.0, sarr[0].IncomeSummary.TotalIncome)
+	assert.Equal(t, 3000.0, sarr[2].IncomeSummary.TotalIncome)
+
+	assert.Equal(t, 3000.0-300-10-50-20, sarr[2].NetFunds)
+
+	//Dividends are added to the income of the month they are paid in
+	parr := []DividendPayment{{Ticker: "AAPL", Amount: 2.5, PayDt: time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)}}
+	sarr = ProjectSummaries(d, 4, SummaryData{Incomes: iarr, Dividends: parr})
+
+	assert.Equal(t, 0.0, sarr[0].IncomeSummary.DividendIncome)
+	assert.Equal(t, 2.5, sarr[1].IncomeSummary.DividendIncome)
+	assert.Equal(t, 2002.5, sarr[1].IncomeSummary.TotalIncome)
+
+	klogger.Exit(method)
+}
+
+func TestLoadDividends(t *testing.T) {
+	method := "Summary_test.TestLoadDividends"
+	klogger.Enter(method)
+
+	parr := []DividendPayment{
+		{Ticker: "AAPL", Amount: 2.4, PayDt: time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)},
+		{Ticker: "MSFT", Amount: 3.75, PayDt: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{Ticker: "AAPL", Amount: 1.1, PayDt: time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC)},
+		{Ticker: "AAPL", Amount: 5, PayDt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	s := Summary{Date: time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)}
+	s.LoadIncomes([]*Income{{Name: "Income1", GrossPay: 1000, Taxes: 100, Frequency: constants.IncomeFreqMonthly, StartDt: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)}})
+	s.LoadDividends(parr)
+	s.Finalize()
+
+	//Dividends paid during the month are added to income by ticker
+	assert.Equal(t, 7.25, s.IncomeSummary.DividendIncome)
+	assert.Equal(t, 1007.25, s.IncomeSummary.TotalIncome)
+	assert.Equal(t, 3, len(s.IncomeSummary.Incomes))
+	assert.Equal(t, SummaryItem{Type: incomeType, Source: dividendSrc, Name: "MSFT", Amount: 3.75}, s.IncomeSummary.Incomes[1])
+	assert.Equal(t, SummaryItem{Type: incomeType, Source: dividendSrc, Name: "AAPL", Amount: 3.5}, s.IncomeSummary.Incomes[2])
+	assert.Equal(t, 1007.25-100, s.NetFunds)
+
+	klogger.Exit(method)
+}
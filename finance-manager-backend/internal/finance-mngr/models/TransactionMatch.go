@@ -0,0 +1,89 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"math"
+	"strings"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type TransactionMatch names the income, bill or credit card an imported transaction appears to be for
+type TransactionMatch struct {
+	Source   string `json:"source"`
+	SourceID int    `json:"sourceId"`
+	Name     string `json:"name"`
+}
+
+// Function SuggestMatch sets the Suggestion of the transaction to the income, bill or credit card it most likely belongs to.
+// Deposits are matched to incomes and withdrawals to bills and credit cards. A payee or description naming the record is the strongest match,
+// followed by an amount equal to a paycheck or bill due within TransactionMatchWindowDays of the transaction.
+// Incomes must already have their values populated and taxes calculated
+func (t *BankAccountTransaction) SuggestMatch(iarr []*Income, barr []*Bill, carr []*CreditCard) {
+	method := "BankAccountTransaction.SuggestMatch"
+	klogger.Enter(method)
+
+	t.Suggestion = nil
+	best := 0
+
+	suggest := func(score int, source string, id int, name string) {
+		if score > best {
+			best = score
+			t.Suggestion = &TransactionMatch{Source: source, SourceID: id, Name: name}
+		}
+	}
+
+	s := t.TransactionDt.AddDate(0, 0, -1*constants.TransactionMatchWindowDays)
+	e := t.TransactionDt.AddDate(0, 0, constants.TransactionMatchWindowDays)
+
+	if t.Type == constants.TransactionTypeDeposit {
+		for _, i := range iarr {
+			score := t.getNameScore(i.Name)
+
+			for _, d := range i.GetPayDatesBetween(s, e) {
+				p := i.GetPaycheckForDate(d)
+				if math.Abs(math.Round(p.NetPay*100)/100-t.Amount) < 0.01 {
+					score++
+					break
+				}
+			}
+
+			suggest(score, constants.TransactionSourceIncome, i.ID, i.Name)
+		}
+	}
+
+	if t.Type == constants.TransactionTypeWithdrawal {
+		for _, b := range barr {
+			score := t.getNameScore(b.Name)
+
+			if math.Abs(b.Amount-t.Amount) < 0.01 && (b.DueDt.IsZero() || len(b.GetDueDatesBetween(s, e)) > 0) {
+				score++
+			}
+
+			suggest(score, constants.TransactionSourceBill, b.ID, b.Name)
+		}
+
+		for _, c := range carr {
+			suggest(t.getNameScore(c.Name), constants.TransactionSourceCreditCard, c.ID, c.Name)
+		}
+	}
+
+	klogger.Exit(method)
+}
+
+// Function getNameScore returns 2 if the payee or description of the transaction contains name and 0 if it does not
+func (t *BankAccountTransaction) getNameScore(name string) int {
+	method := "BankAccountTransaction.getNameScore"
+	klogger.Enter(method)
+
+	n := strings.ToLower(strings.TrimSpace(name))
+	text := strings.ToLower(t.Payee + " " + t.Description)
+
+	if n != "" && strings.Contains(text, n) {
+		klogger.Exit(method)
+		return 2
+	}
+
+	klogger.Exit(method)
+	return 0
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockMatchRecords() ([]*Income, []*Bill, []*CreditCard) {
+	i := Income{
+		ID:        1,
+		Name:      "Acme Corp",
+		Rate:      1800,
+		Type:      constants.IncomeTypeSalary,
+		Frequency: constants.IncomeFreqMonthly,
+		StartDt:   time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	i.PopulateEmptyValues(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	b1 := Bill{ID: 1, Name: "Rent", Amount: 1200, DueDt: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)}
+	b2 := Bill{ID: 2, Name: "Streaming", Amount: 15.99}
+
+	c := CreditCard{ID: 1, Name: "Visa"}
+
+	return []*Income{&i}, []*Bill{&b1, &b2}, []*CreditCard{&c}
+}
+
+func TestSuggestMatch(t *testing.T) {
+	method := "TransactionMatch_test.TestSuggestMatch"
+	klogger.Enter(method)
+
+	iarr, barr, carr := mockMatchRecords()
+
+	//Deposits match incomes by name
+	tr := BankAccountTransaction{Type: constants.TransactionTypeDeposit, Amount: 10, Payee: "ACME CORP PAYROLL", TransactionDt: time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC)}
+	tr.SuggestMatch(iarr, barr, carr)
+	assert.Equal(t, &TransactionMatch{Source: constants.TransactionSourceIncome, SourceID: 1, Name: "Acme Corp"}, tr.Suggestion)
+
+	//or by their net pay near a payday
+	tr = BankAccountTransaction{Type: constants.TransactionTypeDeposit, Amount: 1800, Payee: "DIRECT DEP", TransactionDt: time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC)}
+	tr.SuggestMatch(iarr, barr, carr)
+	assert.NotNil(t, tr.Suggestion)
+	assert.Equal(t, constants.TransactionSourceIncome, tr.Suggestion.Source)
+
+	tr.TransactionDt = time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC)
+	tr.SuggestMatch(iarr, barr, carr)
+	assert.Nil(t, tr.Suggestion)
+
+	//Withdrawals match bills by amount near a due date
+	tr = BankAccountTransaction{Type: constants.TransactionTypeWithdrawal, Amount: 1200, Payee: "OAK APARTMENTS", TransactionDt: time.Date(2024, 2, 6, 0, 0, 0, 0, time.UTC)}
+	tr.SuggestMatch(iarr, barr, carr)
+	assert.Equal(t, &TransactionMatch{Source: constants.TransactionSourceBill, SourceID: 1, Name: "Rent"}, tr.Suggestion)
+
+	tr.TransactionDt = time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC)
+	tr.SuggestMatch(iarr, barr, carr)
+	assert.Nil(t, tr.Suggestion)
+
+	//Bills without a due date match on any date
+	tr = BankAccountTransaction{Type: constants.TransactionTypeWithdrawal, Amount: 15.99, Payee: "STREAMFLIX", TransactionDt: time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC)}
+	tr.SuggestMatch(iarr, barr, carr)
+	assert.Equal(t, 2, tr.Suggestion.SourceID)
+
+	//Withdrawals match credit cards by name, and names outweigh amounts
+	tr = BankAccountTransaction{Type: constants.TransactionTypeWithdrawal, Amount: 15.99, Payee: "VISA REWARDS PAYMENT", TransactionDt: time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC)}
+	tr.SuggestMatch(iarr, barr, carr)
+	assert.Equal(t, &TransactionMatch{Source: constants.TransactionSourceCreditCard, SourceID: 1, Name: "Visa"}, tr.Suggestion)
+
+	//Deposits never match bills
+	tr.Type = constants.TransactionTypeDeposit
+	tr.SuggestMatch(iarr, barr, carr)
+	assert.Nil(t, tr.Suggestion)
+
+	klogger.Exit(method)
+}
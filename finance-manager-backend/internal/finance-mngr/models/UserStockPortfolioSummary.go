@@ -1,20 +1,30 @@
 package models
 
 import (
+	"finance-manager-backend/internal/finance-mngr/constants"
 	"math"
 	"time"
 
 	"github.com/jon-kamis/klogger"
 )
 
+// Type UserStockPortfolioSummary totals a user's positions. CostBasis and the unrealized gains only cover shares in open lots, and
+// RealizedGains includes the sales of stocks that are no longer held. Dividends are the dividends the user has been paid
 type UserStockPortfolioSummary struct {
-	CurrentValue float64             `json:"currentValue"`
-	CurrentHigh  float64             `json:"currentHigh"`
-	CurrentLow   float64             `json:"currentLow"`
-	CurrentOpen  float64             `json:"currentOpen"`
-	CurrentClose float64             `json:"currentClose"`
-	AsOfDate     time.Time           `json:"asOf"`
-	Positions    []PortfolioPosition `json:"positions"`
+	CurrentValue            float64             `json:"currentValue"`
+	CurrentHigh             float64             `json:"currentHigh"`
+	CurrentLow              float64             `json:"currentLow"`
+	CurrentOpen             float64             `json:"currentOpen"`
+	CurrentClose            float64             `json:"currentClose"`
+	CostBasis               float64             `json:"costBasis"`
+	UnrealizedGain          float64             `json:"unrealizedGain"`
+	ShortTermUnrealizedGain float64             `json:"shortTermUnrealizedGain"`
+	LongTermUnrealizedGain  float64             `json:"longTermUnrealizedGain"`
+	RealizedGains           []TaxYearGains      `json:"realizedGains"`
+	DividendIncome          float64             `json:"dividendIncome"`
+	Dividends               []DividendPayment   `json:"dividends"`
+	AsOfDate                time.Time           `json:"asOf"`
+	Positions               []PortfolioPosition `json:"positions"`
 }
 
 // Type PortfolioBalanceHistory Holds a record for the overall balance of the user's stocks for a given date
@@ -26,16 +36,24 @@ type PortfolioBalanceHistory struct {
 	Close float64   `json:"close"`
 }
 
-// Type PortfolioPosition holds values for a user's Stock
+// Type PortfolioPosition holds values for a user's Stock. Lots are the open lots of the stock, and shares recorded before purchases
+// were tracked are not in any lot and have no cost basis
 type PortfolioPosition struct {
-	Ticker   string    `json:"ticker"`
-	Quantity float64   `json:"quantity"`
-	Value    float64   `json:"value"`
-	Open     float64   `json:"open"`
-	Close    float64   `json:"close"`
-	High     float64   `json:"high"`
-	Low      float64   `json:"low"`
-	AsOfDate time.Time `json:"asOf"`
+	Ticker                  string         `json:"ticker"`
+	Quantity                float64        `json:"quantity"`
+	Value                   float64        `json:"value"`
+	Open                    float64        `json:"open"`
+	Close                   float64        `json:"close"`
+	High                    float64        `json:"high"`
+	Low                     float64        `json:"low"`
+	CostBasis               float64        `json:"costBasis"`
+	UnrealizedGain          float64        `json:"unrealizedGain"`
+	ShortTermUnrealizedGain float64        `json:"shortTermUnrealizedGain"`
+	LongTermUnrealizedGain  float64        `json:"longTermUnrealizedGain"`
+	RealizedGains           []TaxYearGains `json:"realizedGains"`
+	DividendIncome          float64        `json:"dividendIncome"`
+	Lots                    []StockLot     `json:"lots"`
+	AsOfDate                time.Time      `json:"asOf"`
 }
 
 // Type PositionHistory holds historic values for a Stock
@@ -63,6 +81,9 @@ func (u *UserStockPortfolioSummary) CalcDailyTotals() {
 	var o float64   //open
 	var c float64   //close
 	var t float64   //total
+	var cb float64  //cost basis
+	var st float64  //short-term unrealized gain
+	var lt float64  //long-term unrealized gain
 	d := time.Now() //as of date
 
 	for _, p := range u.Positions {
@@ -71,6 +92,9 @@ func (u *UserStockPortfolioSummary) CalcDailyTotals() {
 		o += (p.Open * p.Quantity)
 		c += (p.Close * p.Quantity)
 		t += p.Value
+		cb += p.CostBasis
+		st += p.ShortTermUnrealizedGain
+		lt += p.LongTermUnrealizedGain
 
 		if p.AsOfDate.Before(d) {
 			d = p.AsOfDate
@@ -82,6 +106,10 @@ func (u *UserStockPortfolioSummary) CalcDailyTotals() {
 	u.CurrentOpen = math.Round(o*100) / 100
 	u.CurrentClose = math.Round(c*100) / 100
 	u.CurrentValue = math.Round(t*100) / 100
+	u.CostBasis = math.Round(cb*100) / 100
+	u.ShortTermUnrealizedGain = math.Round(st*100) / 100
+	u.LongTermUnrealizedGain = math.Round(lt*100) / 100
+	u.UnrealizedGain = math.Round((st+lt)*100) / 100
 	u.AsOfDate = d
 
 	klogger.Exit(method)
@@ -97,3 +125,90 @@ func (u *UserStockPortfolioSummary) LoadPositions(p []PortfolioPosition) {
 
 	klogger.Exit(method)
 }
+
+// Function LoadRealizedGains totals the gains realized by all of the user's sales by tax year
+func (u *UserStockPortfolioSummary) LoadRealizedGains(garr []RealizedGain) {
+	method := "UserStockPortfolioSummary.LoadRealizedGains"
+	klogger.Enter(method)
+
+	u.RealizedGains = GetRealizedGainsByTaxYear(garr)
+
+	klogger.Exit(method)
+}
+
+// Function LoadLots loads the open lots and realized gains of the position's ticker, and values each lot at the position's close.
+// Lots held for more than a year on t are long-term
+func (p *PortfolioPosition) LoadLots(larr []*StockLot, garr []RealizedGain, t time.Time) {
+	method := "PortfolioPosition.LoadLots"
+	klogger.Enter(method)
+
+	p.Lots = []StockLot{}
+	p.CostBasis = 0
+	p.ShortTermUnrealizedGain = 0
+	p.LongTermUnrealizedGain = 0
+
+	for _, l := range larr {
+		if l.Ticker != p.Ticker {
+			continue
+		}
+
+		lot := *l
+		lot.Value = math.Round(p.Close*lot.Remaining*100) / 100
+		lot.UnrealizedGain = math.Round((lot.Value-lot.CostBasis)*100) / 100
+		lot.Term = getGainTerm(lot.AcquiredDt, t)
+
+		p.CostBasis += lot.CostBasis
+
+		if lot.Term == constants.GainTermLong {
+			p.LongTermUnrealizedGain += lot.UnrealizedGain
+		} else {
+			p.ShortTermUnrealizedGain += lot.UnrealizedGain
+		}
+
+		p.Lots = append(p.Lots, lot)
+	}
+
+	p.CostBasis = math.Round(p.CostBasis*100) / 100
+	p.ShortTermUnrealizedGain = math.Round(p.ShortTermUnrealizedGain*100) / 100
+	p.LongTermUnrealizedGain = math.Round(p.LongTermUnrealizedGain*100) / 100
+	p.UnrealizedGain = math.Round((p.ShortTermUnrealizedGain+p.LongTermUnrealizedGain)*100) / 100
+
+	var tgarr []RealizedGain
+	for _, g := range garr {
+		if g.Ticker == p.Ticker {
+			tgarr = append(tgarr, g)
+		}
+	}
+
+	p.RealizedGains = GetRealizedGainsByTaxYear(tgarr)
+
+	klogger.Exit(method)
+}
+
+// Function LoadDividends loads the dividends paid to the user on or before t and totals them
+func (u *UserStockPortfolioSummary) LoadDividends(parr []DividendPayment, t time.Time) {
+	method := "UserStockPortfolioSummary.LoadDividends"
+	klogger.Enter(method)
+
+	u.Dividends = []DividendPayment{}
+
+	for _, p := range parr {
+		if !p.PayDt.After(t) {
+			u.Dividends = append(u.Dividends, p)
+		}
+	}
+
+	u.DividendIncome = GetDividendIncome("", parr, t)
+
+	klogger.Exit(method)
+}
+
+// Function LoadDividends totals the dividends of the position's ticker paid to the user on or before t
+func (p *PortfolioPosition) LoadDividends(parr []DividendPayment, t time.Time) {
+	method := "PortfolioPosition.LoadDividends"
+	klogger.Enter(method)
+
+	p.DividendIncome = GetDividendIncome(p.Ticker, parr, t)
+
+	klogger.Exit(method)
+}
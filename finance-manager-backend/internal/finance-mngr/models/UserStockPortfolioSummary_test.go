@@ -1,6 +1,7 @@
 package models
 
 import (
+	"finance-manager-backend/internal/finance-mngr/constants"
 	"testing"
 	"time"
 
@@ -62,3 +63,70 @@ func TestLoadPositions(t *testing.T) {
 
 	klogger.Enter(method)
 }
+
+func TestLoadLots(t *testing.T) {
+	method := "UserStockPortfolioSummary_test.TestLoadLots"
+	klogger.Enter(method)
+
+	lots, garr, err := BuildStockLots(append(mockStockTransactions(), mockStockSale(constants.LotMethodFIFO)), nil)
+	assert.Nil(t, err)
+
+	p := PortfolioPosition{Ticker: "AAPL", Quantity: 15, Close: 200}
+	p.LoadLots(lots, garr, time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC))
+
+	//Lot 1 was held for more than a year and lot 2 was not
+	assert.Equal(t, 2, len(p.Lots))
+	assert.Equal(t, constants.GainTermLong, p.Lots[0].Term)
+	assert.Equal(t, 1000.0, p.Lots[0].Value)
+	assert.Equal(t, 495.0, p.Lots[0].UnrealizedGain)
+	assert.Equal(t, constants.GainTermShort, p.Lots[1].Term)
+	assert.Equal(t, 500.0, p.Lots[1].UnrealizedGain)
+
+	assert.Equal(t, 2005.0, p.CostBasis)
+	assert.Equal(t, 495.0, p.LongTermUnrealizedGain)
+	assert.Equal(t, 500.0, p.ShortTermUnrealizedGain)
+	assert.Equal(t, 995.0, p.UnrealizedGain)
+	assert.Equal(t, []TaxYearGains{{Year: 2023, LongTerm: 485, Total: 485}}, p.RealizedGains)
+
+	//Totals include the cost basis and gains of each position
+	m := PortfolioPosition{Ticker: "MSFT", Quantity: 2, Close: 40}
+	m.LoadLots(lots, garr, time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, 1, len(m.Lots))
+	assert.Equal(t, -20.0, m.LongTermUnrealizedGain)
+	assert.Equal(t, 0, len(m.RealizedGains))
+
+	var sum UserStockPortfolioSummary
+	sum.LoadRealizedGains(garr)
+	sum.LoadPositions([]PortfolioPosition{p, m})
+	assert.Equal(t, 2105.0, sum.CostBasis)
+	assert.Equal(t, 475.0, sum.LongTermUnrealizedGain)
+	assert.Equal(t, 500.0, sum.ShortTermUnrealizedGain)
+	assert.Equal(t, 975.0, sum.UnrealizedGain)
+	assert.Equal(t, 1, len(sum.RealizedGains))
+
+	klogger.Exit(method)
+}
+
+func TestLoadDividends_portfolio(t *testing.T) {
+	method := "UserStockPortfolioSummary_test.TestLoadDividends_portfolio"
+	klogger.Enter(method)
+
+	parr := GetDividendPayments(mockDividendUserStocks(), mockStockDividends())
+	d := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	p := PortfolioPosition{Ticker: "AAPL"}
+	p.LoadDividends(parr, d)
+	assert.Equal(t, 2.4, p.DividendIncome)
+
+	//Dividends are only included once they are paid
+	var sum UserStockPortfolioSummary
+	sum.LoadDividends(parr, d)
+	assert.Equal(t, 2.4, sum.DividendIncome)
+	assert.Equal(t, 1, len(sum.Dividends))
+
+	sum.LoadDividends(parr, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, 7.4, sum.DividendIncome)
+	assert.Equal(t, 2, len(sum.Dividends))
+
+	klogger.Exit(method)
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"math"
 	"testing"
 
 	"github.com/jon-kamis/klogger"
@@ -54,3 +55,85 @@ func TestCalcPayment(t *testing.T) {
 	assert.Equal(t, 100.0, cc.Payment)
 
 }
+
+func TestCalcPayoff(t *testing.T) {
+	method := "creditcard_test.TestCalcPayoff"
+	klogger.Enter(method)
+
+	cc := CreditCard{
+		Balance:              1000,
+		APR:                  12,
+		MinPayment:           25,
+		MinPaymentPercentage: 2,
+	}
+
+	//Minimum payments only
+	m, err := cc.CalcPayoff(0)
+	assert.Nil(t, err)
+	assert.Equal(t, 25.0, m.Payment)
+	assert.Equal(t, m.Months, len(m.PaymentSchedule))
+	assert.Equal(t, 10.0, m.PaymentSchedule[0].Interest)
+	assert.Equal(t, 0.0, m.PaymentSchedule[m.Months-1].RemainingBalance)
+	assert.Equal(t, math.Round((cc.Balance+m.TotalInterest)*100)/100, math.Round(m.TotalPayment*100)/100)
+
+	//Fixed payments pay the card off sooner with less interest
+	f, err := cc.CalcPayoff(100)
+	assert.Nil(t, err)
+	assert.Equal(t, 11, f.Months)
+	assert.Less(t, f.Months, m.Months)
+	assert.Less(t, f.TotalInterest, m.TotalInterest)
+
+	//A payment that never covers interest cannot be paid off
+	cc.MinPayment = 1
+	cc.MinPaymentPercentage = 0
+	_, err = cc.CalcPayoff(5)
+	assert.NotNil(t, err)
+
+	//Negative values are invalid
+	cc.APR = -1
+	_, err = cc.CalcPayoff(0)
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
+
+func TestCalcProjection(t *testing.T) {
+	method := "creditcard_test.TestCalcProjection"
+	klogger.Enter(method)
+
+	cc := CreditCard{
+		Balance:              1000,
+		APR:                  12,
+		MinPayment:           25,
+		MinPaymentPercentage: 2,
+	}
+
+	p, err := cc.CalcProjection(0)
+	assert.Nil(t, err)
+	assert.Nil(t, p.FixedPayment)
+	assert.Greater(t, p.MinimumPayment.Months, 0)
+
+	p, err = cc.CalcProjection(100)
+	assert.Nil(t, err)
+	assert.NotNil(t, p.FixedPayment)
+	assert.Equal(t, 100.0, p.FixedPayment.Payment)
+
+	klogger.Exit(method)
+}
+
+func TestGetProjectedBalance_creditCard(t *testing.T) {
+	method := "creditcard_test.TestGetProjectedBalance_creditCard"
+	klogger.Enter(method)
+
+	cc := CreditCard{
+		Balance:    1000,
+		APR:        12,
+		MinPayment: 110,
+	}
+
+	assert.Equal(t, 1000.0, cc.GetProjectedBalance(0))
+	assert.Equal(t, 900.0, cc.GetProjectedBalance(1))
+	assert.Equal(t, 0.0, cc.GetProjectedBalance(24))
+
+	klogger.Exit(method)
+}
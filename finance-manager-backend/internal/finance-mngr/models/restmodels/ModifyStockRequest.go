@@ -3,6 +3,8 @@ package restmodels
 import (
 	"finance-manager-backend/internal/finance-mngr/constants"
 	"finance-manager-backend/internal/finance-mngr/enums/stockoperation"
+	"math"
+	"slices"
 	"time"
 
 	"github.com/jon-kamis/klogger"
@@ -21,6 +23,24 @@ type ModifyStockRequest struct {
 
 	//Date of operation
 	Date time.Time `json:"date"`
+
+	//Price paid or received per share. Must be greater than 0
+	Price float64 `json:"price"`
+
+	//Commissions and fees paid for the whole operation
+	Fees float64 `json:"fees"`
+
+	//How the lots a remove operation is taken from are chosen. Options are 'fifo', 'lifo' and 'specific-id'. Default is 'fifo'
+	LotMethod string `json:"lotMethod"`
+
+	//The lots a specific-id remove operation is taken from
+	Lots []StockLotSelection `json:"lots"`
+}
+
+// Type StockLotSelection selects Quantity shares from the lot bought by the stock transaction LotID
+type StockLotSelection struct {
+	LotID    int     `json:"lotId"`
+	Quantity float64 `json:"quantity"`
 }
 
 func (m *ModifyStockRequest) IsValidRequest() (bool, string) {
@@ -50,6 +70,45 @@ func (m *ModifyStockRequest) IsValidRequest() (bool, string) {
 		isValid = false
 	}
 
+	if m.Price <= 0 {
+		msg = constants.StockOperationInvalidPriceError
+		isValid = false
+	}
+
+	if m.Fees < 0 {
+		msg = constants.StockOperationInvalidFeesError
+		isValid = false
+	}
+
+	if m.LotMethod != "" && !slices.Contains(constants.ValidLotMethods, m.LotMethod) {
+		msg = constants.StockOperationInvalidLotMethodError
+		isValid = false
+	}
+
+	if len(m.Lots) > 0 && (m.Operation != stockoperation.Remove || m.LotMethod != constants.LotMethodSpecificID) {
+		msg = constants.StockOperationLotsNotAllowedError
+		isValid = false
+	}
+
+	if m.Operation == stockoperation.Remove && m.LotMethod == constants.LotMethodSpecificID {
+		selected := 0.0
+		validLots := true
+
+		for _, l := range m.Lots {
+			selected += l.Quantity
+
+			if l.LotID <= 0 || l.Quantity <= 0 {
+				validLots = false
+			}
+		}
+
+		//Quantities are stored to four decimal places
+		if !validLots || math.Abs(selected-m.Amount) > 0.00005 {
+			msg = constants.StockOperationLotsRequiredError
+			isValid = false
+		}
+	}
+
 	klogger.Exit(method)
 	return isValid, msg
 }
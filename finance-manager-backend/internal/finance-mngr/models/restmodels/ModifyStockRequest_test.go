@@ -17,6 +17,7 @@ func TestIsValidRequest(t *testing.T) {
 	r := ModifyStockRequest{
 		Ticker:    "AAPL",
 		Amount:    5,
+		Price:     100,
 		Operation: stockoperation.Add,
 		Date:      time.Now(),
 	}
@@ -62,3 +63,85 @@ func TestIsValidRequest(t *testing.T) {
 
 	klogger.Exit(method)
 }
+
+func TestIsValidRequest_lots(t *testing.T) {
+	method := "ModifyStockRequest_test.TestIsValidRequest_lots"
+	klogger.Enter(method)
+
+	r := ModifyStockRequest{
+		Ticker:    "AAPL",
+		Amount:    5,
+		Price:     100,
+		Fees:      1,
+		Operation: stockoperation.Remove,
+		LotMethod: constants.LotMethodSpecificID,
+		Lots:      []StockLotSelection{{LotID: 1, Quantity: 2}, {LotID: 2, Quantity: 3}},
+		Date:      time.Now(),
+	}
+
+	var r1 ModifyStockRequest
+	var v bool
+	var m string
+
+	v, m = r.IsValidRequest()
+	assert.True(t, v)
+	assert.Equal(t, "", m)
+
+	r1 = r
+	r1.Price = -1
+	v, m = r1.IsValidRequest()
+	assert.False(t, v)
+	assert.Equal(t, constants.StockOperationInvalidPriceError, m)
+
+	//Sales must have a price too
+	r1 = r
+	r1.Price = 0
+	v, m = r1.IsValidRequest()
+	assert.False(t, v)
+	assert.Equal(t, constants.StockOperationInvalidPriceError, m)
+
+	r1 = r
+	r1.Fees = -1
+	v, m = r1.IsValidRequest()
+	assert.False(t, v)
+	assert.Equal(t, constants.StockOperationInvalidFeesError, m)
+
+	r1 = r
+	r1.LotMethod = "Invalid"
+	r1.Lots = nil
+	v, m = r1.IsValidRequest()
+	assert.False(t, v)
+	assert.Equal(t, constants.StockOperationInvalidLotMethodError, m)
+
+	r1 = r
+	r1.Operation = stockoperation.Add
+	v, m = r1.IsValidRequest()
+	assert.False(t, v)
+	assert.Equal(t, constants.StockOperationLotsNotAllowedError, m)
+
+	r1 = r
+	r1.LotMethod = constants.LotMethodFIFO
+	v, m = r1.IsValidRequest()
+	assert.False(t, v)
+	assert.Equal(t, constants.StockOperationLotsNotAllowedError, m)
+
+	r1 = r
+	r1.Lots = nil
+	v, m = r1.IsValidRequest()
+	assert.False(t, v)
+	assert.Equal(t, constants.StockOperationLotsRequiredError, m)
+
+	r1 = r
+	r1.Lots = []StockLotSelection{{LotID: 1, Quantity: 2}}
+	v, m = r1.IsValidRequest()
+	assert.False(t, v)
+	assert.Equal(t, constants.StockOperationLotsRequiredError, m)
+
+	r1 = r
+	r1.Lots = []StockLotSelection{{LotID: 1, Quantity: 5}, {LotID: 2, Quantity: 0}}
+	v, m = r1.IsValidRequest()
+	assert.False(t, v)
+	assert.Equal(t, constants.StockOperationLotsRequiredError, m)
+
+	klogger.Exit(method)
+}
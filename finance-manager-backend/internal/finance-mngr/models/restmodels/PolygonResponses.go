@@ -20,3 +20,38 @@ type AggResponse struct {
 	Status       string            `json:"status"`
 	Ticker       string            `json:"ticker"`
 }
+
+type SplitResponseItem struct {
+	Id            string  `json:"id"`
+	ExecutionDate string  `json:"execution_date"`
+	SplitFrom     float64 `json:"split_from"`
+	SplitTo       float64 `json:"split_to"`
+	Ticker        string  `json:"ticker"`
+}
+
+type SplitResponse struct {
+	NextUrl   string              `json:"next_url"`
+	RequestId string              `json:"request_id"`
+	Results   []SplitResponseItem `json:"results"`
+	Status    string              `json:"status"`
+}
+
+type DividendResponseItem struct {
+	Id              string  `json:"id"`
+	CashAmount      float64 `json:"cash_amount"`
+	Currency        string  `json:"currency"`
+	DeclarationDate string  `json:"declaration_date"`
+	DividendType    string  `json:"dividend_type"`
+	ExDividendDate  string  `json:"ex_dividend_date"`
+	Frequency       int     `json:"frequency"`
+	PayDate         string  `json:"pay_date"`
+	RecordDate      string  `json:"record_date"`
+	Ticker          string  `json:"ticker"`
+}
+
+type DividendResponse struct {
+	NextUrl   string                 `json:"next_url"`
+	RequestId string                 `json:"request_id"`
+	Results   []DividendResponseItem `json:"results"`
+	Status    string                 `json:"status"`
+}
@@ -2,19 +2,28 @@ package restmodels
 
 import (
 	"errors"
+	"finance-manager-backend/internal/finance-mngr/enums/compounding"
 	"finance-manager-backend/internal/finance-mngr/enums/payfrequency"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
 	"math"
 	"time"
 
 	"github.com/jon-kamis/klogger"
 )
 
+// Type SavingsCalculationRequest calculates how much must be saved each pay to reach a goal by a deadline. When APY is set
+// the balance earns interest credited at the Compounding frequency, which defaults to monthly. Pays are scheduled from NextPay
+// by PayFrequency unless PayDates are given
 type SavingsCalculationRequest struct {
-	Goal         float64                   `json:"goal"`
-	Amount       float64                   `json:"amount"`
-	Deadline     time.Time                 `json:"deadline"`
-	PayFrequency payfrequency.PayFrequency `json:"payFrequency"`
-	NextPay      time.Time                 `json:"nextPay"`
+	Goal            float64                   `json:"goal"`
+	Amount          float64                   `json:"amount"`
+	Deadline        time.Time                 `json:"deadline"`
+	PayFrequency    payfrequency.PayFrequency `json:"payFrequency"`
+	NextPay         time.Time                 `json:"nextPay"`
+	StartingBalance float64                   `json:"startingBalance"`
+	APY             float64                   `json:"apy"`
+	Compounding     compounding.Compounding   `json:"compounding"`
+	PayDates        []time.Time               `json:"-"`
 }
 
 func (s *SavingsCalculationRequest) Calculate() (SavingsCalculationResponse, error) {
@@ -41,20 +50,54 @@ func (s *SavingsCalculationRequest) Calculate() (SavingsCalculationResponse, err
 		return r, err
 	}
 
+	if s.StartingBalance < 0 {
+		err := errors.New("starting balance cannot be negative")
+		klogger.ExitError(method, err.Error())
+		return r, err
+	}
+
+	if s.APY < 0 {
+		err := errors.New("apy cannot be negative")
+		klogger.ExitError(method, err.Error())
+		return r, err
+	}
+
+	if s.Compounding != compounding.Undefined && compounding.GetCompounding(string(s.Compounding)) == compounding.Undefined {
+		err := errors.New("compounding must be one of daily, monthly, quarterly or annual")
+		klogger.ExitError(method, err.Error())
+		return r, err
+	}
+
 	r.Deadline = s.Deadline
 	r.ManPerPay = s.Amount
+	r.StartingBalance = s.StartingBalance
 
-	//Get number of pays
-	r.NumPays = s.GetPaysBeforeDeadline()
+	//Get pay dates
+	payDates := s.GetPayDatesBeforeDeadline()
+	r.NumPays = len(payDates)
 	pays := float64(r.NumPays)
 
+	//The balance at the deadline is the starting balance grown by interest plus each contribution grown from its payday,
+	//so it is found by projecting the starting balance alone and a contribution of one dollar per pay alone
+	start := s.project(s.StartingBalance, 0, payDates)
+	unit := s.project(0, 1, payDates)
+
 	if s.Goal != 0.0 {
 		r.Goal = s.Goal
-		r.PerPay = (math.Round((s.Goal / pays) * 100)) / 100
+		needed := math.Max(s.Goal-start, 0)
+
+		//Whatever the starting balance does not cover is due now when there are no pays before the deadline
+		if unit > 0 {
+			r.PerPay = math.Round((needed/unit)*100) / 100
+			r.GoalInterest = math.Round((start+r.PerPay*unit-s.StartingBalance-r.PerPay*pays)*100) / 100
+		} else {
+			r.PerPay = math.Round(needed*100) / 100
+		}
 	}
 
 	if s.Amount > 0 {
-		r.Actual = pays * s.Amount
+		r.Actual = math.Round((start+s.Amount*unit)*100) / 100
+		r.Interest = math.Round((r.Actual-s.StartingBalance-s.Amount*pays)*100) / 100
 	}
 
 	klogger.Exit(method)
@@ -65,10 +108,26 @@ func (r *SavingsCalculationRequest) GetPaysBeforeDeadline() int {
 	method := "SavingsCalculationRequest.GetPaysBeforeDeadline"
 	klogger.Enter(method)
 
+	numPays := len(r.GetPayDatesBeforeDeadline())
+
+	klogger.Exit(method)
+	return numPays
+}
+
+// Function GetPayDatesBeforeDeadline returns the date of each pay from NextPay through the deadline in order, or PayDates if they are given
+func (r *SavingsCalculationRequest) GetPayDatesBeforeDeadline() []time.Time {
+	method := "SavingsCalculationRequest.GetPayDatesBeforeDeadline"
+	klogger.Enter(method)
+
+	if r.PayDates != nil {
+		klogger.Exit(method)
+		return r.PayDates
+	}
+
 	if r.Deadline.Before(time.Now()) {
 		klogger.Info(method, "deadline is a past date")
 		klogger.Exit(method)
-		return 0
+		return nil
 	}
 
 	dt := r.NextPay
@@ -76,35 +135,91 @@ func (r *SavingsCalculationRequest) GetPaysBeforeDeadline() int {
 	if dt.After(r.Deadline) {
 		klogger.Info(method, "next pay is after deadline")
 		klogger.Exit(method)
-		return 0
+		return nil
 	}
 
-	numPays := 0
+	//Count pays on the same schedule incomes are paid on, so semi-monthly pays land on the 15th and last day of the month and pays
+	//that fall on a weekend arrive the Friday before. The range starts on the day of the first pay so it is counted when it is moved
+	rec := fmUtil.Recurrence{
+		Frequency:    r.PayFrequency.String(),
+		StartDt:      dt,
+		BusinessDays: true,
+	}
 
-	if r.PayFrequency == payfrequency.Monthly {
+	dates := rec.GetDatesBetween(fmUtil.GetPreviousBusinessDay(fmUtil.GetStartOfDay(dt)), r.Deadline)
 
-		for !dt.After(r.Deadline) {
-			numPays++
-			dt = dt.AddDate(0, 1, 0)
-		}
+	klogger.Exit(method)
+	return dates
+}
+
+// Function project returns the balance at the deadline when starting with balance b today and saving c on each of the pay dates.
+// Interest accrues daily on the balance at the periodic rate equivalent to the APY and is credited at the end of each
+// compounding period, so interest accrued in a period that has not ended by the deadline is not included
+func (r *SavingsCalculationRequest) project(b float64, c float64, payDates []time.Time) float64 {
+	method := "SavingsCalculationRequest.project"
+	klogger.Enter(method)
 
+	if r.APY == 0 {
 		klogger.Exit(method)
-		return numPays
-	} else if r.PayFrequency == payfrequency.Weekly {
-		for !dt.After(r.Deadline) {
-			numPays++
-			dt = dt.AddDate(0, 0, 7)
+		return b + c*float64(len(payDates))
+	}
+
+	n := r.Compounding.PeriodsPerYear()
+	rate := math.Pow(1+r.APY/100, 1/float64(n)) - 1
+
+	dt := fmUtil.GetStartOfDay(time.Now())
+	origin := dt
+	period := 1
+	periodStart := dt
+	periodEnd := r.getCompoundingDate(origin, period)
+	accrued := 0.0
+	k := 0
+
+	for !dt.After(r.Deadline) {
+		next := dt.AddDate(0, 0, 1)
+
+		//Contributions are made at the start of their payday
+		for k < len(payDates) && payDates[k].Before(next) {
+			b += c
+			k++
 		}
 
-		klogger.Exit(method)
-		return numPays
-	} else {
-		for !dt.After(r.Deadline) {
-			numPays++
-			dt = dt.AddDate(0, 0, 14)
+		days := periodEnd.Sub(periodStart).Hours() / 24
+		accrued += b * rate / math.Round(days)
+
+		if !next.Before(periodEnd) {
+			b += accrued
+			accrued = 0
+			period++
+			periodStart = periodEnd
+			periodEnd = r.getCompoundingDate(origin, period)
 		}
 
-		klogger.Exit(method)
-		return numPays
+		dt = next
 	}
+
+	klogger.Exit(method)
+	return b
+}
+
+// Function getCompoundingDate returns the date interest is credited for the nth time after t
+func (r *SavingsCalculationRequest) getCompoundingDate(t time.Time, n int) time.Time {
+	method := "SavingsCalculationRequest.getCompoundingDate"
+	klogger.Enter(method)
+
+	var dt time.Time
+
+	switch r.Compounding {
+	case compounding.Daily:
+		dt = t.AddDate(0, 0, n)
+	case compounding.Quarterly:
+		dt = fmUtil.AddMonths(t, 3*n)
+	case compounding.Annual:
+		dt = fmUtil.AddMonths(t, 12*n)
+	default:
+		dt = fmUtil.AddMonths(t, n)
+	}
+
+	klogger.Exit(method)
+	return dt
 }
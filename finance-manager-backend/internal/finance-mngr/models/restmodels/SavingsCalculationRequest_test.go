@@ -0,0 +1,180 @@
+package restmodels
+
+import (
+	"finance-manager-backend/internal/finance-mngr/enums/compounding"
+	"finance-manager-backend/internal/finance-mngr/enums/payfrequency"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPaysBeforeDeadline(t *testing.T) {
+	method := "SavingsCalculationRequest_test.TestGetPaysBeforeDeadline"
+	klogger.Enter(method)
+
+	r := SavingsCalculationRequest{
+		Deadline:     time.Date(2030, 3, 31, 0, 0, 0, 0, time.UTC),
+		PayFrequency: payfrequency.SemiMonthly,
+		NextPay:      time.Date(2030, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	//March 31st 2030 is a Sunday so its pay arrives before the deadline on the Friday before
+	assert.Equal(t, 6, r.GetPaysBeforeDeadline())
+
+	r.PayFrequency = payfrequency.Monthly
+	assert.Equal(t, 3, r.GetPaysBeforeDeadline())
+
+	r.Deadline = time.Date(2030, 12, 31, 0, 0, 0, 0, time.UTC)
+	r.PayFrequency = payfrequency.Quarterly
+	assert.Equal(t, 4, r.GetPaysBeforeDeadline())
+
+	r.PayFrequency = payfrequency.Annual
+	assert.Equal(t, 1, r.GetPaysBeforeDeadline())
+
+	//Deadlines in the past have no pays
+	r.Deadline = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 0, r.GetPaysBeforeDeadline())
+
+	klogger.Exit(method)
+}
+
+func TestGetPayDatesBeforeDeadline_weekend(t *testing.T) {
+	method := "SavingsCalculationRequest_test.TestGetPayDatesBeforeDeadline_weekend"
+	klogger.Enter(method)
+
+	//March 3rd and 31st 2030 are Sundays so every weekly pay arrives on the Friday before
+	r := SavingsCalculationRequest{
+		Deadline:     time.Date(2030, 3, 31, 0, 0, 0, 0, time.UTC),
+		PayFrequency: payfrequency.Weekly,
+		NextPay:      time.Date(2030, 3, 3, 0, 0, 0, 0, time.UTC),
+	}
+
+	dates := r.GetPayDatesBeforeDeadline()
+	assert.Equal(t, 5, len(dates))
+	assert.Equal(t, time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC), dates[0])
+	assert.Equal(t, time.Date(2030, 3, 29, 0, 0, 0, 0, time.UTC), dates[4])
+
+	r.PayFrequency = payfrequency.BiWeekly
+	dates = r.GetPayDatesBeforeDeadline()
+	assert.Equal(t, 3, len(dates))
+	assert.Equal(t, time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC), dates[0])
+
+	//The first pay is counted when it is moved before NextPay
+	r.PayFrequency = payfrequency.Monthly
+	r.NextPay = time.Date(2030, 3, 31, 0, 0, 0, 0, time.UTC)
+	r.Deadline = time.Date(2030, 5, 31, 0, 0, 0, 0, time.UTC)
+	dates = r.GetPayDatesBeforeDeadline()
+	assert.Equal(t, 3, len(dates))
+	assert.Equal(t, time.Date(2030, 3, 29, 0, 0, 0, 0, time.UTC), dates[0])
+	assert.Equal(t, time.Date(2030, 4, 30, 0, 0, 0, 0, time.UTC), dates[1])
+	assert.Equal(t, time.Date(2030, 5, 31, 0, 0, 0, 0, time.UTC), dates[2])
+
+	r.PayFrequency = payfrequency.SemiMonthly
+	r.Deadline = time.Date(2030, 4, 15, 0, 0, 0, 0, time.UTC)
+	dates = r.GetPayDatesBeforeDeadline()
+	assert.Equal(t, 2, len(dates))
+	assert.Equal(t, time.Date(2030, 3, 29, 0, 0, 0, 0, time.UTC), dates[0])
+
+	klogger.Exit(method)
+}
+
+func TestCalculate(t *testing.T) {
+	method := "SavingsCalculationRequest_test.TestCalculate"
+	klogger.Enter(method)
+
+	today := fmUtil.GetStartOfDay(time.Now())
+
+	r := SavingsCalculationRequest{
+		Goal:         1200,
+		Amount:       100,
+		Deadline:     fmUtil.AddMonths(today, 12),
+		PayFrequency: payfrequency.Monthly,
+		NextPay:      fmUtil.AddMonths(today, 1),
+	}
+
+	//Without an apy no interest is earned
+	resp, err := r.Calculate()
+	assert.Nil(t, err)
+	assert.Equal(t, 12, resp.NumPays)
+	assert.Equal(t, 100.0, resp.PerPay)
+	assert.Equal(t, 1200.0, resp.Actual)
+	assert.Equal(t, 0.0, resp.Interest)
+	assert.Equal(t, 0.0, resp.GoalInterest)
+
+	//Interest earned lowers what must be saved each pay to reach the goal
+	r.APY = 5
+	resp, err = r.Calculate()
+	assert.Nil(t, err)
+	assert.Less(t, resp.PerPay, 100.0)
+	assert.Greater(t, resp.Interest, 0.0)
+	assert.Greater(t, resp.GoalInterest, 0.0)
+	assert.InDelta(t, 1200+resp.Interest, resp.Actual, 0.005)
+
+	//Saving the per pay amount reaches the goal
+	r.Amount = resp.PerPay
+	resp, err = r.Calculate()
+	assert.Nil(t, err)
+	assert.InDelta(t, 1200, resp.Actual, 0.12)
+
+	//A starting balance earns the apy over a year no matter how often interest is credited
+	r = SavingsCalculationRequest{
+		Goal:            1050,
+		Deadline:        fmUtil.AddMonths(today, 12),
+		PayFrequency:    payfrequency.Monthly,
+		NextPay:         fmUtil.AddMonths(today, 1),
+		StartingBalance: 1000,
+		APY:             5,
+	}
+
+	for _, c := range []compounding.Compounding{compounding.Monthly, compounding.Quarterly, compounding.Annual} {
+		r.Compounding = c
+		resp, err = r.Calculate()
+		assert.Nil(t, err)
+		assert.Equal(t, 0.0, resp.PerPay)
+		assert.Equal(t, 1000.0, resp.StartingBalance)
+		assert.Equal(t, 50.0, resp.GoalInterest)
+	}
+
+	//Interest accrued in a period that has not ended by the deadline is not credited
+	r.Compounding = compounding.Annual
+	r.Deadline = fmUtil.AddMonths(today, 11)
+	r.Goal = 1000
+	r.Amount = 10
+	resp, err = r.Calculate()
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, resp.Interest)
+	assert.Equal(t, 1110.0, resp.Actual)
+
+	klogger.Exit(method)
+}
+
+func TestCalculate_invalid(t *testing.T) {
+	method := "SavingsCalculationRequest_test.TestCalculate_invalid"
+	klogger.Enter(method)
+
+	r := SavingsCalculationRequest{
+		Goal:         1200,
+		Deadline:     time.Date(2099, 12, 31, 0, 0, 0, 0, time.UTC),
+		PayFrequency: payfrequency.Monthly,
+		NextPay:      time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC),
+		APY:          -1,
+	}
+
+	_, err := r.Calculate()
+	assert.Equal(t, "apy cannot be negative", err.Error())
+
+	r.APY = 4
+	r.StartingBalance = -1
+	_, err = r.Calculate()
+	assert.Equal(t, "starting balance cannot be negative", err.Error())
+
+	r.StartingBalance = 0
+	r.Compounding = "hourly"
+	_, err = r.Calculate()
+	assert.Equal(t, "compounding must be one of daily, monthly, quarterly or annual", err.Error())
+
+	klogger.Exit(method)
+}
@@ -2,11 +2,16 @@ package restmodels
 
 import "time"
 
+// Type SavingsCalculationResponse is the result of a SavingsCalculationRequest. Interest is what the balance earns by the
+// deadline when ManPerPay is saved each pay and GoalInterest is what it earns when PerPay is saved each pay
 type SavingsCalculationResponse struct {
-	Goal      float64   `json:"goal"`
-	PerPay    float64   `json:"perPay"`
-	ManPerPay float64   `json:"manPerPay"`
-	Actual    float64   `json:"actual"`
-	NumPays   int       `json:"numPays"`
-	Deadline  time.Time `json:"deadline"`
+	Goal            float64   `json:"goal"`
+	PerPay          float64   `json:"perPay"`
+	ManPerPay       float64   `json:"manPerPay"`
+	Actual          float64   `json:"actual"`
+	NumPays         int       `json:"numPays"`
+	Deadline        time.Time `json:"deadline"`
+	StartingBalance float64   `json:"startingBalance"`
+	Interest        float64   `json:"interest"`
+	GoalInterest    float64   `json:"goalInterest"`
 }
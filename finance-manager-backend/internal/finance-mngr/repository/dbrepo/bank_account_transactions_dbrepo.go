@@ -0,0 +1,332 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func (m *PostgresDBRepo) GetAllBankAccountTransactionsByBankAccountID(accountId int) ([]*models.BankAccountTransaction, error) {
+	method := "bank_account_transactions_dbrepo.GetAllBankAccountTransactionsByBankAccountID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, bank_account_id, user_id, type, amount, transaction_dt, description, transfer_account_id, source, source_id,
+			payee, external_id, category, create_dt, last_update_dt
+		FROM bank_account_transactions
+		WHERE
+			bank_account_id = $1
+		ORDER BY transaction_dt, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, accountId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	transactions, err := scanBankAccountTransactions(rows)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(transactions))
+	klogger.Exit(method)
+	return transactions, nil
+}
+
+func (m *PostgresDBRepo) GetAllUserBankAccountTransactions(userId int) ([]*models.BankAccountTransaction, error) {
+	method := "bank_account_transactions_dbrepo.GetAllUserBankAccountTransactions"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, bank_account_id, user_id, type, amount, transaction_dt, description, transfer_account_id, source, source_id,
+			payee, external_id, category, create_dt, last_update_dt
+		FROM bank_account_transactions
+		WHERE
+			user_id = $1
+		ORDER BY transaction_dt, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	transactions, err := scanBankAccountTransactions(rows)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(transactions))
+	klogger.Exit(method)
+	return transactions, nil
+}
+
+func (m *PostgresDBRepo) GetBankAccountTransactionByID(id int) (models.BankAccountTransaction, error) {
+	method := "bank_account_transactions_dbrepo.GetBankAccountTransactionByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, bank_account_id, user_id, type, amount, transaction_dt, description, transfer_account_id, source, source_id,
+			payee, external_id, category, create_dt, last_update_dt
+		FROM bank_account_transactions
+		WHERE
+			id = $1`
+
+	var t models.BankAccountTransaction
+	row := m.DB.QueryRowContext(ctx, query, id)
+
+	err := row.Scan(
+		&t.ID,
+		&t.BankAccountID,
+		&t.UserID,
+		&t.Type,
+		&t.Amount,
+		&t.TransactionDt,
+		&t.Description,
+		&t.TransferAccountID,
+		&t.Source,
+		&t.SourceID,
+		&t.Payee,
+		&t.ExternalID,
+		&t.Category,
+		&t.CreateDt,
+		&t.LastUpdateDt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			klogger.Info(method, constants.NoRowsReturnedMsg)
+			klogger.Exit(method)
+			return t, nil
+		} else {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return t, err
+		}
+	}
+
+	klogger.Exit(method)
+	return t, nil
+}
+
+// Function InsertBankAccountTransaction saves transaction t. A transaction is not saved when its account already has one with the same
+// ExternalID, in which case 0 is returned
+func (m *PostgresDBRepo) InsertBankAccountTransaction(t models.BankAccountTransaction) (int, error) {
+	method := "bank_account_transactions_dbrepo.InsertBankAccountTransaction"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`INSERT INTO bank_account_transactions
+			(bank_account_id, user_id, type, amount, transaction_dt, description, transfer_account_id, source, source_id,
+			payee, external_id, category, create_dt, last_update_dt)
+		values
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		on conflict do nothing returning id`
+
+	var id int
+	err := m.DB.QueryRowContext(ctx, stmt,
+		t.BankAccountID,
+		t.UserID,
+		t.Type,
+		t.Amount,
+		t.TransactionDt,
+		t.Description,
+		t.TransferAccountID,
+		t.Source,
+		t.SourceID,
+		t.Payee,
+		t.ExternalID,
+		t.Category,
+		time.Now(),
+		time.Now(),
+	).Scan(&id)
+
+	if err == sql.ErrNoRows {
+		klogger.Info(method, "transaction %s has already been imported into bank account %d", t.ExternalID, t.BankAccountID)
+		klogger.Exit(method)
+		return 0, nil
+	}
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+// Function UpdateBankAccountTransactionCategory saves the category and the income, bill or credit card link of a Bank Account Transaction
+func (m *PostgresDBRepo) UpdateBankAccountTransactionCategory(t models.BankAccountTransaction) error {
+	method := "bank_account_transactions_dbrepo.UpdateBankAccountTransactionCategory"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`UPDATE bank_account_transactions
+		SET
+			category = $2,
+			source = $3,
+			source_id = $4,
+			last_update_dt = $5
+		WHERE
+			id = $1`
+
+	_, err := m.DB.ExecContext(ctx, stmt,
+		t.ID,
+		t.Category,
+		t.Source,
+		t.SourceID,
+		time.Now(),
+	)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteBankAccountTransactionByID(id int) error {
+	method := "bank_account_transactions_dbrepo.DeleteBankAccountTransactionByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM bank_account_transactions
+		WHERE
+			id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteBankAccountTransactionsByBankAccountID(accountId int) error {
+	method := "bank_account_transactions_dbrepo.DeleteBankAccountTransactionsByBankAccountID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM bank_account_transactions
+		WHERE
+			bank_account_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, accountId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteBankAccountTransactionsByUserID(userId int) error {
+	method := "bank_account_transactions_dbrepo.DeleteBankAccountTransactionsByUserID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM bank_account_transactions
+		WHERE
+			user_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function scanBankAccountTransactions reads every row of a bank_account_transactions query
+func scanBankAccountTransactions(rows *sql.Rows) ([]*models.BankAccountTransaction, error) {
+	method := "bank_account_transactions_dbrepo.scanBankAccountTransactions"
+	klogger.Enter(method)
+
+	transactions := []*models.BankAccountTransaction{}
+
+	for rows.Next() {
+		var t models.BankAccountTransaction
+		err := rows.Scan(
+			&t.ID,
+			&t.BankAccountID,
+			&t.UserID,
+			&t.Type,
+			&t.Amount,
+			&t.TransactionDt,
+			&t.Description,
+			&t.TransferAccountID,
+			&t.Source,
+			&t.SourceID,
+			&t.Payee,
+			&t.ExternalID,
+			&t.Category,
+			&t.CreateDt,
+			&t.LastUpdateDt,
+		)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return nil, err
+		}
+
+		transactions = append(transactions, &t)
+	}
+
+	klogger.Exit(method)
+	return transactions, nil
+}
@@ -0,0 +1,123 @@
+package dbrepo
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBankAccountTransactions(t *testing.T) {
+	method := "bank_account_transactions_dbrepo_test.TestBankAccountTransactions"
+	klogger.Enter(method)
+
+	t1 := models.BankAccountTransaction{BankAccountID: 1, UserID: 1, Type: constants.TransactionTypeDeposit, Amount: 100, TransactionDt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+		Payee: "ACME PAYROLL", ExternalID: "FIT1"}
+	t2 := models.BankAccountTransaction{BankAccountID: 1, UserID: 1, Type: constants.TransactionTypeWithdrawal, Amount: 25.5, TransactionDt: time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)}
+	t3 := models.BankAccountTransaction{BankAccountID: 2, UserID: 1, Type: constants.TransactionTypeTransferIn, Amount: 50, TransactionDt: time.Date(2023, 1, 20, 0, 0, 0, 0, time.UTC), TransferAccountID: 1}
+	t4 := models.BankAccountTransaction{BankAccountID: 3, UserID: 2, Type: constants.TransactionTypeDeposit, Amount: 10, TransactionDt: time.Date(2023, 1, 20, 0, 0, 0, 0, time.UTC)}
+
+	var err error
+	for _, tr := range []*models.BankAccountTransaction{&t1, &t2, &t3, &t4} {
+		tr.ID, err = d.InsertBankAccountTransaction(*tr)
+		assert.Nil(t, err)
+		assert.Greater(t, tr.ID, 0)
+	}
+
+	//A transaction already imported into the account is not saved again
+	id, err := d.InsertBankAccountTransaction(t1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, id)
+
+	//The same external id may be imported into another account
+	t5 := t1
+	t5.BankAccountID = 2
+	t5.ID, err = d.InsertBankAccountTransaction(t5)
+	assert.Nil(t, err)
+	assert.Greater(t, t5.ID, 0)
+
+	//Transactions without an external id are never duplicates
+	t6 := t2
+	t6.ID, err = d.InsertBankAccountTransaction(t6)
+	assert.Nil(t, err)
+	assert.Greater(t, t6.ID, 0)
+
+	err = d.DeleteBankAccountTransactionByID(t5.ID)
+	assert.Nil(t, err)
+	err = d.DeleteBankAccountTransactionByID(t6.ID)
+	assert.Nil(t, err)
+
+	//Transactions are returned in the order they were made
+	tarr, err := d.GetAllBankAccountTransactionsByBankAccountID(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tarr))
+	assert.Equal(t, t2.ID, tarr[0].ID)
+
+	tarr, err = d.GetAllUserBankAccountTransactions(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(tarr))
+
+	//Get by ID
+	tr, err := d.GetBankAccountTransactionByID(t1.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, t1.Type, tr.Type)
+	assert.Equal(t, t1.Amount, tr.Amount)
+	assert.Equal(t, t1.Payee, tr.Payee)
+	assert.Equal(t, t1.ExternalID, tr.ExternalID)
+	assert.True(t, t1.TransactionDt.Equal(tr.TransactionDt))
+
+	//Transaction that does not exist
+	tr, err = d.GetBankAccountTransactionByID(9999)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, tr.ID)
+
+	//Update the category and link
+	t1.Category = "Paycheck"
+	t1.Source = constants.TransactionSourceIncome
+	t1.SourceID = 7
+	err = d.UpdateBankAccountTransactionCategory(t1)
+	assert.Nil(t, err)
+
+	tr, err = d.GetBankAccountTransactionByID(t1.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "Paycheck", tr.Category)
+	assert.Equal(t, t1.Source, tr.Source)
+	assert.Equal(t, 7, tr.SourceID)
+
+	//Delete by ID
+	err = d.DeleteBankAccountTransactionByID(t2.ID)
+	assert.Nil(t, err)
+
+	tarr, err = d.GetAllBankAccountTransactionsByBankAccountID(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tarr))
+
+	//Delete by account
+	err = d.DeleteBankAccountTransactionsByBankAccountID(1)
+	assert.Nil(t, err)
+
+	tarr, err = d.GetAllUserBankAccountTransactions(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tarr))
+	assert.Equal(t, t3.ID, tarr[0].ID)
+
+	//Delete by user
+	err = d.DeleteBankAccountTransactionsByUserID(1)
+	assert.Nil(t, err)
+
+	tarr, err = d.GetAllUserBankAccountTransactions(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(tarr))
+
+	tarr, err = d.GetAllUserBankAccountTransactions(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tarr))
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM bank_account_transactions")
+
+	klogger.Exit(method)
+}
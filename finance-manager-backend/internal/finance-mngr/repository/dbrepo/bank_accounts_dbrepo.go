@@ -0,0 +1,245 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"strings"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func (m *PostgresDBRepo) GetAllUserBankAccounts(userId int, search string) ([]*models.BankAccount, error) {
+	method := "bank_accounts_dbrepo.GetAllUserBankAccounts"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	var query string
+	var err error
+	var rows *sql.Rows
+
+	if search != "" {
+		search = strings.ToLower(search)
+
+		query = `
+		SELECT
+			id, user_id, name, type, opening_balance, opening_dt,
+			create_dt, last_update_dt
+		FROM bank_accounts
+		WHERE
+			user_id = $1
+			AND
+			LOWER(name) like '%' || $2 || '%'
+		ORDER BY name, id`
+		rows, err = m.DB.QueryContext(ctx, query, userId, search)
+	} else {
+		query = `
+		SELECT
+			id, user_id, name, type, opening_balance, opening_dt,
+			create_dt, last_update_dt
+		FROM bank_accounts
+		WHERE
+			user_id = $1
+		ORDER BY name, id`
+		rows, err = m.DB.QueryContext(ctx, query, userId)
+	}
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	accounts := []*models.BankAccount{}
+
+	for rows.Next() {
+		var a models.BankAccount
+		err := rows.Scan(
+			&a.ID,
+			&a.UserID,
+			&a.Name,
+			&a.Type,
+			&a.OpeningBalance,
+			&a.OpeningDt,
+			&a.CreateDt,
+			&a.LastUpdateDt,
+		)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return nil, err
+		}
+
+		accounts = append(accounts, &a)
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(accounts))
+	klogger.Exit(method)
+	return accounts, nil
+}
+
+func (m *PostgresDBRepo) GetBankAccountByID(id int) (models.BankAccount, error) {
+	method := "bank_accounts_dbrepo.GetBankAccountByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, user_id, name, type, opening_balance, opening_dt,
+			create_dt, last_update_dt
+		FROM bank_accounts
+		WHERE
+			id = $1`
+
+	var a models.BankAccount
+	row := m.DB.QueryRowContext(ctx, query, id)
+
+	err := row.Scan(
+		&a.ID,
+		&a.UserID,
+		&a.Name,
+		&a.Type,
+		&a.OpeningBalance,
+		&a.OpeningDt,
+		&a.CreateDt,
+		&a.LastUpdateDt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			klogger.Info(method, constants.NoRowsReturnedMsg)
+			klogger.Exit(method)
+			return a, nil
+		} else {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return a, err
+		}
+	}
+
+	klogger.Exit(method)
+	return a, nil
+}
+
+func (m *PostgresDBRepo) UpdateBankAccount(a models.BankAccount) error {
+	method := "bank_accounts_dbrepo.UpdateBankAccount"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`UPDATE bank_accounts
+		SET
+			name = $2,
+			type = $3,
+			opening_balance = $4,
+			opening_dt = $5,
+			last_update_dt = $6
+		WHERE
+			id = $1`
+
+	_, err := m.DB.ExecContext(ctx, stmt,
+		a.ID,
+		a.Name,
+		a.Type,
+		a.OpeningBalance,
+		a.OpeningDt,
+		time.Now(),
+	)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) InsertBankAccount(a models.BankAccount) (int, error) {
+	method := "bank_accounts_dbrepo.InsertBankAccount"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`INSERT INTO bank_accounts
+			(user_id, name, type, opening_balance, opening_dt, create_dt, last_update_dt)
+		values
+			($1, $2, $3, $4, $5, $6, $7) returning id`
+
+	var id int
+	err := m.DB.QueryRowContext(ctx, stmt,
+		a.UserID,
+		a.Name,
+		a.Type,
+		a.OpeningBalance,
+		a.OpeningDt,
+		time.Now(),
+		time.Now(),
+	).Scan(&id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+func (m *PostgresDBRepo) DeleteBankAccountByID(id int) error {
+	method := "bank_accounts_dbrepo.DeleteBankAccountByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM bank_accounts
+		WHERE
+			id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteBankAccountsByUserID(userId int) error {
+	method := "bank_accounts_dbrepo.DeleteBankAccountsByUserID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM bank_accounts
+		WHERE
+			user_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
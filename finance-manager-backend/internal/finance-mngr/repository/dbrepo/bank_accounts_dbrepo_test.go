@@ -0,0 +1,83 @@
+package dbrepo
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBankAccounts(t *testing.T) {
+	method := "bank_accounts_dbrepo_test.TestBankAccounts"
+	klogger.Enter(method)
+
+	a1 := models.BankAccount{UserID: 1, Name: "Checking", Type: constants.BankAccountTypeChecking, OpeningBalance: 500, OpeningDt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	a2 := models.BankAccount{UserID: 1, Name: "Savings", Type: constants.BankAccountTypeSavings, OpeningBalance: 1000, OpeningDt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	a3 := models.BankAccount{UserID: 2, Name: "Wallet", Type: constants.BankAccountTypeCash, OpeningDt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	var err error
+	for _, a := range []*models.BankAccount{&a1, &a2, &a3} {
+		a.ID, err = d.InsertBankAccount(*a)
+		assert.Nil(t, err)
+		assert.Greater(t, a.ID, 0)
+	}
+
+	aarr, err := d.GetAllUserBankAccounts(1, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(aarr))
+
+	aarr, err = d.GetAllUserBankAccounts(1, "sav")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(aarr))
+	assert.Equal(t, a2.ID, aarr[0].ID)
+
+	//Get by ID
+	a, err := d.GetBankAccountByID(a1.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, a1.Name, a.Name)
+	assert.Equal(t, a1.Type, a.Type)
+	assert.Equal(t, a1.OpeningBalance, a.OpeningBalance)
+	assert.True(t, a1.OpeningDt.Equal(a.OpeningDt))
+
+	//Account that does not exist
+	a, err = d.GetBankAccountByID(9999)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, a.ID)
+
+	//Update
+	a1.OpeningBalance = 750
+	err = d.UpdateBankAccount(a1)
+	assert.Nil(t, err)
+
+	a, err = d.GetBankAccountByID(a1.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 750.0, a.OpeningBalance)
+
+	//Delete by ID
+	err = d.DeleteBankAccountByID(a2.ID)
+	assert.Nil(t, err)
+
+	aarr, err = d.GetAllUserBankAccounts(1, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(aarr))
+
+	//Delete by user
+	err = d.DeleteBankAccountsByUserID(1)
+	assert.Nil(t, err)
+
+	aarr, err = d.GetAllUserBankAccounts(1, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(aarr))
+
+	aarr, err = d.GetAllUserBankAccounts(2, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(aarr))
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM bank_accounts")
+
+	klogger.Exit(method)
+}
@@ -27,7 +27,7 @@ func (m *PostgresDBRepo) GetAllUserBills(userId int, search string) ([]*models.B
 
 		query = `
 		SELECT
-			id, user_id, name, amount,
+			id, user_id, name, amount, frequency, due_dt, end_dt, account_id,
 			create_dt, last_update_dt
 		FROM bills
 		WHERE
@@ -38,7 +38,7 @@ func (m *PostgresDBRepo) GetAllUserBills(userId int, search string) ([]*models.B
 	} else {
 		query = `
 		SELECT
-			id, user_id, name, amount,
+			id, user_id, name, amount, frequency, due_dt, end_dt, account_id,
 			create_dt, last_update_dt
 		FROM bills
 		WHERE
@@ -63,11 +63,16 @@ func (m *PostgresDBRepo) GetAllUserBills(userId int, search string) ([]*models.B
 
 	for rows.Next() {
 		var bill models.Bill
+		var dueDt sql.NullTime
 		err := rows.Scan(
 			&bill.ID,
 			&bill.UserID,
 			&bill.Name,
 			&bill.Amount,
+			&bill.Frequency,
+			&dueDt,
+			&bill.EndDt,
+			&bill.AccountID,
 			&bill.CreateDt,
 			&bill.LastUpdateDt,
 		)
@@ -77,6 +82,7 @@ func (m *PostgresDBRepo) GetAllUserBills(userId int, search string) ([]*models.B
 			return nil, err
 		}
 
+		bill.DueDt = dueDt.Time
 		recordCount = recordCount + 1
 		bills = append(bills, &bill)
 	}
@@ -95,13 +101,14 @@ func (m *PostgresDBRepo) GetBillByID(id int) (models.Bill, error) {
 
 	query := `
 		select
-			id, user_id, name, amount,
+			id, user_id, name, amount, frequency, due_dt, end_dt, account_id,
 			create_dt, last_update_dt
 		FROM bills
 		WHERE 
 			id = $1`
 
 	var bill models.Bill
+	var dueDt sql.NullTime
 	row := m.DB.QueryRowContext(ctx, query, id)
 
 	err := row.Scan(
@@ -109,6 +116,10 @@ func (m *PostgresDBRepo) GetBillByID(id int) (models.Bill, error) {
 		&bill.UserID,
 		&bill.Name,
 		&bill.Amount,
+		&bill.Frequency,
+		&dueDt,
+		&bill.EndDt,
+		&bill.AccountID,
 		&bill.CreateDt,
 		&bill.LastUpdateDt,
 	)
@@ -123,6 +134,8 @@ func (m *PostgresDBRepo) GetBillByID(id int) (models.Bill, error) {
 		}
 	}
 
+	bill.DueDt = dueDt.Time
+
 	klogger.Exit(method)
 	return bill, nil
 }
@@ -139,7 +152,11 @@ func (m *PostgresDBRepo) UpdateBill(bill models.Bill) error {
 		SET
 			name = $2,
 			amount = $3,
-			last_update_dt = $4
+			frequency = $4,
+			due_dt = $5,
+			end_dt = $6,
+			account_id = $7,
+			last_update_dt = $8
 		WHERE
 			id = $1`
 
@@ -147,6 +164,10 @@ func (m *PostgresDBRepo) UpdateBill(bill models.Bill) error {
 		bill.ID,
 		bill.Name,
 		bill.Amount,
+		bill.Frequency,
+		sql.NullTime{Time: bill.DueDt, Valid: !bill.DueDt.IsZero()},
+		bill.EndDt,
+		bill.AccountID,
 		time.Now(),
 	)
 
@@ -168,15 +189,19 @@ func (m *PostgresDBRepo) InsertBill(bill models.Bill) (int, error) {
 
 	stmt :=
 		`INSERT INTO bills 
-			(user_id, name, amount, create_dt, last_update_dt)
+			(user_id, name, amount, frequency, due_dt, end_dt, account_id, create_dt, last_update_dt)
 		values 
-			($1, $2, $3, $4, $5) returning id`
+			($1, $2, $3, $4, $5, $6, $7, $8, $9) returning id`
 
 	var id int
 	err := m.DB.QueryRowContext(ctx, stmt,
 		bill.UserID,
 		bill.Name,
 		bill.Amount,
+		bill.Frequency,
+		sql.NullTime{Time: bill.DueDt, Valid: !bill.DueDt.IsZero()},
+		bill.EndDt,
+		bill.AccountID,
 		time.Now(),
 		time.Now(),
 	).Scan(&id)
@@ -190,6 +215,33 @@ func (m *PostgresDBRepo) InsertBill(bill models.Bill) (int, error) {
 	return id, nil
 }
 
+// Function UnlinkBillsFromBankAccount removes a deleted bank account from the bills paid from it
+func (m *PostgresDBRepo) UnlinkBillsFromBankAccount(accountId int) error {
+	method := "bills_dbrepo.UnlinkBillsFromBankAccount"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`UPDATE bills
+		SET
+			account_id = 0,
+			last_update_dt = $2
+		WHERE
+			account_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, stmt, accountId, time.Now())
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
 func (m *PostgresDBRepo) DeleteBillByID(id int) error {
 	method := "bills_dbrepo.DeleteBillByID"
 	klogger.Enter(method)
@@ -0,0 +1,233 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func (m *PostgresDBRepo) GetAllUserBudgets(userId int) ([]*models.Budget, error) {
+	method := "budgets_dbrepo.GetAllUserBudgets"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, user_id, category, monthly_limit, rollover, source, source_id, start_dt, create_dt, last_update_dt
+		FROM budgets
+		WHERE
+			user_id = $1
+		ORDER BY category`
+
+	rows, err := m.DB.QueryContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	budgets := []*models.Budget{}
+
+	for rows.Next() {
+		var b models.Budget
+		err := rows.Scan(
+			&b.ID,
+			&b.UserID,
+			&b.Category,
+			&b.Limit,
+			&b.Rollover,
+			&b.Source,
+			&b.SourceID,
+			&b.StartDt,
+			&b.CreateDt,
+			&b.LastUpdateDt,
+		)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return nil, err
+		}
+
+		budgets = append(budgets, &b)
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(budgets))
+	klogger.Exit(method)
+	return budgets, nil
+}
+
+func (m *PostgresDBRepo) GetBudgetByID(id int) (models.Budget, error) {
+	method := "budgets_dbrepo.GetBudgetByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, user_id, category, monthly_limit, rollover, source, source_id, start_dt, create_dt, last_update_dt
+		FROM budgets
+		WHERE
+			id = $1`
+
+	var b models.Budget
+	row := m.DB.QueryRowContext(ctx, query, id)
+
+	err := row.Scan(
+		&b.ID,
+		&b.UserID,
+		&b.Category,
+		&b.Limit,
+		&b.Rollover,
+		&b.Source,
+		&b.SourceID,
+		&b.StartDt,
+		&b.CreateDt,
+		&b.LastUpdateDt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			klogger.Info(method, constants.NoRowsReturnedMsg)
+			klogger.Exit(method)
+			return b, nil
+		} else {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return b, err
+		}
+	}
+
+	klogger.Exit(method)
+	return b, nil
+}
+
+func (m *PostgresDBRepo) UpdateBudget(b models.Budget) error {
+	method := "budgets_dbrepo.UpdateBudget"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`UPDATE budgets
+		SET
+			category = $2,
+			monthly_limit = $3,
+			rollover = $4,
+			source = $5,
+			source_id = $6,
+			start_dt = $7,
+			last_update_dt = $8
+		WHERE
+			id = $1`
+
+	_, err := m.DB.ExecContext(ctx, stmt,
+		b.ID,
+		b.Category,
+		b.Limit,
+		b.Rollover,
+		b.Source,
+		b.SourceID,
+		b.StartDt,
+		time.Now(),
+	)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) InsertBudget(b models.Budget) (int, error) {
+	method := "budgets_dbrepo.InsertBudget"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`INSERT INTO budgets
+			(user_id, category, monthly_limit, rollover, source, source_id, start_dt, create_dt, last_update_dt)
+		values
+			($1, $2, $3, $4, $5, $6, $7, $8, $9) returning id`
+
+	var id int
+	err := m.DB.QueryRowContext(ctx, stmt,
+		b.UserID,
+		b.Category,
+		b.Limit,
+		b.Rollover,
+		b.Source,
+		b.SourceID,
+		b.StartDt,
+		time.Now(),
+		time.Now(),
+	).Scan(&id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+func (m *PostgresDBRepo) DeleteBudgetByID(id int) error {
+	method := "budgets_dbrepo.DeleteBudgetByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM budgets
+		WHERE
+			id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteBudgetsByUserID(userId int) error {
+	method := "budgets_dbrepo.DeleteBudgetsByUserID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM budgets
+		WHERE
+			user_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
@@ -0,0 +1,85 @@
+package dbrepo
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudgets(t *testing.T) {
+	method := "budgets_dbrepo_test.TestBudgets"
+	klogger.Enter(method)
+
+	b1 := models.Budget{UserID: 1, Category: "groceries", Limit: 300, Rollover: true, StartDt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	b2 := models.Budget{UserID: 1, Category: "dining", Limit: 100, StartDt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	b3 := models.Budget{UserID: 2, Category: "rent", Source: constants.BudgetSourceBill, SourceID: 1, StartDt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	var err error
+	for _, b := range []*models.Budget{&b1, &b2, &b3} {
+		b.ID, err = d.InsertBudget(*b)
+		assert.Nil(t, err)
+		assert.Greater(t, b.ID, 0)
+	}
+
+	//Get by ID
+	b, err := d.GetBudgetByID(b1.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, b1.ID, b.ID)
+	assert.Equal(t, b1.Category, b.Category)
+	assert.Equal(t, b1.Limit, b.Limit)
+	assert.True(t, b.Rollover)
+	assert.True(t, b1.StartDt.Equal(b.StartDt))
+
+	//Budget that does not exist
+	b, err = d.GetBudgetByID(9999)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, b.ID)
+
+	//Budgets are returned by category
+	barr, err := d.GetAllUserBudgets(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(barr))
+	assert.Equal(t, b2.ID, barr[0].ID)
+	assert.Equal(t, b1.ID, barr[1].ID)
+
+	//Update
+	b1.Limit = 350
+	b1.Rollover = false
+	err = d.UpdateBudget(b1)
+	assert.Nil(t, err)
+
+	b, err = d.GetBudgetByID(b1.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 350.0, b.Limit)
+	assert.False(t, b.Rollover)
+
+	//Delete by ID
+	err = d.DeleteBudgetByID(b2.ID)
+	assert.Nil(t, err)
+
+	barr, err = d.GetAllUserBudgets(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(barr))
+
+	//Delete by user
+	err = d.DeleteBudgetsByUserID(1)
+	assert.Nil(t, err)
+
+	barr, err = d.GetAllUserBudgets(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(barr))
+
+	barr, err = d.GetAllUserBudgets(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(barr))
+	assert.Equal(t, constants.BudgetSourceBill, barr[0].Source)
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM budgets")
+
+	klogger.Exit(method)
+}
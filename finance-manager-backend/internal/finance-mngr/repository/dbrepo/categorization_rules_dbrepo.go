@@ -0,0 +1,251 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func (m *PostgresDBRepo) GetAllUserCategorizationRules(userId int) ([]*models.CategorizationRule, error) {
+	method := "categorization_rules_dbrepo.GetAllUserCategorizationRules"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, user_id, name, priority, payee_pattern, min_amount, max_amount, account_id, category, source, source_id,
+			create_dt, last_update_dt
+		FROM categorization_rules
+		WHERE
+			user_id = $1
+		ORDER BY priority, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	rules := []*models.CategorizationRule{}
+
+	for rows.Next() {
+		var r models.CategorizationRule
+		err := rows.Scan(
+			&r.ID,
+			&r.UserID,
+			&r.Name,
+			&r.Priority,
+			&r.PayeePattern,
+			&r.MinAmount,
+			&r.MaxAmount,
+			&r.AccountID,
+			&r.Category,
+			&r.Source,
+			&r.SourceID,
+			&r.CreateDt,
+			&r.LastUpdateDt,
+		)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return nil, err
+		}
+
+		rules = append(rules, &r)
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(rules))
+	klogger.Exit(method)
+	return rules, nil
+}
+
+func (m *PostgresDBRepo) GetCategorizationRuleByID(id int) (models.CategorizationRule, error) {
+	method := "categorization_rules_dbrepo.GetCategorizationRuleByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, user_id, name, priority, payee_pattern, min_amount, max_amount, account_id, category, source, source_id,
+			create_dt, last_update_dt
+		FROM categorization_rules
+		WHERE
+			id = $1`
+
+	var r models.CategorizationRule
+	row := m.DB.QueryRowContext(ctx, query, id)
+
+	err := row.Scan(
+		&r.ID,
+		&r.UserID,
+		&r.Name,
+		&r.Priority,
+		&r.PayeePattern,
+		&r.MinAmount,
+		&r.MaxAmount,
+		&r.AccountID,
+		&r.Category,
+		&r.Source,
+		&r.SourceID,
+		&r.CreateDt,
+		&r.LastUpdateDt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			klogger.Info(method, constants.NoRowsReturnedMsg)
+			klogger.Exit(method)
+			return r, nil
+		} else {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return r, err
+		}
+	}
+
+	klogger.Exit(method)
+	return r, nil
+}
+
+func (m *PostgresDBRepo) UpdateCategorizationRule(r models.CategorizationRule) error {
+	method := "categorization_rules_dbrepo.UpdateCategorizationRule"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`UPDATE categorization_rules
+		SET
+			name = $2,
+			priority = $3,
+			payee_pattern = $4,
+			min_amount = $5,
+			max_amount = $6,
+			account_id = $7,
+			category = $8,
+			source = $9,
+			source_id = $10,
+			last_update_dt = $11
+		WHERE
+			id = $1`
+
+	_, err := m.DB.ExecContext(ctx, stmt,
+		r.ID,
+		r.Name,
+		r.Priority,
+		r.PayeePattern,
+		r.MinAmount,
+		r.MaxAmount,
+		r.AccountID,
+		r.Category,
+		r.Source,
+		r.SourceID,
+		time.Now(),
+	)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) InsertCategorizationRule(r models.CategorizationRule) (int, error) {
+	method := "categorization_rules_dbrepo.InsertCategorizationRule"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`INSERT INTO categorization_rules
+			(user_id, name, priority, payee_pattern, min_amount, max_amount, account_id, category, source, source_id,
+			create_dt, last_update_dt)
+		values
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) returning id`
+
+	var id int
+	err := m.DB.QueryRowContext(ctx, stmt,
+		r.UserID,
+		r.Name,
+		r.Priority,
+		r.PayeePattern,
+		r.MinAmount,
+		r.MaxAmount,
+		r.AccountID,
+		r.Category,
+		r.Source,
+		r.SourceID,
+		time.Now(),
+		time.Now(),
+	).Scan(&id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+func (m *PostgresDBRepo) DeleteCategorizationRuleByID(id int) error {
+	method := "categorization_rules_dbrepo.DeleteCategorizationRuleByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM categorization_rules
+		WHERE
+			id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteCategorizationRulesByUserID(userId int) error {
+	method := "categorization_rules_dbrepo.DeleteCategorizationRulesByUserID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM categorization_rules
+		WHERE
+			user_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
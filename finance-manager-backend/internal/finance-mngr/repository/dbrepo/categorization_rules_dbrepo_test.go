@@ -0,0 +1,94 @@
+package dbrepo
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategorizationRules(t *testing.T) {
+	method := "categorization_rules_dbrepo_test.TestCategorizationRules"
+	klogger.Enter(method)
+
+	minAmount := 10.0
+	maxAmount := 50.0
+
+	r1 := models.CategorizationRule{UserID: 1, Name: "Coffee", Priority: 2, PayeePattern: "coffee", MinAmount: &minAmount, MaxAmount: &maxAmount, Category: "dining"}
+	r2 := models.CategorizationRule{UserID: 1, Name: "Rent", Priority: 1, AccountID: 1, Source: constants.TransactionSourceBill, SourceID: 1}
+	r3 := models.CategorizationRule{UserID: 2, Name: "Groceries", PayeePattern: "whole foods", Category: "groceries"}
+
+	var err error
+	for _, r := range []*models.CategorizationRule{&r1, &r2, &r3} {
+		r.ID, err = d.InsertCategorizationRule(*r)
+		assert.Nil(t, err)
+		assert.Greater(t, r.ID, 0)
+	}
+
+	//Get by ID
+	r, err := d.GetCategorizationRuleByID(r1.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, r1.ID, r.ID)
+	assert.Equal(t, r1.PayeePattern, r.PayeePattern)
+	assert.Equal(t, minAmount, *r.MinAmount)
+	assert.Equal(t, maxAmount, *r.MaxAmount)
+	assert.Equal(t, r1.Category, r.Category)
+
+	//Amounts that are not set are read back as nil
+	r, err = d.GetCategorizationRuleByID(r2.ID)
+	assert.Nil(t, err)
+	assert.Nil(t, r.MinAmount)
+	assert.Nil(t, r.MaxAmount)
+	assert.Equal(t, constants.TransactionSourceBill, r.Source)
+	assert.Equal(t, 1, r.SourceID)
+
+	//Rule that does not exist
+	r, err = d.GetCategorizationRuleByID(9999)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, r.ID)
+
+	//Rules are returned in priority order
+	rarr, err := d.GetAllUserCategorizationRules(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rarr))
+	assert.Equal(t, r2.ID, rarr[0].ID)
+	assert.Equal(t, r1.ID, rarr[1].ID)
+
+	//Update
+	r1.Priority = 0
+	r1.MaxAmount = nil
+	err = d.UpdateCategorizationRule(r1)
+	assert.Nil(t, err)
+
+	rarr, err = d.GetAllUserCategorizationRules(1)
+	assert.Nil(t, err)
+	assert.Equal(t, r1.ID, rarr[0].ID)
+	assert.Nil(t, rarr[0].MaxAmount)
+
+	//Delete by ID
+	err = d.DeleteCategorizationRuleByID(r2.ID)
+	assert.Nil(t, err)
+
+	rarr, err = d.GetAllUserCategorizationRules(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rarr))
+
+	//Delete by user
+	err = d.DeleteCategorizationRulesByUserID(1)
+	assert.Nil(t, err)
+
+	rarr, err = d.GetAllUserCategorizationRules(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(rarr))
+
+	rarr, err = d.GetAllUserCategorizationRules(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rarr))
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM categorization_rules")
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,260 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func (m *PostgresDBRepo) GetAllIncomeHoursByIncomeID(incomeId int) ([]*models.IncomeHours, error) {
+	method := "income_hours_dbrepo.GetAllIncomeHoursByIncomeID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, income_id, user_id, pay_dt, hours, create_dt, last_update_dt
+		FROM income_hours
+		WHERE
+			income_id = $1
+		ORDER BY pay_dt, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, incomeId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	hours, err := scanIncomeHours(rows)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(hours))
+	klogger.Exit(method)
+	return hours, nil
+}
+
+func (m *PostgresDBRepo) GetAllUserIncomeHours(userId int) ([]*models.IncomeHours, error) {
+	method := "income_hours_dbrepo.GetAllUserIncomeHours"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, income_id, user_id, pay_dt, hours, create_dt, last_update_dt
+		FROM income_hours
+		WHERE
+			user_id = $1
+		ORDER BY pay_dt, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	hours, err := scanIncomeHours(rows)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(hours))
+	klogger.Exit(method)
+	return hours, nil
+}
+
+func (m *PostgresDBRepo) GetIncomeHoursByID(id int) (models.IncomeHours, error) {
+	method := "income_hours_dbrepo.GetIncomeHoursByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, income_id, user_id, pay_dt, hours, create_dt, last_update_dt
+		FROM income_hours
+		WHERE
+			id = $1`
+
+	var h models.IncomeHours
+	row := m.DB.QueryRowContext(ctx, query, id)
+
+	err := row.Scan(
+		&h.ID,
+		&h.IncomeID,
+		&h.UserID,
+		&h.PayDt,
+		&h.Hours,
+		&h.CreateDt,
+		&h.LastUpdateDt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			klogger.Info(method, constants.NoRowsReturnedMsg)
+			klogger.Exit(method)
+			return h, nil
+		} else {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return h, err
+		}
+	}
+
+	klogger.Exit(method)
+	return h, nil
+}
+
+func (m *PostgresDBRepo) InsertIncomeHours(h models.IncomeHours) (int, error) {
+	method := "income_hours_dbrepo.InsertIncomeHours"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`INSERT INTO income_hours
+			(income_id, user_id, pay_dt, hours, create_dt, last_update_dt)
+		values
+			($1, $2, $3, $4, $5, $6) returning id`
+
+	var id int
+	err := m.DB.QueryRowContext(ctx, stmt,
+		h.IncomeID,
+		h.UserID,
+		h.PayDt,
+		h.Hours,
+		time.Now(),
+		time.Now(),
+	).Scan(&id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+func (m *PostgresDBRepo) DeleteIncomeHoursByID(id int) error {
+	method := "income_hours_dbrepo.DeleteIncomeHoursByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM income_hours
+		WHERE
+			id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteIncomeHoursByIncomeID(incomeId int) error {
+	method := "income_hours_dbrepo.DeleteIncomeHoursByIncomeID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM income_hours
+		WHERE
+			income_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, incomeId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteIncomeHoursByUserID(userId int) error {
+	method := "income_hours_dbrepo.DeleteIncomeHoursByUserID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM income_hours
+		WHERE
+			user_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function scanIncomeHours reads every row of an income_hours query
+func scanIncomeHours(rows *sql.Rows) ([]*models.IncomeHours, error) {
+	method := "income_hours_dbrepo.scanIncomeHours"
+	klogger.Enter(method)
+
+	hours := []*models.IncomeHours{}
+
+	for rows.Next() {
+		var h models.IncomeHours
+		err := rows.Scan(
+			&h.ID,
+			&h.IncomeID,
+			&h.UserID,
+			&h.PayDt,
+			&h.Hours,
+			&h.CreateDt,
+			&h.LastUpdateDt,
+		)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return nil, err
+		}
+
+		hours = append(hours, &h)
+	}
+
+	klogger.Exit(method)
+	return hours, nil
+}
@@ -0,0 +1,82 @@
+package dbrepo
+
+import (
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncomeHours(t *testing.T) {
+	method := "income_hours_dbrepo_test.TestIncomeHours"
+	klogger.Enter(method)
+
+	h1 := models.IncomeHours{IncomeID: 1, UserID: 1, Hours: 80, PayDt: time.Date(2023, 1, 20, 0, 0, 0, 0, time.UTC)}
+	h2 := models.IncomeHours{IncomeID: 1, UserID: 1, Hours: 72.5, PayDt: time.Date(2023, 2, 3, 0, 0, 0, 0, time.UTC)}
+	h3 := models.IncomeHours{IncomeID: 2, UserID: 1, Hours: 40, PayDt: time.Date(2023, 1, 20, 0, 0, 0, 0, time.UTC)}
+	h4 := models.IncomeHours{IncomeID: 3, UserID: 2, Hours: 40, PayDt: time.Date(2023, 1, 20, 0, 0, 0, 0, time.UTC)}
+
+	var err error
+	for _, h := range []*models.IncomeHours{&h1, &h2, &h3, &h4} {
+		h.ID, err = d.InsertIncomeHours(*h)
+		assert.Nil(t, err)
+		assert.Greater(t, h.ID, 0)
+	}
+
+	//Get by ID
+	h, err := d.GetIncomeHoursByID(h2.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, h2.ID, h.ID)
+	assert.Equal(t, h2.Hours, h.Hours)
+	assert.True(t, h2.PayDt.Equal(h.PayDt))
+
+	//Hours that do not exist
+	h, err = d.GetIncomeHoursByID(9999)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, h.ID)
+
+	harr, err := d.GetAllIncomeHoursByIncomeID(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(harr))
+
+	harr, err = d.GetAllUserIncomeHours(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(harr))
+
+	//Delete by ID
+	err = d.DeleteIncomeHoursByID(h2.ID)
+	assert.Nil(t, err)
+
+	harr, err = d.GetAllIncomeHoursByIncomeID(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(harr))
+
+	//Delete by income
+	err = d.DeleteIncomeHoursByIncomeID(1)
+	assert.Nil(t, err)
+
+	harr, err = d.GetAllUserIncomeHours(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(harr))
+	assert.Equal(t, h3.ID, harr[0].ID)
+
+	//Delete by user
+	err = d.DeleteIncomeHoursByUserID(1)
+	assert.Nil(t, err)
+
+	harr, err = d.GetAllUserIncomeHours(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(harr))
+
+	//Hours of other users are kept
+	harr, err = d.GetAllUserIncomeHours(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(harr))
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM income_hours")
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,263 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func (m *PostgresDBRepo) GetAllIncomeVersionsByIncomeID(incomeId int) ([]*models.IncomeVersion, error) {
+	method := "income_versions_dbrepo.GetAllIncomeVersionsByIncomeID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, income_id, user_id, rate, hours, effective_dt, create_dt, last_update_dt
+		FROM income_versions
+		WHERE
+			income_id = $1
+		ORDER BY effective_dt, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, incomeId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	versions, err := scanIncomeVersions(rows)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(versions))
+	klogger.Exit(method)
+	return versions, nil
+}
+
+func (m *PostgresDBRepo) GetAllUserIncomeVersions(userId int) ([]*models.IncomeVersion, error) {
+	method := "income_versions_dbrepo.GetAllUserIncomeVersions"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, income_id, user_id, rate, hours, effective_dt, create_dt, last_update_dt
+		FROM income_versions
+		WHERE
+			user_id = $1
+		ORDER BY effective_dt, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	versions, err := scanIncomeVersions(rows)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(versions))
+	klogger.Exit(method)
+	return versions, nil
+}
+
+func (m *PostgresDBRepo) GetIncomeVersionByID(id int) (models.IncomeVersion, error) {
+	method := "income_versions_dbrepo.GetIncomeVersionByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, income_id, user_id, rate, hours, effective_dt, create_dt, last_update_dt
+		FROM income_versions
+		WHERE
+			id = $1`
+
+	var v models.IncomeVersion
+	row := m.DB.QueryRowContext(ctx, query, id)
+
+	err := row.Scan(
+		&v.ID,
+		&v.IncomeID,
+		&v.UserID,
+		&v.Rate,
+		&v.Hours,
+		&v.EffectiveDt,
+		&v.CreateDt,
+		&v.LastUpdateDt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			klogger.Info(method, constants.NoRowsReturnedMsg)
+			klogger.Exit(method)
+			return v, nil
+		} else {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return v, err
+		}
+	}
+
+	klogger.Exit(method)
+	return v, nil
+}
+
+func (m *PostgresDBRepo) InsertIncomeVersion(v models.IncomeVersion) (int, error) {
+	method := "income_versions_dbrepo.InsertIncomeVersion"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`INSERT INTO income_versions
+			(income_id, user_id, rate, hours, effective_dt, create_dt, last_update_dt)
+		values
+			($1, $2, $3, $4, $5, $6, $7) returning id`
+
+	var id int
+	err := m.DB.QueryRowContext(ctx, stmt,
+		v.IncomeID,
+		v.UserID,
+		v.Rate,
+		v.Hours,
+		v.EffectiveDt,
+		time.Now(),
+		time.Now(),
+	).Scan(&id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+func (m *PostgresDBRepo) DeleteIncomeVersionByID(id int) error {
+	method := "income_versions_dbrepo.DeleteIncomeVersionByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM income_versions
+		WHERE
+			id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteIncomeVersionsByIncomeID(incomeId int) error {
+	method := "income_versions_dbrepo.DeleteIncomeVersionsByIncomeID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM income_versions
+		WHERE
+			income_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, incomeId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteIncomeVersionsByUserID(userId int) error {
+	method := "income_versions_dbrepo.DeleteIncomeVersionsByUserID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM income_versions
+		WHERE
+			user_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function scanIncomeVersions reads every row of an income_versions query
+func scanIncomeVersions(rows *sql.Rows) ([]*models.IncomeVersion, error) {
+	method := "income_versions_dbrepo.scanIncomeVersions"
+	klogger.Enter(method)
+
+	versions := []*models.IncomeVersion{}
+
+	for rows.Next() {
+		var v models.IncomeVersion
+		err := rows.Scan(
+			&v.ID,
+			&v.IncomeID,
+			&v.UserID,
+			&v.Rate,
+			&v.Hours,
+			&v.EffectiveDt,
+			&v.CreateDt,
+			&v.LastUpdateDt,
+		)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return nil, err
+		}
+
+		versions = append(versions, &v)
+	}
+
+	klogger.Exit(method)
+	return versions, nil
+}
@@ -0,0 +1,83 @@
+package dbrepo
+
+import (
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncomeVersions(t *testing.T) {
+	method := "income_versions_dbrepo_test.TestIncomeVersions"
+	klogger.Enter(method)
+
+	v1 := models.IncomeVersion{IncomeID: 1, UserID: 1, Rate: 25, Hours: 40, EffectiveDt: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)}
+	v2 := models.IncomeVersion{IncomeID: 1, UserID: 1, Rate: 30, EffectiveDt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	v3 := models.IncomeVersion{IncomeID: 2, UserID: 1, Rate: 20, EffectiveDt: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)}
+	v4 := models.IncomeVersion{IncomeID: 3, UserID: 2, Rate: 20, EffectiveDt: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)}
+
+	var err error
+	for _, v := range []*models.IncomeVersion{&v1, &v2, &v3, &v4} {
+		v.ID, err = d.InsertIncomeVersion(*v)
+		assert.Nil(t, err)
+		assert.Greater(t, v.ID, 0)
+	}
+
+	//Get by ID
+	v, err := d.GetIncomeVersionByID(v1.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, v1.ID, v.ID)
+	assert.Equal(t, v1.Rate, v.Rate)
+	assert.Equal(t, v1.Hours, v.Hours)
+	assert.True(t, v1.EffectiveDt.Equal(v.EffectiveDt))
+
+	//Version that does not exist
+	v, err = d.GetIncomeVersionByID(9999)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, v.ID)
+
+	varr, err := d.GetAllIncomeVersionsByIncomeID(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(varr))
+
+	varr, err = d.GetAllUserIncomeVersions(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(varr))
+
+	//Delete by ID
+	err = d.DeleteIncomeVersionByID(v2.ID)
+	assert.Nil(t, err)
+
+	varr, err = d.GetAllIncomeVersionsByIncomeID(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(varr))
+
+	//Delete by income
+	err = d.DeleteIncomeVersionsByIncomeID(1)
+	assert.Nil(t, err)
+
+	varr, err = d.GetAllUserIncomeVersions(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(varr))
+	assert.Equal(t, v3.ID, varr[0].ID)
+
+	//Delete by user
+	err = d.DeleteIncomeVersionsByUserID(1)
+	assert.Nil(t, err)
+
+	varr, err = d.GetAllUserIncomeVersions(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(varr))
+
+	//Versions of other users are kept
+	varr, err = d.GetAllUserIncomeVersions(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(varr))
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM income_versions")
+
+	klogger.Exit(method)
+}
@@ -28,7 +28,7 @@ func (m *PostgresDBRepo) GetAllUserIncomes(userId int, search string) ([]*models
 		klogger.Debug(method, "searching for incomes meeting criteria: %s", search)
 		query = `
 		SELECT
-			id, user_id, name, type, rate, hours, amount, frequency, tax_percentage, start_dt,
+			id, user_id, name, type, rate, hours, amount, frequency, tax_percentage, filing_status, state, retirement_percentage, roth_percentage, hsa_contribution, health_premium, garnishment, regular_hours_cap, overtime_multiplier, start_dt, end_dt, account_id,
 			create_dt, last_update_dt
 		FROM incomes
 		WHERE
@@ -39,7 +39,7 @@ func (m *PostgresDBRepo) GetAllUserIncomes(userId int, search string) ([]*models
 	} else {
 		query = `
 		SELECT
-			id, user_id, name, type, rate, hours, amount, frequency, tax_percentage, start_dt,
+			id, user_id, name, type, rate, hours, amount, frequency, tax_percentage, filing_status, state, retirement_percentage, roth_percentage, hsa_contribution, health_premium, garnishment, regular_hours_cap, overtime_multiplier, start_dt, end_dt, account_id,
 			create_dt, last_update_dt
 		FROM incomes
 		WHERE
@@ -74,7 +74,18 @@ func (m *PostgresDBRepo) GetAllUserIncomes(userId int, search string) ([]*models
 			&income.GrossPay,
 			&income.Frequency,
 			&income.TaxPercentage,
+			&income.FilingStatus,
+			&income.State,
+			&income.RetirementPercentage,
+			&income.RothPercentage,
+			&income.HSAContribution,
+			&income.HealthPremium,
+			&income.Garnishment,
+			&income.RegularHoursCap,
+			&income.OvertimeMultiplier,
 			&income.StartDt,
+			&income.EndDt,
+			&income.AccountID,
 			&income.CreateDt,
 			&income.LastUpdateDt,
 		)
@@ -102,7 +113,7 @@ func (m *PostgresDBRepo) GetIncomeByID(id int) (models.Income, error) {
 
 	query := `
 		select
-			id, user_id, name, type, rate, hours, amount, frequency, tax_percentage, start_dt,
+			id, user_id, name, type, rate, hours, amount, frequency, tax_percentage, filing_status, state, retirement_percentage, roth_percentage, hsa_contribution, health_premium, garnishment, regular_hours_cap, overtime_multiplier, start_dt, end_dt, account_id,
 			create_dt, last_update_dt
 		FROM incomes
 		WHERE 
@@ -121,7 +132,18 @@ func (m *PostgresDBRepo) GetIncomeByID(id int) (models.Income, error) {
 		&income.GrossPay,
 		&income.Frequency,
 		&income.TaxPercentage,
+		&income.FilingStatus,
+		&income.State,
+		&income.RetirementPercentage,
+		&income.RothPercentage,
+		&income.HSAContribution,
+		&income.HealthPremium,
+		&income.Garnishment,
+		&income.RegularHoursCap,
+		&income.OvertimeMultiplier,
 		&income.StartDt,
+		&income.EndDt,
+		&income.AccountID,
 		&income.CreateDt,
 		&income.LastUpdateDt,
 	)
@@ -157,8 +179,19 @@ func (m *PostgresDBRepo) UpdateIncome(income models.Income) error {
 			amount = $6,
 			frequency = $7,
 			tax_percentage = $8,
-			start_dt = $9,
-			last_update_dt = $10
+			filing_status = $9,
+			state = $10,
+			retirement_percentage = $11,
+			roth_percentage = $12,
+			hsa_contribution = $13,
+			health_premium = $14,
+			garnishment = $15,
+			regular_hours_cap = $16,
+			overtime_multiplier = $17,
+			start_dt = $18,
+			end_dt = $19,
+			account_id = $20,
+			last_update_dt = $21
 		WHERE
 			id = $1`
 
@@ -171,7 +204,18 @@ func (m *PostgresDBRepo) UpdateIncome(income models.Income) error {
 		income.GrossPay,
 		income.Frequency,
 		income.TaxPercentage,
+		income.FilingStatus,
+		income.State,
+		income.RetirementPercentage,
+		income.RothPercentage,
+		income.HSAContribution,
+		income.HealthPremium,
+		income.Garnishment,
+		income.RegularHoursCap,
+		income.OvertimeMultiplier,
 		income.StartDt,
+		income.EndDt,
+		income.AccountID,
 		time.Now(),
 	)
 
@@ -193,9 +237,11 @@ func (m *PostgresDBRepo) InsertIncome(income models.Income) (int, error) {
 
 	stmt :=
 		`INSERT INTO incomes 
-			(user_id, name, type, rate, hours, amount, frequency, tax_percentage, start_dt, create_dt, last_update_dt)
+			(user_id, name, type, rate, hours, amount, frequency, tax_percentage, filing_status, state, retirement_percentage, roth_percentage,
+			hsa_contribution, health_premium, garnishment, regular_hours_cap, overtime_multiplier, start_dt, end_dt, account_id, create_dt,
+			last_update_dt)
 		values 
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) returning id`
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22) returning id`
 
 	var id int
 	err := m.DB.QueryRowContext(ctx, stmt,
@@ -207,7 +253,18 @@ func (m *PostgresDBRepo) InsertIncome(income models.Income) (int, error) {
 		income.GrossPay,
 		income.Frequency,
 		income.TaxPercentage,
+		income.FilingStatus,
+		income.State,
+		income.RetirementPercentage,
+		income.RothPercentage,
+		income.HSAContribution,
+		income.HealthPremium,
+		income.Garnishment,
+		income.RegularHoursCap,
+		income.OvertimeMultiplier,
 		income.StartDt,
+		income.EndDt,
+		income.AccountID,
 		time.Now(),
 		time.Now(),
 	).Scan(&id)
@@ -221,6 +278,33 @@ func (m *PostgresDBRepo) InsertIncome(income models.Income) (int, error) {
 	return id, nil
 }
 
+// Function UnlinkIncomesFromBankAccount removes a deleted bank account from the incomes that deposit to it
+func (m *PostgresDBRepo) UnlinkIncomesFromBankAccount(accountId int) error {
+	method := "incomes_dbrepo.UnlinkIncomesFromBankAccount"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`UPDATE incomes
+		SET
+			account_id = 0,
+			last_update_dt = $2
+		WHERE
+			account_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, stmt, accountId, time.Now())
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
 func (m *PostgresDBRepo) DeleteIncomeByID(id int) error {
 	method := "incomes_dbrepo.DeleteIncomeByID"
 	klogger.Enter(method)
@@ -0,0 +1,263 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func (m *PostgresDBRepo) GetAllLoanPaymentsByLoanID(loanId int) ([]*models.LoanPayment, error) {
+	method := "loan_payments_dbrepo.GetAllLoanPaymentsByLoanID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, loan_id, user_id, type, amount, payment_dt, create_dt, last_update_dt
+		FROM loan_payments
+		WHERE
+			loan_id = $1
+		ORDER BY payment_dt, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, loanId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	payments, err := scanLoanPayments(rows)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(payments))
+	klogger.Exit(method)
+	return payments, nil
+}
+
+func (m *PostgresDBRepo) GetAllUserLoanPayments(userId int) ([]*models.LoanPayment, error) {
+	method := "loan_payments_dbrepo.GetAllUserLoanPayments"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, loan_id, user_id, type, amount, payment_dt, create_dt, last_update_dt
+		FROM loan_payments
+		WHERE
+			user_id = $1
+		ORDER BY payment_dt, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	payments, err := scanLoanPayments(rows)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(payments))
+	klogger.Exit(method)
+	return payments, nil
+}
+
+func (m *PostgresDBRepo) GetLoanPaymentByID(id int) (models.LoanPayment, error) {
+	method := "loan_payments_dbrepo.GetLoanPaymentByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, loan_id, user_id, type, amount, payment_dt, create_dt, last_update_dt
+		FROM loan_payments
+		WHERE
+			id = $1`
+
+	var p models.LoanPayment
+	row := m.DB.QueryRowContext(ctx, query, id)
+
+	err := row.Scan(
+		&p.ID,
+		&p.LoanID,
+		&p.UserID,
+		&p.Type,
+		&p.Amount,
+		&p.PaymentDt,
+		&p.CreateDt,
+		&p.LastUpdateDt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			klogger.Info(method, constants.NoRowsReturnedMsg)
+			klogger.Exit(method)
+			return p, nil
+		} else {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return p, err
+		}
+	}
+
+	klogger.Exit(method)
+	return p, nil
+}
+
+func (m *PostgresDBRepo) InsertLoanPayment(p models.LoanPayment) (int, error) {
+	method := "loan_payments_dbrepo.InsertLoanPayment"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`INSERT INTO loan_payments
+			(loan_id, user_id, type, amount, payment_dt, create_dt, last_update_dt)
+		values
+			($1, $2, $3, $4, $5, $6, $7) returning id`
+
+	var id int
+	err := m.DB.QueryRowContext(ctx, stmt,
+		p.LoanID,
+		p.UserID,
+		p.Type,
+		p.Amount,
+		p.PaymentDt,
+		time.Now(),
+		time.Now(),
+	).Scan(&id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+func (m *PostgresDBRepo) DeleteLoanPaymentByID(id int) error {
+	method := "loan_payments_dbrepo.DeleteLoanPaymentByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM loan_payments
+		WHERE
+			id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteLoanPaymentsByLoanID(loanId int) error {
+	method := "loan_payments_dbrepo.DeleteLoanPaymentsByLoanID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM loan_payments
+		WHERE
+			loan_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, loanId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteLoanPaymentsByUserID(userId int) error {
+	method := "loan_payments_dbrepo.DeleteLoanPaymentsByUserID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM loan_payments
+		WHERE
+			user_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function scanLoanPayments reads every row of a loan_payments query
+func scanLoanPayments(rows *sql.Rows) ([]*models.LoanPayment, error) {
+	method := "loan_payments_dbrepo.scanLoanPayments"
+	klogger.Enter(method)
+
+	payments := []*models.LoanPayment{}
+
+	for rows.Next() {
+		var p models.LoanPayment
+		err := rows.Scan(
+			&p.ID,
+			&p.LoanID,
+			&p.UserID,
+			&p.Type,
+			&p.Amount,
+			&p.PaymentDt,
+			&p.CreateDt,
+			&p.LastUpdateDt,
+		)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return nil, err
+		}
+
+		payments = append(payments, &p)
+	}
+
+	klogger.Exit(method)
+	return payments, nil
+}
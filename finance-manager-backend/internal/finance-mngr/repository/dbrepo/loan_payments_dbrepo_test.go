@@ -0,0 +1,86 @@
+package dbrepo
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoanPayments(t *testing.T) {
+	method := "loan_payments_dbrepo_test.TestLoanPayments"
+	klogger.Enter(method)
+
+	p1 := models.LoanPayment{LoanID: 1, UserID: 1, Type: constants.LoanPaymentTypePayment, Amount: 500, PaymentDt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)}
+	p2 := models.LoanPayment{LoanID: 1, UserID: 1, Type: constants.LoanPaymentTypeLateFee, Amount: 25, PaymentDt: time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)}
+	p3 := models.LoanPayment{LoanID: 2, UserID: 1, Type: constants.LoanPaymentTypeExtraPrincipal, Amount: 1000, PaymentDt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)}
+	p4 := models.LoanPayment{LoanID: 3, UserID: 2, Type: constants.LoanPaymentTypePayment, Amount: 300, PaymentDt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)}
+
+	var err error
+	for _, lp := range []*models.LoanPayment{&p1, &p2, &p3, &p4} {
+		lp.ID, err = d.InsertLoanPayment(*lp)
+		assert.Nil(t, err)
+		assert.Greater(t, lp.ID, 0)
+	}
+
+	//Get by ID
+	lp, err := d.GetLoanPaymentByID(p2.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, p2.ID, lp.ID)
+	assert.Equal(t, p2.Type, lp.Type)
+	assert.Equal(t, p2.Amount, lp.Amount)
+	assert.True(t, p2.PaymentDt.Equal(lp.PaymentDt))
+
+	//Payment that does not exist
+	lp, err = d.GetLoanPaymentByID(9999)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, lp.ID)
+
+	//Payments are returned in the order they were made
+	parr, err := d.GetAllLoanPaymentsByLoanID(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(parr))
+	assert.Equal(t, p2.ID, parr[0].ID)
+	assert.Equal(t, p1.ID, parr[1].ID)
+
+	parr, err = d.GetAllUserLoanPayments(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(parr))
+
+	//Delete by ID
+	err = d.DeleteLoanPaymentByID(p2.ID)
+	assert.Nil(t, err)
+
+	parr, err = d.GetAllLoanPaymentsByLoanID(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(parr))
+
+	//Delete by loan
+	err = d.DeleteLoanPaymentsByLoanID(1)
+	assert.Nil(t, err)
+
+	parr, err = d.GetAllUserLoanPayments(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(parr))
+	assert.Equal(t, p3.ID, parr[0].ID)
+
+	//Delete by user
+	err = d.DeleteLoanPaymentsByUserID(1)
+	assert.Nil(t, err)
+
+	parr, err = d.GetAllUserLoanPayments(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(parr))
+
+	parr, err = d.GetAllUserLoanPayments(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(parr))
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM loan_payments")
+
+	klogger.Exit(method)
+}
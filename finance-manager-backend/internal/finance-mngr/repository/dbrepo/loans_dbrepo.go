@@ -3,6 +3,7 @@ package dbrepo
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"finance-manager-backend/internal/finance-mngr/constants"
 	"finance-manager-backend/internal/finance-mngr/models"
 	"strings"
@@ -28,6 +29,7 @@ func (m *PostgresDBRepo) GetAllUserLoans(userId int, search string) ([]*models.L
 		query = `
 		SELECT
 			id, user_id, loan_name, total_balance, total_cost, total_principal, total_interest, monthly_payment, interest_rate, loan_term,
+			initial_fixed_months, adjustment_interval, adjusted_rate, initial_cap, periodic_cap, lifetime_cap, rate_steps,
 			create_dt, last_update_dt
 		FROM loans
 		WHERE
@@ -39,6 +41,7 @@ func (m *PostgresDBRepo) GetAllUserLoans(userId int, search string) ([]*models.L
 		query = `
 		SELECT
 			id, user_id, loan_name, total_balance, total_cost, total_principal, total_interest, monthly_payment, interest_rate, loan_term,
+			initial_fixed_months, adjustment_interval, adjusted_rate, initial_cap, periodic_cap, lifetime_cap, rate_steps,
 			create_dt, last_update_dt
 		FROM loans
 		WHERE
@@ -63,6 +66,7 @@ func (m *PostgresDBRepo) GetAllUserLoans(userId int, search string) ([]*models.L
 
 	for rows.Next() {
 		var loan models.Loan
+		var steps []byte
 		err := rows.Scan(
 			&loan.ID,
 			&loan.UserID,
@@ -74,6 +78,13 @@ func (m *PostgresDBRepo) GetAllUserLoans(userId int, search string) ([]*models.L
 			&loan.MonthlyPayment,
 			&loan.InterestRate,
 			&loan.LoanTerm,
+			&loan.RateSchedule.InitialFixedMonths,
+			&loan.RateSchedule.AdjustmentInterval,
+			&loan.RateSchedule.AdjustedRate,
+			&loan.RateSchedule.InitialCap,
+			&loan.RateSchedule.PeriodicCap,
+			&loan.RateSchedule.LifetimeCap,
+			&steps,
 			&loan.CreateDt,
 			&loan.LastUpdateDt,
 		)
@@ -83,6 +94,12 @@ func (m *PostgresDBRepo) GetAllUserLoans(userId int, search string) ([]*models.L
 			return nil, err
 		}
 
+		err = unmarshalLoanRateSteps(steps, &loan)
+		if err != nil {
+			klogger.ExitError(method, "failed to read loan rate steps:\n%v", err)
+			return nil, err
+		}
+
 		recordCount = recordCount + 1
 		loans = append(loans, &loan)
 	}
@@ -100,12 +117,14 @@ func (m *PostgresDBRepo) GetLoanByID(id int) (models.Loan, error) {
 	defer cancel()
 
 	query := `select id, user_id, loan_name, total_balance, total_cost, total_principal, total_interest, monthly_payment, interest_rate, loan_term,
+			initial_fixed_months, adjustment_interval, adjusted_rate, initial_cap, periodic_cap, lifetime_cap, rate_steps,
 		create_dt, last_update_dt
 		FROM loans
 		WHERE 
 			id = $1`
 
 	var loan models.Loan
+	var steps []byte
 	row := m.DB.QueryRowContext(ctx, query, id)
 
 	err := row.Scan(
@@ -119,6 +138,13 @@ func (m *PostgresDBRepo) GetLoanByID(id int) (models.Loan, error) {
 		&loan.MonthlyPayment,
 		&loan.InterestRate,
 		&loan.LoanTerm,
+		&loan.RateSchedule.InitialFixedMonths,
+		&loan.RateSchedule.AdjustmentInterval,
+		&loan.RateSchedule.AdjustedRate,
+		&loan.RateSchedule.InitialCap,
+		&loan.RateSchedule.PeriodicCap,
+		&loan.RateSchedule.LifetimeCap,
+		&steps,
 		&loan.CreateDt,
 		&loan.LastUpdateDt,
 	)
@@ -135,6 +161,12 @@ func (m *PostgresDBRepo) GetLoanByID(id int) (models.Loan, error) {
 
 	}
 
+	err = unmarshalLoanRateSteps(steps, &loan)
+	if err != nil {
+		klogger.ExitError(method, "failed to read loan rate steps:\n%v", err)
+		return loan, err
+	}
+
 	klogger.Exit(method)
 	return loan, nil
 }
@@ -172,12 +204,20 @@ func (m *PostgresDBRepo) InsertLoan(loan models.Loan) (int, error) {
 
 	stmt :=
 		`INSERT INTO loans 
-			(user_id, loan_name, total_balance, total_cost, total_principal, total_interest, monthly_payment, interest_rate, loan_term, create_dt, last_update_dt)
+			(user_id, loan_name, total_balance, total_cost, total_principal, total_interest, monthly_payment, interest_rate, loan_term,
+			initial_fixed_months, adjustment_interval, adjusted_rate, initial_cap, periodic_cap, lifetime_cap, rate_steps,
+			create_dt, last_update_dt)
 		values 
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) returning id`
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18) returning id`
+
+	steps, err := json.Marshal(loan.RateSchedule.Steps)
+	if err != nil {
+		klogger.ExitError(method, "failed to write loan rate steps:\n%v", err)
+		return -1, err
+	}
 
 	var id int
-	err := m.DB.QueryRowContext(ctx, stmt,
+	err = m.DB.QueryRowContext(ctx, stmt,
 		loan.UserID,
 		loan.Name,
 		loan.Total,
@@ -187,6 +227,13 @@ func (m *PostgresDBRepo) InsertLoan(loan models.Loan) (int, error) {
 		loan.MonthlyPayment,
 		loan.InterestRate,
 		loan.LoanTerm,
+		loan.RateSchedule.InitialFixedMonths,
+		loan.RateSchedule.AdjustmentInterval,
+		loan.RateSchedule.AdjustedRate,
+		loan.RateSchedule.InitialCap,
+		loan.RateSchedule.PeriodicCap,
+		loan.RateSchedule.LifetimeCap,
+		string(steps),
 		time.Now(),
 		time.Now(),
 	).Scan(&id)
@@ -218,11 +265,24 @@ func (m *PostgresDBRepo) UpdateLoan(loan models.Loan) error {
 			monthly_payment = $7,
 			interest_rate = $8,
 			loan_term = $9,
-			last_update_dt = $10
+			initial_fixed_months = $10,
+			adjustment_interval = $11,
+			adjusted_rate = $12,
+			initial_cap = $13,
+			periodic_cap = $14,
+			lifetime_cap = $15,
+			rate_steps = $16,
+			last_update_dt = $17
 		WHERE
 			id = $1`
 
-	_, err := m.DB.ExecContext(ctx, stmt,
+	steps, err := json.Marshal(loan.RateSchedule.Steps)
+	if err != nil {
+		klogger.ExitError(method, "failed to write loan rate steps:\n%v", err)
+		return err
+	}
+
+	_, err = m.DB.ExecContext(ctx, stmt,
 		loan.ID,
 		loan.Name,
 		loan.Total,
@@ -232,6 +292,13 @@ func (m *PostgresDBRepo) UpdateLoan(loan models.Loan) error {
 		loan.MonthlyPayment,
 		loan.InterestRate,
 		loan.LoanTerm,
+		loan.RateSchedule.InitialFixedMonths,
+		loan.RateSchedule.AdjustmentInterval,
+		loan.RateSchedule.AdjustedRate,
+		loan.RateSchedule.InitialCap,
+		loan.RateSchedule.PeriodicCap,
+		loan.RateSchedule.LifetimeCap,
+		string(steps),
 		time.Now(),
 	)
 
@@ -267,3 +334,23 @@ func (m *PostgresDBRepo) DeleteLoansByUserID(id int) error {
 	klogger.Exit(method)
 	return nil
 }
+
+// Function unmarshalLoanRateSteps reads the rate_steps column of a loan into its rate schedule
+func unmarshalLoanRateSteps(steps []byte, loan *models.Loan) error {
+	method := "loans_dbrepo.unmarshalLoanRateSteps"
+	klogger.Enter(method)
+
+	if len(steps) == 0 {
+		klogger.Exit(method)
+		return nil
+	}
+
+	err := json.Unmarshal(steps, &loan.RateSchedule.Steps)
+	if err != nil {
+		klogger.ExitError(method, "failed to unmarshal rate steps:\n%v", err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
@@ -0,0 +1,260 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func (m *PostgresDBRepo) GetAllSavingsGoalContributionsBySavingsGoalID(goalId int) ([]*models.SavingsGoalContribution, error) {
+	method := "savings_goal_contributions_dbrepo.GetAllSavingsGoalContributionsBySavingsGoalID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, savings_goal_id, user_id, amount, contribution_dt, create_dt, last_update_dt
+		FROM savings_goal_contributions
+		WHERE
+			savings_goal_id = $1
+		ORDER BY contribution_dt, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, goalId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	contributions, err := scanSavingsGoalContributions(rows)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(contributions))
+	klogger.Exit(method)
+	return contributions, nil
+}
+
+func (m *PostgresDBRepo) GetAllUserSavingsGoalContributions(userId int) ([]*models.SavingsGoalContribution, error) {
+	method := "savings_goal_contributions_dbrepo.GetAllUserSavingsGoalContributions"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, savings_goal_id, user_id, amount, contribution_dt, create_dt, last_update_dt
+		FROM savings_goal_contributions
+		WHERE
+			user_id = $1
+		ORDER BY contribution_dt, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	contributions, err := scanSavingsGoalContributions(rows)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(contributions))
+	klogger.Exit(method)
+	return contributions, nil
+}
+
+func (m *PostgresDBRepo) GetSavingsGoalContributionByID(id int) (models.SavingsGoalContribution, error) {
+	method := "savings_goal_contributions_dbrepo.GetSavingsGoalContributionByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, savings_goal_id, user_id, amount, contribution_dt, create_dt, last_update_dt
+		FROM savings_goal_contributions
+		WHERE
+			id = $1`
+
+	var c models.SavingsGoalContribution
+	row := m.DB.QueryRowContext(ctx, query, id)
+
+	err := row.Scan(
+		&c.ID,
+		&c.SavingsGoalID,
+		&c.UserID,
+		&c.Amount,
+		&c.ContributionDt,
+		&c.CreateDt,
+		&c.LastUpdateDt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			klogger.Info(method, constants.NoRowsReturnedMsg)
+			klogger.Exit(method)
+			return c, nil
+		} else {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return c, err
+		}
+	}
+
+	klogger.Exit(method)
+	return c, nil
+}
+
+func (m *PostgresDBRepo) InsertSavingsGoalContribution(c models.SavingsGoalContribution) (int, error) {
+	method := "savings_goal_contributions_dbrepo.InsertSavingsGoalContribution"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`INSERT INTO savings_goal_contributions
+			(savings_goal_id, user_id, amount, contribution_dt, create_dt, last_update_dt)
+		values
+			($1, $2, $3, $4, $5, $6) returning id`
+
+	var id int
+	err := m.DB.QueryRowContext(ctx, stmt,
+		c.SavingsGoalID,
+		c.UserID,
+		c.Amount,
+		c.ContributionDt,
+		time.Now(),
+		time.Now(),
+	).Scan(&id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+func (m *PostgresDBRepo) DeleteSavingsGoalContributionByID(id int) error {
+	method := "savings_goal_contributions_dbrepo.DeleteSavingsGoalContributionByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM savings_goal_contributions
+		WHERE
+			id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteSavingsGoalContributionsBySavingsGoalID(goalId int) error {
+	method := "savings_goal_contributions_dbrepo.DeleteSavingsGoalContributionsBySavingsGoalID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM savings_goal_contributions
+		WHERE
+			savings_goal_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, goalId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteSavingsGoalContributionsByUserID(userId int) error {
+	method := "savings_goal_contributions_dbrepo.DeleteSavingsGoalContributionsByUserID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM savings_goal_contributions
+		WHERE
+			user_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function scanSavingsGoalContributions reads every row of a savings_goal_contributions query
+func scanSavingsGoalContributions(rows *sql.Rows) ([]*models.SavingsGoalContribution, error) {
+	method := "savings_goal_contributions_dbrepo.scanSavingsGoalContributions"
+	klogger.Enter(method)
+
+	contributions := []*models.SavingsGoalContribution{}
+
+	for rows.Next() {
+		var c models.SavingsGoalContribution
+		err := rows.Scan(
+			&c.ID,
+			&c.SavingsGoalID,
+			&c.UserID,
+			&c.Amount,
+			&c.ContributionDt,
+			&c.CreateDt,
+			&c.LastUpdateDt,
+		)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return nil, err
+		}
+
+		contributions = append(contributions, &c)
+	}
+
+	klogger.Exit(method)
+	return contributions, nil
+}
@@ -0,0 +1,81 @@
+package dbrepo
+
+import (
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSavingsGoalContributions(t *testing.T) {
+	method := "savings_goal_contributions_dbrepo_test.TestSavingsGoalContributions"
+	klogger.Enter(method)
+
+	c1 := models.SavingsGoalContribution{SavingsGoalID: 1, UserID: 1, Amount: 100, ContributionDt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c2 := models.SavingsGoalContribution{SavingsGoalID: 1, UserID: 1, Amount: -25.5, ContributionDt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)}
+	c3 := models.SavingsGoalContribution{SavingsGoalID: 2, UserID: 1, Amount: 50, ContributionDt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c4 := models.SavingsGoalContribution{SavingsGoalID: 3, UserID: 2, Amount: 50, ContributionDt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	var err error
+	for _, c := range []*models.SavingsGoalContribution{&c1, &c2, &c3, &c4} {
+		c.ID, err = d.InsertSavingsGoalContribution(*c)
+		assert.Nil(t, err)
+		assert.Greater(t, c.ID, 0)
+	}
+
+	//Get by ID
+	c, err := d.GetSavingsGoalContributionByID(c2.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, c2.ID, c.ID)
+	assert.Equal(t, c2.Amount, c.Amount)
+	assert.True(t, c2.ContributionDt.Equal(c.ContributionDt))
+
+	//Contribution that does not exist
+	c, err = d.GetSavingsGoalContributionByID(9999)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, c.ID)
+
+	carr, err := d.GetAllSavingsGoalContributionsBySavingsGoalID(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(carr))
+
+	carr, err = d.GetAllUserSavingsGoalContributions(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(carr))
+
+	//Delete by ID
+	err = d.DeleteSavingsGoalContributionByID(c2.ID)
+	assert.Nil(t, err)
+
+	carr, err = d.GetAllSavingsGoalContributionsBySavingsGoalID(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(carr))
+
+	//Delete by goal
+	err = d.DeleteSavingsGoalContributionsBySavingsGoalID(1)
+	assert.Nil(t, err)
+
+	carr, err = d.GetAllUserSavingsGoalContributions(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(carr))
+	assert.Equal(t, c3.ID, carr[0].ID)
+
+	//Delete by user
+	err = d.DeleteSavingsGoalContributionsByUserID(1)
+	assert.Nil(t, err)
+
+	carr, err = d.GetAllUserSavingsGoalContributions(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(carr))
+
+	carr, err = d.GetAllUserSavingsGoalContributions(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(carr))
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM savings_goal_contributions")
+
+	klogger.Exit(method)
+}
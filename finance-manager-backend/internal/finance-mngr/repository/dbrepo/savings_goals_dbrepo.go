@@ -0,0 +1,277 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"strings"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func (m *PostgresDBRepo) GetAllUserSavingsGoals(userId int, search string) ([]*models.SavingsGoal, error) {
+	method := "savings_goals_dbrepo.GetAllUserSavingsGoals"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	var query string
+	var err error
+	var rows *sql.Rows
+
+	if search != "" {
+		search = strings.ToLower(search)
+
+		query = `
+		SELECT
+			id, user_id, name, target, deadline, income_id, contribution_per_pay,
+			create_dt, last_update_dt
+		FROM savings_goals
+		WHERE
+			user_id = $1
+			AND
+			LOWER(name) like '%' || $2 || '%'
+		ORDER BY deadline, id`
+		rows, err = m.DB.QueryContext(ctx, query, userId, search)
+	} else {
+		query = `
+		SELECT
+			id, user_id, name, target, deadline, income_id, contribution_per_pay,
+			create_dt, last_update_dt
+		FROM savings_goals
+		WHERE
+			user_id = $1
+		ORDER BY deadline, id`
+		rows, err = m.DB.QueryContext(ctx, query, userId)
+	}
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	goals := []*models.SavingsGoal{}
+
+	for rows.Next() {
+		var g models.SavingsGoal
+		err := rows.Scan(
+			&g.ID,
+			&g.UserID,
+			&g.Name,
+			&g.Target,
+			&g.Deadline,
+			&g.IncomeID,
+			&g.ContributionPerPay,
+			&g.CreateDt,
+			&g.LastUpdateDt,
+		)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return nil, err
+		}
+
+		goals = append(goals, &g)
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(goals))
+	klogger.Exit(method)
+	return goals, nil
+}
+
+func (m *PostgresDBRepo) GetSavingsGoalByID(id int) (models.SavingsGoal, error) {
+	method := "savings_goals_dbrepo.GetSavingsGoalByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, user_id, name, target, deadline, income_id, contribution_per_pay,
+			create_dt, last_update_dt
+		FROM savings_goals
+		WHERE
+			id = $1`
+
+	var g models.SavingsGoal
+	row := m.DB.QueryRowContext(ctx, query, id)
+
+	err := row.Scan(
+		&g.ID,
+		&g.UserID,
+		&g.Name,
+		&g.Target,
+		&g.Deadline,
+		&g.IncomeID,
+		&g.ContributionPerPay,
+		&g.CreateDt,
+		&g.LastUpdateDt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			klogger.Info(method, constants.NoRowsReturnedMsg)
+			klogger.Exit(method)
+			return g, nil
+		} else {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return g, err
+		}
+	}
+
+	klogger.Exit(method)
+	return g, nil
+}
+
+func (m *PostgresDBRepo) UpdateSavingsGoal(g models.SavingsGoal) error {
+	method := "savings_goals_dbrepo.UpdateSavingsGoal"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`UPDATE savings_goals
+		SET
+			name = $2,
+			target = $3,
+			deadline = $4,
+			income_id = $5,
+			contribution_per_pay = $6,
+			last_update_dt = $7
+		WHERE
+			id = $1`
+
+	_, err := m.DB.ExecContext(ctx, stmt,
+		g.ID,
+		g.Name,
+		g.Target,
+		g.Deadline,
+		g.IncomeID,
+		g.ContributionPerPay,
+		time.Now(),
+	)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) InsertSavingsGoal(g models.SavingsGoal) (int, error) {
+	method := "savings_goals_dbrepo.InsertSavingsGoal"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`INSERT INTO savings_goals
+			(user_id, name, target, deadline, income_id, contribution_per_pay, create_dt, last_update_dt)
+		values
+			($1, $2, $3, $4, $5, $6, $7, $8) returning id`
+
+	var id int
+	err := m.DB.QueryRowContext(ctx, stmt,
+		g.UserID,
+		g.Name,
+		g.Target,
+		g.Deadline,
+		g.IncomeID,
+		g.ContributionPerPay,
+		time.Now(),
+		time.Now(),
+	).Scan(&id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+// Function UnlinkSavingsGoalsFromIncome removes a deleted income from the savings goals it is linked to
+func (m *PostgresDBRepo) UnlinkSavingsGoalsFromIncome(incomeId int) error {
+	method := "savings_goals_dbrepo.UnlinkSavingsGoalsFromIncome"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`UPDATE savings_goals
+		SET
+			income_id = 0,
+			last_update_dt = $2
+		WHERE
+			income_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, stmt, incomeId, time.Now())
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteSavingsGoalByID(id int) error {
+	method := "savings_goals_dbrepo.DeleteSavingsGoalByID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM savings_goals
+		WHERE
+			id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteSavingsGoalsByUserID(userId int) error {
+	method := "savings_goals_dbrepo.DeleteSavingsGoalsByUserID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM savings_goals
+		WHERE
+			user_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
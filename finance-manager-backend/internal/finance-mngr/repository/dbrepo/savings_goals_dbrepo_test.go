@@ -0,0 +1,93 @@
+package dbrepo
+
+import (
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSavingsGoals(t *testing.T) {
+	method := "savings_goals_dbrepo_test.TestSavingsGoals"
+	klogger.Enter(method)
+
+	g1 := models.SavingsGoal{UserID: 1, Name: "Vacation", Target: 3000, Deadline: time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC), IncomeID: 4, ContributionPerPay: 100}
+	g2 := models.SavingsGoal{UserID: 1, Name: "Car", Target: 10000, Deadline: time.Date(2029, 1, 1, 0, 0, 0, 0, time.UTC)}
+	g3 := models.SavingsGoal{UserID: 2, Name: "House", Target: 50000, Deadline: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC), IncomeID: 4}
+
+	var err error
+	for _, g := range []*models.SavingsGoal{&g1, &g2, &g3} {
+		g.ID, err = d.InsertSavingsGoal(*g)
+		assert.Nil(t, err)
+		assert.Greater(t, g.ID, 0)
+	}
+
+	//Goals are returned in deadline order
+	garr, err := d.GetAllUserSavingsGoals(1, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(garr))
+	assert.Equal(t, g2.ID, garr[0].ID)
+
+	garr, err = d.GetAllUserSavingsGoals(1, "vaca")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(garr))
+	assert.Equal(t, g1.ID, garr[0].ID)
+
+	//Get by ID
+	g, err := d.GetSavingsGoalByID(g1.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, g1.Name, g.Name)
+	assert.Equal(t, g1.Target, g.Target)
+	assert.Equal(t, g1.IncomeID, g.IncomeID)
+	assert.Equal(t, g1.ContributionPerPay, g.ContributionPerPay)
+	assert.True(t, g1.Deadline.Equal(g.Deadline))
+
+	//Goal that does not exist
+	g, err = d.GetSavingsGoalByID(9999)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, g.ID)
+
+	//Update
+	g1.Target = 4000
+	err = d.UpdateSavingsGoal(g1)
+	assert.Nil(t, err)
+
+	g, err = d.GetSavingsGoalByID(g1.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 4000.0, g.Target)
+
+	//Unlink a deleted income
+	err = d.UnlinkSavingsGoalsFromIncome(4)
+	assert.Nil(t, err)
+
+	g, err = d.GetSavingsGoalByID(g3.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, g.IncomeID)
+
+	//Delete by ID
+	err = d.DeleteSavingsGoalByID(g2.ID)
+	assert.Nil(t, err)
+
+	garr, err = d.GetAllUserSavingsGoals(1, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(garr))
+
+	//Delete by user
+	err = d.DeleteSavingsGoalsByUserID(1)
+	assert.Nil(t, err)
+
+	garr, err = d.GetAllUserSavingsGoals(1, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(garr))
+
+	garr, err = d.GetAllUserSavingsGoals(2, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(garr))
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM savings_goals")
+
+	klogger.Exit(method)
+}
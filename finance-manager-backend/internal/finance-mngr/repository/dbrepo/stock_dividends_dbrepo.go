@@ -0,0 +1,95 @@
+package dbrepo
+
+import (
+	"context"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Function GetStockDividendsByTicker returns the dividends of ticker t ordered by ex-dividend date. Dividends of every ticker are returned if t is empty
+func (m *PostgresDBRepo) GetStockDividendsByTicker(t string) ([]models.StockDividend, error) {
+	method := "stock_dividends_dbrepo.GetStockDividendsByTicker"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, ticker, cash_amount, ex_dividend_dt, pay_dt, create_dt, last_update_dt
+		FROM stock_dividends
+		WHERE
+			($1 = '' OR ticker = $1)
+		ORDER BY ex_dividend_dt, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, t)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	dividends := []models.StockDividend{}
+
+	for rows.Next() {
+		var d models.StockDividend
+
+		err := rows.Scan(
+			&d.ID,
+			&d.Ticker,
+			&d.CashAmount,
+			&d.ExDividendDt,
+			&d.PayDt,
+			&d.CreateDt,
+			&d.LastUpdateDt,
+		)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return nil, err
+		}
+
+		dividends = append(dividends, d)
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(dividends))
+	klogger.Exit(method)
+	return dividends, nil
+}
+
+func (m *PostgresDBRepo) InsertStockDividend(d models.StockDividend) (int, error) {
+	method := "stock_dividends_dbrepo.InsertStockDividend"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`INSERT INTO stock_dividends
+			(ticker, cash_amount, ex_dividend_dt, pay_dt, create_dt, last_update_dt)
+		values
+			($1, $2, $3, $4, $5, $6) returning id`
+
+	var id int
+	err := m.DB.QueryRowContext(ctx, stmt,
+		d.Ticker,
+		d.CashAmount,
+		d.ExDividendDt,
+		d.PayDt,
+		time.Now(),
+		time.Now(),
+	).Scan(&id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
@@ -0,0 +1,310 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Function GetStockSplitsByTicker returns the splits of ticker t ordered by execution date. Splits of every ticker are returned if t is empty
+func (m *PostgresDBRepo) GetStockSplitsByTicker(t string) ([]models.StockSplit, error) {
+	method := "stock_splits_dbrepo.GetStockSplitsByTicker"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, ticker, execution_dt, split_from, split_to, create_dt, last_update_dt
+		FROM stock_splits
+		WHERE
+			($1 = '' OR ticker = $1)
+		ORDER BY execution_dt, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, t)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	splits := []models.StockSplit{}
+
+	for rows.Next() {
+		var s models.StockSplit
+
+		err := rows.Scan(
+			&s.ID,
+			&s.Ticker,
+			&s.ExecutionDt,
+			&s.SplitFrom,
+			&s.SplitTo,
+			&s.CreateDt,
+			&s.LastUpdateDt,
+		)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return nil, err
+		}
+
+		splits = append(splits, s)
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(splits))
+	klogger.Exit(method)
+	return splits, nil
+}
+
+// Function InsertStockSplit inserts split s without adjusting any stock data or user stocks for it
+func (m *PostgresDBRepo) InsertStockSplit(s models.StockSplit) (int, error) {
+	method := "stock_splits_dbrepo.InsertStockSplit"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	tx, err := m.DB.BeginTx(ctx, nil)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	defer tx.Rollback()
+
+	id, err := insertStockSplit(ctx, tx, s)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+// Function SaveStockSplit inserts split s, adjusts the stock data of its ticker for it and splits the user stocks held across it in
+// one transaction, so that a failure part way through does not leave the split saved with its prices or holdings unadjusted
+func (m *PostgresDBRepo) SaveStockSplit(s models.StockSplit) (int, error) {
+	method := "stock_splits_dbrepo.SaveStockSplit"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	tx, err := m.DB.BeginTx(ctx, nil)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	defer tx.Rollback()
+
+	id, err := insertStockSplit(ctx, tx, s)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	err = adjustStockDataForSplit(ctx, tx, s)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	err = splitUserStocks(ctx, tx, s)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+// Function SplitUserStocks splits each user stock held across the execution date of split s in two. The first expires just before the
+// split and the second takes effect on it with the split quantity. User stocks that have already been split no longer span the
+// execution date, so this can be safely rerun
+func (m *PostgresDBRepo) SplitUserStocks(s models.StockSplit) error {
+	method := "stock_splits_dbrepo.SplitUserStocks"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	tx, err := m.DB.BeginTx(ctx, nil)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	defer tx.Rollback()
+
+	err = splitUserStocks(ctx, tx, s)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function insertStockSplit inserts split s as part of transaction tx
+func insertStockSplit(ctx context.Context, tx *sql.Tx, s models.StockSplit) (int, error) {
+	method := "stock_splits_dbrepo.insertStockSplit"
+	klogger.Enter(method)
+
+	stmt :=
+		`INSERT INTO stock_splits
+			(ticker, execution_dt, split_from, split_to, create_dt, last_update_dt)
+		values
+			($1, $2, $3, $4, $5, $6) returning id`
+
+	var id int
+	err := tx.QueryRowContext(ctx, stmt,
+		s.Ticker,
+		s.ExecutionDt,
+		s.SplitFrom,
+		s.SplitTo,
+		time.Now(),
+		time.Now(),
+	).Scan(&id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+// Function adjustStockDataForSplit divides the prices of the bars of a stock from before its split s by the split's ratio as part of
+// transaction tx. Bars fetched after the split took effect are already adjusted for it and are left as they are, so this must only be
+// run once per split
+func adjustStockDataForSplit(ctx context.Context, tx *sql.Tx, s models.StockSplit) error {
+	method := "stock_splits_dbrepo.adjustStockDataForSplit"
+	klogger.Enter(method)
+
+	stmt :=
+		`UPDATE stock_data
+		SET
+			high = high / $3,
+			low = low / $3,
+			open = open / $3,
+			close = close / $3,
+			last_update_dt = $4
+		WHERE
+			ticker = $1
+			AND date < $2
+			AND create_dt < $2`
+
+	_, err := tx.ExecContext(ctx, stmt, s.Ticker, s.ExecutionDt, s.GetRatio(), time.Now())
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	stmt =
+		`UPDATE stocks
+		SET
+			high = high / $3,
+			low = low / $3,
+			open = open / $3,
+			close = close / $3,
+			last_update_dt = $4
+		WHERE
+			ticker = $1
+			AND date < $2`
+
+	_, err = tx.ExecContext(ctx, stmt, s.Ticker, s.ExecutionDt, s.GetRatio(), time.Now())
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function splitUserStocks splits each user stock held across the execution date of split s in two as part of transaction tx
+func splitUserStocks(ctx context.Context, tx *sql.Tx, s models.StockSplit) error {
+	method := "stock_splits_dbrepo.splitUserStocks"
+	klogger.Enter(method)
+
+	stmt :=
+		`INSERT INTO user_stocks
+			(user_id, ticker, quantity, type, effective_dt, expiration_dt, create_dt, last_update_dt)
+		SELECT
+			user_id, ticker, quantity * $3, type, $2, expiration_dt, $4, $4
+		FROM user_stocks
+		WHERE
+			ticker = $1
+			AND effective_dt < $2
+			AND (expiration_dt IS NULL OR expiration_dt >= $2)`
+
+	_, err := tx.ExecContext(ctx, stmt, s.Ticker, s.ExecutionDt, s.GetRatio(), time.Now())
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	stmt =
+		`UPDATE user_stocks
+		SET
+			expiration_dt = $3,
+			last_update_dt = $4
+		WHERE
+			ticker = $1
+			AND effective_dt < $2
+			AND (expiration_dt IS NULL OR expiration_dt >= $2)`
+
+	_, err = tx.ExecContext(ctx, stmt, s.Ticker, s.ExecutionDt, s.ExecutionDt.Add(-1*time.Millisecond), time.Now())
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
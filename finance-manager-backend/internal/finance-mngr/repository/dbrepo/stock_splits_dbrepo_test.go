@@ -0,0 +1,79 @@
+package dbrepo
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveStockSplit(t *testing.T) {
+	method := "stock_splits_dbrepo_test.TestSaveStockSplit"
+	klogger.Enter(method)
+
+	s := models.StockSplit{
+		Ticker:      "TEST1",
+		ExecutionDt: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+		SplitFrom:   1,
+		SplitTo:     2,
+	}
+
+	us := models.UserStock{
+		UserId:      1,
+		Type:        constants.UserStockTypeOwn,
+		Ticker:      "TEST1",
+		Quantity:    3,
+		EffectiveDt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	_, err := d.InsertUserStock(us)
+	assert.Nil(t, err)
+
+	sd := models.StockData{
+		Ticker:   "TEST1",
+		Close:    100,
+		Date:     time.Date(2022, 5, 31, 0, 0, 0, 0, time.UTC),
+		CreateDt: time.Date(2022, 5, 31, 0, 0, 0, 0, time.UTC),
+	}
+	p.GormDB.Create(&sd)
+
+	id, err := d.SaveStockSplit(s)
+	assert.Nil(t, err)
+	assert.Greater(t, id, 0)
+
+	//The split is saved
+	sarr, err := d.GetStockSplitsByTicker("TEST1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(sarr))
+	assert.Equal(t, id, sarr[0].ID)
+
+	//Bars from before the split are adjusted for it
+	var sdDb models.StockData
+	err = p.GormDB.First(&sdDb, sd.ID).Error
+	assert.Nil(t, err)
+	assert.Equal(t, 50.0, sdDb.Close)
+
+	//The user stock held across the split expires before it and is followed by the split quantity
+	usl, err := d.GetAllUserStocksByDateRange(1, "TEST1", time.Time{}, time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(usl))
+
+	for _, u := range usl {
+		if u.EffectiveDt.Equal(s.ExecutionDt) {
+			assert.Equal(t, 6.0, u.Quantity)
+		} else {
+			assert.Equal(t, 3.0, u.Quantity)
+			assert.True(t, u.ExpirationDt.Time.Before(s.ExecutionDt))
+		}
+	}
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM user_stocks")
+	p.GormDB.Exec("DELETE FROM stock_data")
+	p.GormDB.Exec("DELETE FROM stock_splits")
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,252 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Function GetAllUserStockTransactions returns a user's stock transactions in the order they were made. Only the transactions of ticker
+// are returned if it is included
+func (m *PostgresDBRepo) GetAllUserStockTransactions(userId int, ticker string) ([]*models.StockTransaction, error) {
+	method := "stock_transactions_dbrepo.GetAllUserStockTransactions"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, user_id, ticker, type, quantity, price, fees, lot_method, lots, transaction_dt, create_dt, last_update_dt
+		FROM stock_transactions
+		WHERE
+			user_id = $1
+			AND ($2 = '' OR ticker = $2)
+		ORDER BY transaction_dt, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, userId, ticker)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	transactions := []*models.StockTransaction{}
+
+	for rows.Next() {
+		var t models.StockTransaction
+		var lots []byte
+
+		err := rows.Scan(
+			&t.ID,
+			&t.UserID,
+			&t.Ticker,
+			&t.Type,
+			&t.Quantity,
+			&t.Price,
+			&t.Fees,
+			&t.LotMethod,
+			&lots,
+			&t.TransactionDt,
+			&t.CreateDt,
+			&t.LastUpdateDt,
+		)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return nil, err
+		}
+
+		err = unmarshalStockTransactionLots(lots, &t)
+		if err != nil {
+			klogger.ExitError(method, err.Error())
+			return nil, err
+		}
+
+		transactions = append(transactions, &t)
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(transactions))
+	klogger.Exit(method)
+	return transactions, nil
+}
+
+func (m *PostgresDBRepo) InsertStockTransaction(t models.StockTransaction) (int, error) {
+	method := "stock_transactions_dbrepo.InsertStockTransaction"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	tx, err := m.DB.BeginTx(ctx, nil)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	defer tx.Rollback()
+
+	id, err := insertStockTransaction(ctx, tx, t)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+// Function SaveStockOperation records a buy or sell of stock in one transaction. The prior user stock usp is updated if it exists,
+// the user stock us the operation leaves the user holding is inserted if its quantity is greater than 0, and the stock transaction t is inserted
+func (m *PostgresDBRepo) SaveStockOperation(usp models.UserStock, us models.UserStock, t models.StockTransaction) (int, error) {
+	method := "stock_transactions_dbrepo.SaveStockOperation"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	tx, err := m.DB.BeginTx(ctx, nil)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	defer tx.Rollback()
+
+	if usp.ID != 0 {
+		err = updateUserStock(ctx, tx, usp)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return -1, err
+		}
+	}
+
+	if us.Quantity > 0 {
+		_, err = insertUserStock(ctx, tx, us)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return -1, err
+		}
+	}
+
+	id, err := insertStockTransaction(ctx, tx, t)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+func (m *PostgresDBRepo) DeleteStockTransactionsByUserID(userId int) error {
+	method := "stock_transactions_dbrepo.DeleteStockTransactionsByUserID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM stock_transactions
+		WHERE
+			user_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function unmarshalStockTransactionLots reads the lots column of a stock transaction into the lots it selects
+func unmarshalStockTransactionLots(lots []byte, t *models.StockTransaction) error {
+	method := "stock_transactions_dbrepo.unmarshalStockTransactionLots"
+	klogger.Enter(method)
+
+	if len(lots) == 0 {
+		klogger.Exit(method)
+		return nil
+	}
+
+	err := json.Unmarshal(lots, &t.Lots)
+	if err != nil {
+		klogger.ExitError(method, "failed to unmarshal stock transaction lots:\n%v", err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function insertStockTransaction inserts stock transaction t as part of transaction tx
+func insertStockTransaction(ctx context.Context, tx *sql.Tx, t models.StockTransaction) (int, error) {
+	method := "stock_transactions_dbrepo.insertStockTransaction"
+	klogger.Enter(method)
+
+	stmt :=
+		`INSERT INTO stock_transactions
+			(user_id, ticker, type, quantity, price, fees, lot_method, lots, transaction_dt, create_dt, last_update_dt)
+		values
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) returning id`
+
+	lots, err := json.Marshal(t.Lots)
+	if err != nil {
+		klogger.ExitError(method, "failed to write stock transaction lots:\n%v", err)
+		return -1, err
+	}
+
+	var id int
+	err = tx.QueryRowContext(ctx, stmt,
+		t.UserID,
+		t.Ticker,
+		t.Type,
+		t.Quantity,
+		t.Price,
+		t.Fees,
+		t.LotMethod,
+		string(lots),
+		t.TransactionDt,
+		time.Now(),
+		time.Now(),
+	).Scan(&id)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
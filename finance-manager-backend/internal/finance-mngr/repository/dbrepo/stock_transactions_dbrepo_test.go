@@ -0,0 +1,78 @@
+package dbrepo
+
+import (
+	"database/sql"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveStockOperation(t *testing.T) {
+	method := "stock_transactions_dbrepo_test.TestSaveStockOperation"
+	klogger.Enter(method)
+
+	usp := models.UserStock{
+		UserId:      1,
+		Type:        constants.UserStockTypeOwn,
+		Ticker:      "TEST1",
+		Quantity:    2,
+		EffectiveDt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	var err error
+	usp.ID, err = d.InsertUserStock(usp)
+	assert.Nil(t, err)
+
+	//Buying 3 more shares expires the prior user stock and leaves the user holding 5
+	usp.ExpirationDt = sql.NullTime{Time: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC).Add(-1 * time.Millisecond), Valid: true}
+
+	us := models.UserStock{
+		UserId:      1,
+		Type:        constants.UserStockTypeOwn,
+		Ticker:      "TEST1",
+		Quantity:    5,
+		EffectiveDt: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	st := models.StockTransaction{
+		UserID:        1,
+		Ticker:        "TEST1",
+		Type:          constants.StockTransactionTypeBuy,
+		Quantity:      3,
+		Price:         100,
+		TransactionDt: us.EffectiveDt,
+	}
+
+	id, err := d.SaveStockOperation(usp, us, st)
+	assert.Nil(t, err)
+	assert.Greater(t, id, 0)
+
+	tarr, err := d.GetAllUserStockTransactions(1, "TEST1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tarr))
+	assert.Equal(t, id, tarr[0].ID)
+	assert.Equal(t, 100.0, tarr[0].Price)
+
+	usl, err := d.GetAllUserStocksByDateRange(1, "TEST1", time.Time{}, time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(usl))
+
+	for _, u := range usl {
+		if u.ID == usp.ID {
+			assert.Equal(t, 2.0, u.Quantity)
+			assert.True(t, u.ExpirationDt.Time.Before(us.EffectiveDt))
+		} else {
+			assert.Equal(t, 5.0, u.Quantity)
+		}
+	}
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM user_stocks")
+	p.GormDB.Exec("DELETE FROM stock_transactions")
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,194 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func (m *PostgresDBRepo) GetAllUserSummarySnapshots(userId int, sd time.Time, ed time.Time) ([]*models.SummarySnapshot, error) {
+	method := "summary_snapshots_dbrepo.GetAllUserSummarySnapshots"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, user_id, snapshot_dt, total_income, total_cost, net_funds, loan_balance, credit_card_balance, total_balance,
+			credit_utilization, account_balance, net_worth, create_dt
+		FROM summary_snapshots
+		WHERE
+			user_id = $1
+			AND snapshot_dt >= $2
+			AND snapshot_dt <= $3
+		ORDER BY snapshot_dt, id`
+
+	rows, err := m.DB.QueryContext(ctx, query, userId, sd, ed)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	snapshots := []*models.SummarySnapshot{}
+
+	for rows.Next() {
+		var s models.SummarySnapshot
+		err := rows.Scan(
+			&s.ID,
+			&s.UserID,
+			&s.SnapshotDt,
+			&s.TotalIncome,
+			&s.TotalCost,
+			&s.NetFunds,
+			&s.LoanBalance,
+			&s.CreditCardBalance,
+			&s.TotalBalance,
+			&s.CreditUtilization,
+			&s.AccountBalance,
+			&s.NetWorth,
+			&s.CreateDt,
+		)
+
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return nil, err
+		}
+
+		snapshots = append(snapshots, &s)
+	}
+
+	klogger.Debug(method, "retrieved %d records", len(snapshots))
+	klogger.Exit(method)
+	return snapshots, nil
+}
+
+func (m *PostgresDBRepo) GetSummarySnapshotByUserIDAndDate(userId int, d time.Time) (models.SummarySnapshot, error) {
+	method := "summary_snapshots_dbrepo.GetSummarySnapshotByUserIDAndDate"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			id, user_id, snapshot_dt, total_income, total_cost, net_funds, loan_balance, credit_card_balance, total_balance,
+			credit_utilization, account_balance, net_worth, create_dt
+		FROM summary_snapshots
+		WHERE
+			user_id = $1
+			AND snapshot_dt = $2`
+
+	var s models.SummarySnapshot
+	row := m.DB.QueryRowContext(ctx, query, userId, d)
+
+	err := row.Scan(
+		&s.ID,
+		&s.UserID,
+		&s.SnapshotDt,
+		&s.TotalIncome,
+		&s.TotalCost,
+		&s.NetFunds,
+		&s.LoanBalance,
+		&s.CreditCardBalance,
+		&s.TotalBalance,
+		&s.CreditUtilization,
+		&s.AccountBalance,
+		&s.NetWorth,
+		&s.CreateDt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			klogger.Info(method, constants.NoRowsReturnedMsg)
+			klogger.Exit(method)
+			return s, nil
+		} else {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return s, err
+		}
+	}
+
+	klogger.Exit(method)
+	return s, nil
+}
+
+// Function InsertSummarySnapshot saves snapshot s. A snapshot is not saved when the user already has one for the same date,
+// in which case 0 is returned
+func (m *PostgresDBRepo) InsertSummarySnapshot(s models.SummarySnapshot) (int, error) {
+	method := "summary_snapshots_dbrepo.InsertSummarySnapshot"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	stmt :=
+		`INSERT INTO summary_snapshots
+			(user_id, snapshot_dt, total_income, total_cost, net_funds, loan_balance, credit_card_balance, total_balance,
+			credit_utilization, account_balance, net_worth, create_dt)
+		values
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT DO NOTHING
+		returning id`
+
+	var id int
+	err := m.DB.QueryRowContext(ctx, stmt,
+		s.UserID,
+		s.SnapshotDt,
+		s.TotalIncome,
+		s.TotalCost,
+		s.NetFunds,
+		s.LoanBalance,
+		s.CreditCardBalance,
+		s.TotalBalance,
+		s.CreditUtilization,
+		s.AccountBalance,
+		s.NetWorth,
+		time.Now(),
+	).Scan(&id)
+
+	if err == sql.ErrNoRows {
+		klogger.Info(method, "summary for user %d has already been snapshotted", s.UserID)
+		klogger.Exit(method)
+		return 0, nil
+	}
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+func (m *PostgresDBRepo) DeleteSummarySnapshotsByUserID(userId int) error {
+	method := "summary_snapshots_dbrepo.DeleteSummarySnapshotsByUserID"
+	klogger.Enter(method)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+
+	query := `
+		DELETE
+		FROM summary_snapshots
+		WHERE
+			user_id = $1`
+
+	_, err := m.DB.ExecContext(ctx, query, userId)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
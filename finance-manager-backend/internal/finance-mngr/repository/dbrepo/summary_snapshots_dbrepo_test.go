@@ -0,0 +1,72 @@
+package dbrepo
+
+import (
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarySnapshots(t *testing.T) {
+	method := "summary_snapshots_dbrepo_test.TestSummarySnapshots"
+	klogger.Enter(method)
+
+	jan := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	s1 := models.SummarySnapshot{UserID: 1, SnapshotDt: jan, TotalIncome: 5000, TotalCost: 3000, NetFunds: 2000, LoanBalance: 10000, NetWorth: -8000}
+	s2 := models.SummarySnapshot{UserID: 1, SnapshotDt: feb, TotalIncome: 5000, TotalCost: 3500, NetFunds: 1500, LoanBalance: 9500, NetWorth: -7500}
+	s3 := models.SummarySnapshot{UserID: 1, SnapshotDt: mar, TotalIncome: 5200, TotalCost: 3100, NetFunds: 2100, LoanBalance: 9000, NetWorth: -6500}
+	s4 := models.SummarySnapshot{UserID: 2, SnapshotDt: jan, TotalIncome: 4000, TotalCost: 2000, NetFunds: 2000}
+
+	var err error
+	for _, s := range []*models.SummarySnapshot{&s1, &s2, &s3, &s4} {
+		s.ID, err = d.InsertSummarySnapshot(*s)
+		assert.Nil(t, err)
+		assert.Greater(t, s.ID, 0)
+	}
+
+	//Get by user and date
+	s, err := d.GetSummarySnapshotByUserIDAndDate(1, feb)
+	assert.Nil(t, err)
+	assert.Equal(t, s2.ID, s.ID)
+	assert.Equal(t, s2.TotalCost, s.TotalCost)
+	assert.Equal(t, s2.NetWorth, s.NetWorth)
+	assert.True(t, feb.Equal(s.SnapshotDt))
+
+	//Snapshot that does not exist
+	s, err = d.GetSummarySnapshotByUserIDAndDate(2, feb)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, s.ID)
+
+	//History is returned in date order within the range inclusively
+	sarr, err := d.GetAllUserSummarySnapshots(1, feb, mar)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(sarr))
+	assert.Equal(t, s2.ID, sarr[0].ID)
+	assert.Equal(t, s3.ID, sarr[1].ID)
+
+	sarr, err = d.GetAllUserSummarySnapshots(1, time.Time{}, time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(sarr))
+
+	//Delete by user
+	err = d.DeleteSummarySnapshotsByUserID(1)
+	assert.Nil(t, err)
+
+	sarr, err = d.GetAllUserSummarySnapshots(1, time.Time{}, time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(sarr))
+
+	sarr, err = d.GetAllUserSummarySnapshots(2, time.Time{}, time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(sarr))
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM summary_snapshots")
+
+	klogger.Exit(method)
+}
@@ -18,50 +18,24 @@ func (m *PostgresDBRepo) InsertUserStock(s models.UserStock) (int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
 	defer cancel()
 
-	var stmt string
-	var err error
-	var id int
+	tx, err := m.DB.BeginTx(ctx, nil)
 
-	//Set default type
-	if s.Type == "" {
-		s.Type = constants.UserStockTypeOwn
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
 	}
 
-	if !s.ExpirationDt.Time.IsZero() {
-		stmt =
-			`INSERT INTO user_stocks 
-			(user_id, ticker, quantity, type, effective_dt, expiration_dt, create_dt, last_update_dt)
-		values 
-			($1, $2, $3, $4, $5, $6, $7, $8) returning id`
+	defer tx.Rollback()
 
-		err = m.DB.QueryRowContext(ctx, stmt,
-			s.UserId,
-			s.Ticker,
-			s.Quantity,
-			s.Type,
-			s.EffectiveDt,
-			s.ExpirationDt.Time,
-			time.Now(),
-			time.Now(),
-		).Scan(&id)
-	} else {
-		stmt =
-			`INSERT INTO user_stocks 
-				(user_id, ticker, quantity, type, effective_dt, create_dt, last_update_dt)
-			values 
-				($1, $2, $3, $4, $5, $6, $7) returning id`
+	id, err := insertUserStock(ctx, tx, s)
 
-		err = m.DB.QueryRowContext(ctx, stmt,
-			s.UserId,
-			s.Ticker,
-			s.Quantity,
-			s.Type,
-			s.EffectiveDt,
-			time.Now(),
-			time.Now(),
-		).Scan(&id)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
 	}
 
+	err = tx.Commit()
+
 	if err != nil {
 		klogger.ExitError(method, constants.UnexpectedSQLError, err)
 		return -1, err
@@ -185,7 +159,7 @@ func (m *PostgresDBRepo) GetAllUserStocksByDateRange(userId int, search string,
 
 		query = `
 		SELECT
-			id, user_id, ticker, quantity, effective_dt, expiration_dt,
+			id, user_id, ticker, quantity, type, effective_dt, expiration_dt,
 			create_dt, last_update_dt
 		FROM user_stocks
 		WHERE
@@ -200,7 +174,7 @@ func (m *PostgresDBRepo) GetAllUserStocksByDateRange(userId int, search string,
 	} else {
 		query = `
 		SELECT
-			id, user_id, ticker, quantity, effective_dt, expiration_dt,
+			id, user_id, ticker, quantity, type, effective_dt, expiration_dt,
 			create_dt, last_update_dt
 		FROM user_stocks
 		WHERE
@@ -236,6 +210,7 @@ func (m *PostgresDBRepo) GetAllUserStocksByDateRange(userId int, search string,
 			&u.UserId,
 			&u.Ticker,
 			&u.Quantity,
+			&u.Type,
 			&u.EffectiveDt,
 			&u.ExpirationDt,
 			&u.CreateDt,
@@ -317,6 +292,96 @@ func (m *PostgresDBRepo) UpdateUserStock(us models.UserStock) error {
 	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
 	defer cancel()
 
+	tx, err := m.DB.BeginTx(ctx, nil)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	defer tx.Rollback()
+
+	err = updateUserStock(ctx, tx, us)
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function insertUserStock inserts user stock s as part of transaction tx
+func insertUserStock(ctx context.Context, tx *sql.Tx, s models.UserStock) (int, error) {
+	method := "stocks_dbrepo.insertUserStock"
+	klogger.Enter(method)
+
+	var stmt string
+	var err error
+	var id int
+
+	//Set default type
+	if s.Type == "" {
+		s.Type = constants.UserStockTypeOwn
+	}
+
+	if !s.ExpirationDt.Time.IsZero() {
+		stmt =
+			`INSERT INTO user_stocks 
+			(user_id, ticker, quantity, type, effective_dt, expiration_dt, create_dt, last_update_dt)
+		values 
+			($1, $2, $3, $4, $5, $6, $7, $8) returning id`
+
+		err = tx.QueryRowContext(ctx, stmt,
+			s.UserId,
+			s.Ticker,
+			s.Quantity,
+			s.Type,
+			s.EffectiveDt,
+			s.ExpirationDt.Time,
+			time.Now(),
+			time.Now(),
+		).Scan(&id)
+	} else {
+		stmt =
+			`INSERT INTO user_stocks 
+				(user_id, ticker, quantity, type, effective_dt, create_dt, last_update_dt)
+			values 
+				($1, $2, $3, $4, $5, $6, $7) returning id`
+
+		err = tx.QueryRowContext(ctx, stmt,
+			s.UserId,
+			s.Ticker,
+			s.Quantity,
+			s.Type,
+			s.EffectiveDt,
+			time.Now(),
+			time.Now(),
+		).Scan(&id)
+	}
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return id, nil
+}
+
+// Function updateUserStock updates user stock us as part of transaction tx
+func updateUserStock(ctx context.Context, tx *sql.Tx, us models.UserStock) error {
+	method := "stocks_dbrepo.updateUserStock"
+	klogger.Enter(method)
+
 	stmt :=
 		`UPDATE user_stocks 
 		SET
@@ -328,7 +393,7 @@ func (m *PostgresDBRepo) UpdateUserStock(us models.UserStock) error {
 		WHERE
 			id = $1`
 
-	_, err := m.DB.ExecContext(ctx, stmt,
+	_, err := tx.ExecContext(ctx, stmt,
 		us.ID,
 		us.Quantity,
 		us.EffectiveDt,
@@ -83,22 +83,239 @@ type DatabaseRepo interface {
 	//Updates an existing loan
 	UpdateLoan(loan models.Loan) error
 
+	/*** Loan Payment Functions ***/
+
+	//Deletes a Loan Payment by its id
+	DeleteLoanPaymentByID(id int) error
+
+	//Deletes all Loan Payments for a given loanId
+	DeleteLoanPaymentsByLoanID(loanId int) error
+
+	//Deletes all Loan Payments for a given userId
+	DeleteLoanPaymentsByUserID(userId int) error
+
+	//Fetches all Loan Payments for a given loanId
+	GetAllLoanPaymentsByLoanID(loanId int) ([]*models.LoanPayment, error)
+
+	//Fetches all Loan Payments for a given userId
+	GetAllUserLoanPayments(userId int) ([]*models.LoanPayment, error)
+
+	//Fetches a Loan Payment by its id
+	GetLoanPaymentByID(id int) (models.LoanPayment, error)
+
+	//Inserts a new Loan Payment
+	InsertLoanPayment(p models.LoanPayment) (int, error)
+
+	/*** Summary Snapshot Functions ***/
+
+	//Deletes all Summary Snapshots for a given userId
+	DeleteSummarySnapshotsByUserID(userId int) error
+
+	//Fetches all Summary Snapshots for a given userId taken between sd and ed inclusively, ordered by date
+	GetAllUserSummarySnapshots(userId int, sd time.Time, ed time.Time) ([]*models.SummarySnapshot, error)
+
+	//Fetches the Summary Snapshot for a given userId taken on date d
+	GetSummarySnapshotByUserIDAndDate(userId int, d time.Time) (models.SummarySnapshot, error)
+
+	//Inserts a new Summary Snapshot
+	InsertSummarySnapshot(s models.SummarySnapshot) (int, error)
+
 	//Income Functions
 	DeleteIncomesByUserID(id int) error
 	DeleteIncomeByID(id int) error
 	GetAllUserIncomes(id int, search string) ([]*models.Income, error)
 	GetIncomeByID(id int) (models.Income, error)
 	InsertIncome(models.Income) (int, error)
+	UnlinkIncomesFromBankAccount(accountId int) error
 	UpdateIncome(income models.Income) error
 
+	/*** Income Version Functions ***/
+
+	//Deletes an Income Version by its id
+	DeleteIncomeVersionByID(id int) error
+
+	//Deletes all Income Versions for a given incomeId
+	DeleteIncomeVersionsByIncomeID(incomeId int) error
+
+	//Deletes all Income Versions for a given userId
+	DeleteIncomeVersionsByUserID(userId int) error
+
+	//Fetches all Income Versions for a given incomeId
+	GetAllIncomeVersionsByIncomeID(incomeId int) ([]*models.IncomeVersion, error)
+
+	//Fetches all Income Versions for a given userId
+	GetAllUserIncomeVersions(userId int) ([]*models.IncomeVersion, error)
+
+	//Fetches an Income Version by its id
+	GetIncomeVersionByID(id int) (models.IncomeVersion, error)
+
+	//Inserts a new Income Version
+	InsertIncomeVersion(v models.IncomeVersion) (int, error)
+
+	/*** Income Hours Functions ***/
+
+	//Deletes logged Income Hours by its id
+	DeleteIncomeHoursByID(id int) error
+
+	//Deletes all logged Income Hours for a given incomeId
+	DeleteIncomeHoursByIncomeID(incomeId int) error
+
+	//Deletes all logged Income Hours for a given userId
+	DeleteIncomeHoursByUserID(userId int) error
+
+	//Fetches all logged Income Hours for a given incomeId
+	GetAllIncomeHoursByIncomeID(incomeId int) ([]*models.IncomeHours, error)
+
+	//Fetches all logged Income Hours for a given userId
+	GetAllUserIncomeHours(userId int) ([]*models.IncomeHours, error)
+
+	//Fetches logged Income Hours by its id
+	GetIncomeHoursByID(id int) (models.IncomeHours, error)
+
+	//Inserts new logged Income Hours
+	InsertIncomeHours(h models.IncomeHours) (int, error)
+
+	/*** Savings Goal Functions ***/
+
+	//Deletes a Savings Goal by its id
+	DeleteSavingsGoalByID(id int) error
+
+	//Deletes all Savings Goals for a given userId
+	DeleteSavingsGoalsByUserID(userId int) error
+
+	//Fetches all Savings Goals for a given userId and accepts a search parameter
+	GetAllUserSavingsGoals(userId int, search string) ([]*models.SavingsGoal, error)
+
+	//Fetches a Savings Goal by its id
+	GetSavingsGoalByID(id int) (models.SavingsGoal, error)
+
+	//Inserts a new Savings Goal
+	InsertSavingsGoal(g models.SavingsGoal) (int, error)
+
+	//Removes a deleted Income from the Savings Goals linked to it
+	UnlinkSavingsGoalsFromIncome(incomeId int) error
+
+	//Updates an existing Savings Goal
+	UpdateSavingsGoal(g models.SavingsGoal) error
+
+	/*** Savings Goal Contribution Functions ***/
+
+	//Deletes a Savings Goal Contribution by its id
+	DeleteSavingsGoalContributionByID(id int) error
+
+	//Deletes all Savings Goal Contributions for a given savingsGoalId
+	DeleteSavingsGoalContributionsBySavingsGoalID(goalId int) error
+
+	//Deletes all Savings Goal Contributions for a given userId
+	DeleteSavingsGoalContributionsByUserID(userId int) error
+
+	//Fetches all Savings Goal Contributions for a given savingsGoalId
+	GetAllSavingsGoalContributionsBySavingsGoalID(goalId int) ([]*models.SavingsGoalContribution, error)
+
+	//Fetches all Savings Goal Contributions for a given userId
+	GetAllUserSavingsGoalContributions(userId int) ([]*models.SavingsGoalContribution, error)
+
+	//Fetches a Savings Goal Contribution by its id
+	GetSavingsGoalContributionByID(id int) (models.SavingsGoalContribution, error)
+
+	//Inserts a new Savings Goal Contribution
+	InsertSavingsGoalContribution(c models.SavingsGoalContribution) (int, error)
+
 	//Bill Functions
 	DeleteBillsByUserID(id int) error
 	DeleteBillByID(id int) error
 	GetAllUserBills(id int, search string) ([]*models.Bill, error)
 	GetBillByID(id int) (models.Bill, error)
 	InsertBill(models.Bill) (int, error)
+	UnlinkBillsFromBankAccount(accountId int) error
 	UpdateBill(income models.Bill) error
 
+	/*** Bank Account Functions ***/
+
+	//Deletes a Bank Account by its id
+	DeleteBankAccountByID(id int) error
+
+	//Deletes all Bank Accounts for a given userId
+	DeleteBankAccountsByUserID(userId int) error
+
+	//Fetches all Bank Accounts for a given userId and accepts a search parameter
+	GetAllUserBankAccounts(userId int, search string) ([]*models.BankAccount, error)
+
+	//Fetches a Bank Account by its id
+	GetBankAccountByID(id int) (models.BankAccount, error)
+
+	//Inserts a new Bank Account
+	InsertBankAccount(a models.BankAccount) (int, error)
+
+	//Updates an existing Bank Account
+	UpdateBankAccount(a models.BankAccount) error
+
+	/*** Bank Account Transaction Functions ***/
+
+	//Deletes a Bank Account Transaction by its id
+	DeleteBankAccountTransactionByID(id int) error
+
+	//Deletes all Bank Account Transactions for a given bankAccountId
+	DeleteBankAccountTransactionsByBankAccountID(accountId int) error
+
+	//Deletes all Bank Account Transactions for a given userId
+	DeleteBankAccountTransactionsByUserID(userId int) error
+
+	//Fetches all Bank Account Transactions for a given bankAccountId
+	GetAllBankAccountTransactionsByBankAccountID(accountId int) ([]*models.BankAccountTransaction, error)
+
+	//Fetches all Bank Account Transactions for a given userId
+	GetAllUserBankAccountTransactions(userId int) ([]*models.BankAccountTransaction, error)
+
+	//Fetches a Bank Account Transaction by its id
+	GetBankAccountTransactionByID(id int) (models.BankAccountTransaction, error)
+
+	//Inserts a new Bank Account Transaction
+	InsertBankAccountTransaction(t models.BankAccountTransaction) (int, error)
+
+	//Updates the category and income, bill or credit card link of a Bank Account Transaction
+	UpdateBankAccountTransactionCategory(t models.BankAccountTransaction) error
+
+	/*** Budget Functions ***/
+
+	//Deletes a Budget by its id
+	DeleteBudgetByID(id int) error
+
+	//Deletes all Budgets for a given userId
+	DeleteBudgetsByUserID(userId int) error
+
+	//Fetches all Budgets for a given userId ordered by category
+	GetAllUserBudgets(userId int) ([]*models.Budget, error)
+
+	//Fetches a Budget by its id
+	GetBudgetByID(id int) (models.Budget, error)
+
+	//Inserts a new Budget
+	InsertBudget(b models.Budget) (int, error)
+
+	//Updates an existing Budget
+	UpdateBudget(b models.Budget) error
+
+	/*** Categorization Rule Functions ***/
+
+	//Deletes a Categorization Rule by its id
+	DeleteCategorizationRuleByID(id int) error
+
+	//Deletes all Categorization Rules for a given userId
+	DeleteCategorizationRulesByUserID(userId int) error
+
+	//Fetches all Categorization Rules for a given userId in priority order
+	GetAllUserCategorizationRules(userId int) ([]*models.CategorizationRule, error)
+
+	//Fetches a Categorization Rule by its id
+	GetCategorizationRuleByID(id int) (models.CategorizationRule, error)
+
+	//Inserts a new Categorization Rule
+	InsertCategorizationRule(r models.CategorizationRule) (int, error)
+
+	//Updates an existing Categorization Rule
+	UpdateCategorizationRule(r models.CategorizationRule) error
+
 	//Credit Cards
 	GetAllUserCreditCards(id int, search string) ([]*models.CreditCard, error)
 	GetCreditCardByID(id int) (models.CreditCard, error)
@@ -147,4 +364,40 @@ type DatabaseRepo interface {
 
 	//Updates a user stock
 	UpdateUserStock(us models.UserStock) error
+
+	/*** Stock Transactions ***/
+
+	//Deletes all Stock Transactions for a given userId
+	DeleteStockTransactionsByUserID(userId int) error
+
+	//Fetches all Stock Transactions for a given userId in the order they were made, and only those of ticker if it is included
+	GetAllUserStockTransactions(userId int, ticker string) ([]*models.StockTransaction, error)
+
+	//Inserts a new Stock Transaction
+	InsertStockTransaction(t models.StockTransaction) (int, error)
+
+	//Updates the prior User Stock of a stock operation if it exists, inserts the User Stock it leaves if it has a quantity and inserts its Stock Transaction in one transaction
+	SaveStockOperation(usp models.UserStock, us models.UserStock, t models.StockTransaction) (int, error)
+
+	/*** Stock Splits ***/
+
+	//Fetches the splits of a given ticker in the order they took effect. Fetches the splits of every ticker if t is empty
+	GetStockSplitsByTicker(t string) ([]models.StockSplit, error)
+
+	//Inserts a new Stock Split
+	InsertStockSplit(s models.StockSplit) (int, error)
+
+	//Inserts a new Stock Split and adjusts the stock data and user stocks of its ticker for it in one transaction. Must only be run once per split
+	SaveStockSplit(s models.StockSplit) (int, error)
+
+	//Splits the user stocks held across a split into user stocks before and after it
+	SplitUserStocks(s models.StockSplit) error
+
+	/*** Stock Dividends ***/
+
+	//Fetches the dividends of a given ticker by ex-dividend date. Fetches the dividends of every ticker if t is empty
+	GetStockDividendsByTicker(t string) ([]models.StockDividend, error)
+
+	//Inserts a new Stock Dividend
+	InsertStockDividend(d models.StockDividend) (int, error)
 }
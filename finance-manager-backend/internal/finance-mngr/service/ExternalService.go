@@ -24,4 +24,10 @@ type ExternalService interface {
 
 	//Fetches stocks for a given ticker and date range
 	FetchStockWithTickerForDateRange(t string, d1 time.Time, d2 time.Time) ([]models.Stock, error)
+
+	//Fetches the splits of a given ticker
+	FetchStockSplitsWithTicker(t string) ([]models.StockSplit, error)
+
+	//Fetches the cash dividends of a given ticker
+	FetchStockDividendsWithTicker(t string) ([]models.StockDividend, error)
 }
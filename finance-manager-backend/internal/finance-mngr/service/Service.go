@@ -3,6 +3,7 @@ package service
 import (
 	"finance-manager-backend/internal/finance-mngr/models"
 	"finance-manager-backend/internal/finance-mngr/models/restmodels"
+	"time"
 )
 
 type Service interface {
@@ -14,6 +15,66 @@ type Service interface {
 	//d - The number of days to pull history for
 	GetUserPortfolioBalanceHistory(uId int, d int) ([]models.PortfolioBalanceHistory, error)
 
+	//Calculates the time-weighted and money-weighted returns of a user's portfolio and each of its positions for each period up to t
+	GetUserPortfolioPerformance(uId int, periods []string, t time.Time) (models.PortfolioPerformance, error)
+
+	//Compares a user's portfolio balance history to each benchmark ticker, normalizing each benchmark to the portfolio's starting value
+	GetUserPortfolioBenchmarks(uId int, hist []models.PortfolioBalanceHistory, tickers []string) ([]models.BenchmarkComparison, error)
+
+	//Corporate Action Service
+
+	//Saves the splits and dividends of a ticker that have not already been saved, adjusting its stock data and user stocks for each new split.
+	//Returns the number of splits and dividends saved
+	IngestStockCorporateActions(t string, sarr []models.StockSplit, darr []models.StockDividend) (int, error)
+
+	//Splits the user stocks of a ticker that are held across any of its saved splits
+	ApplyStockSplits(t string) error
+
+	//Bank Account Service
+
+	//Posts a transaction to a user's bank accounts for each payday and bill due date linked to them through t that has not already been posted.
+	//Returns the number of transactions posted
+	PostScheduledTransactions(uId int, t time.Time) (int, error)
+
+	//Saves the transactions parsed from a bank statement to a bank account that were not already imported to it and suggests
+	//the income, bill or credit card each is for
+	ImportBankAccountTransactions(a models.BankAccount, tarr []models.BankAccountTransaction) (models.StatementImportResponse, error)
+
+	//Budget Service
+
+	//Compares each of a user's budgets to their planned and actual spending for the month containing t
+	GetUserBudgetStatuses(uId int, t time.Time) ([]models.BudgetStatus, error)
+
+	//Categorization Service
+
+	//Re-runs a user's categorization rules against all of their bank account transactions.
+	//Returns the number of transactions whose category or link was changed
+	ApplyCategorizationRules(uId int) (int, error)
+
+	//Calendar Service
+
+	//Builds a calendar of a user's paydays, bill due dates and loan and credit card payments, projecting balances from t
+	GetUserCalendar(uId int, c models.Calendar, t time.Time) (models.Calendar, error)
+
+	//Income Service
+
+	//Calculates the taxes of all of a user's incomes together
+	CalcUserIncomeTaxes(iarr []*models.Income) error
+
+	//Calculates the taxes of a single income, returning an error when its filing status or state is not supported
+	CalcIncomeTaxes(i *models.Income) error
+
+	//Summary Service
+
+	//Builds the Summary of a user's finances for the month containing t, optionally adding their dividends to their income
+	GetUserSummary(uId int, t time.Time, includeDividends bool) (models.Summary, error)
+
+	//Builds the Summary of a user's finances for each of a number of months beginning with the month containing t
+	GetUserSummaryProjection(uId int, t time.Time, months int, includeDividends bool) ([]models.Summary, error)
+
+	//Gets the payments a user receives from the dividends of the stocks they have owned up to t
+	GetUserDividendPayments(uId int, t time.Time) ([]models.DividendPayment, error)
+
 	//User Stock Service
 
 	//Loads the prior User stock for a transaction and updates the Stock being generated by the transaction
@@ -0,0 +1,218 @@
+package fmservice
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Function PostScheduledTransactions posts a transaction to a user's bank accounts for each payday of the incomes and each due date
+// of the bills linked to them, from the account's opening date through t, that has not already been posted
+// uId - The ID of the user to post transactions for
+// t - The last date to post transactions for
+// Returns the number of transactions posted
+func (fms *FMService) PostScheduledTransactions(uId int, t time.Time) (int, error) {
+	method := "fm_bankaccountservice.PostScheduledTransactions"
+	klogger.Enter(method)
+
+	if uId <= 0 {
+		err := errors.New("uId is required")
+		klogger.ExitError(method, err.Error())
+		return 0, err
+	}
+
+	accounts, err := fms.DB.GetAllUserBankAccounts(uId, "")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return 0, err
+	}
+
+	if len(accounts) == 0 {
+		klogger.Exit(method)
+		return 0, nil
+	}
+
+	transactions, err := fms.DB.GetAllUserBankAccountTransactions(uId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return 0, err
+	}
+
+	incomes, err := fms.DB.GetAllUserIncomes(uId, "")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return 0, err
+	}
+
+	versions, err := fms.DB.GetAllUserIncomeVersions(uId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return 0, err
+	}
+
+	hours, err := fms.DB.GetAllUserIncomeHours(uId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return 0, err
+	}
+
+	bills, err := fms.DB.GetAllUserBills(uId, "")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return 0, err
+	}
+
+	for _, i := range incomes {
+		i.LoadVersions(versions)
+		i.LoadLoggedHours(hours)
+		i.PopulateEmptyValues(t)
+	}
+
+	err = models.CalcUserTaxes(incomes, fms.TaxEngine)
+	if err != nil {
+		klogger.ExitError(method, "failed to calculate user income taxes:\n%v", err)
+		return 0, err
+	}
+
+	posted := 0
+
+	for _, a := range accounts {
+		a.LoadTransactions(transactions)
+
+		var scheduled []models.BankAccountTransaction
+
+		for _, i := range incomes {
+			if i.AccountID == a.ID {
+				scheduled = append(scheduled, i.GetPostingsBetween(a.OpeningDt, t)...)
+			}
+		}
+
+		for _, b := range bills {
+			if b.AccountID == a.ID {
+				scheduled = append(scheduled, b.GetPostingsBetween(a.OpeningDt, t)...)
+			}
+		}
+
+		for _, p := range a.GetUnpostedTransactions(scheduled) {
+			_, err = fms.DB.InsertBankAccountTransaction(p)
+			if err != nil {
+				klogger.ExitError(method, constants.UnexpectedSQLError, err)
+				return posted, err
+			}
+
+			posted++
+		}
+	}
+
+	klogger.Exit(method)
+	return posted, nil
+}
+
+// Function ImportBankAccountTransactions saves the transactions parsed from a bank statement to a bank account, skipping those whose
+// ExternalID has already been imported to it. The user's categorization rules are applied to each saved transaction, and those
+// the rules do not link are given a suggested income, bill or credit card
+// a - The bank account to import the transactions to
+// tarr - The transactions parsed from the statement, already validated for the account
+// Returns the transactions that were imported and the number of duplicates skipped
+func (fms *FMService) ImportBankAccountTransactions(a models.BankAccount, tarr []models.BankAccountTransaction) (models.StatementImportResponse, error) {
+	method := "fm_bankaccountservice.ImportBankAccountTransactions"
+	klogger.Enter(method)
+
+	res := models.StatementImportResponse{Transactions: []models.BankAccountTransaction{}}
+
+	existing, err := fms.DB.GetAllBankAccountTransactionsByBankAccountID(a.ID)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return res, err
+	}
+
+	incomes, err := fms.DB.GetAllUserIncomes(a.UserID, "")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return res, err
+	}
+
+	versions, err := fms.DB.GetAllUserIncomeVersions(a.UserID)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return res, err
+	}
+
+	hours, err := fms.DB.GetAllUserIncomeHours(a.UserID)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return res, err
+	}
+
+	bills, err := fms.DB.GetAllUserBills(a.UserID, "")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return res, err
+	}
+
+	ccs, err := fms.DB.GetAllUserCreditCards(a.UserID, "")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return res, err
+	}
+
+	rules, err := fms.DB.GetAllUserCategorizationRules(a.UserID)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return res, err
+	}
+
+	for _, i := range incomes {
+		i.LoadVersions(versions)
+		i.LoadLoggedHours(hours)
+		i.PopulateEmptyValues(time.Now())
+	}
+
+	err = models.CalcUserTaxes(incomes, fms.TaxEngine)
+	if err != nil {
+		klogger.ExitError(method, "failed to calculate user income taxes:\n%v", err)
+		return res, err
+	}
+
+	imported := make(map[string]bool)
+	for _, t := range existing {
+		if t.ExternalID != "" {
+			imported[t.ExternalID] = true
+		}
+	}
+
+	for _, t := range tarr {
+		if imported[t.ExternalID] {
+			res.Duplicates++
+			continue
+		}
+
+		t.ApplyCategorizationRules(rules)
+
+		if t.Source == "" {
+			t.SuggestMatch(incomes, bills, ccs)
+		}
+
+		t.ID, err = fms.DB.InsertBankAccountTransaction(t)
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return res, err
+		}
+
+		//Another import saved the same transaction first
+		if t.ID == 0 {
+			res.Duplicates++
+			continue
+		}
+
+		imported[t.ExternalID] = true
+		res.Imported++
+		res.Transactions = append(res.Transactions, t)
+	}
+
+	klogger.Exit(method)
+	return res, nil
+}
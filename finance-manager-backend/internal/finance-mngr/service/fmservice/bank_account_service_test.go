@@ -0,0 +1,63 @@
+package fmservice
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportBankAccountTransactions(t *testing.T) {
+	method := "bank_account_service_test.TestImportBankAccountTransactions"
+	klogger.Enter(method)
+
+	a := models.BankAccount{UserID: 1, Name: "TestImport", Type: constants.BankAccountTypeChecking, OpeningDt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	var err error
+	a.ID, err = fms.DB.InsertBankAccount(a)
+	assert.Nil(t, err)
+
+	dt := time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)
+
+	//A transaction imported from an earlier statement
+	_, err = fms.DB.InsertBankAccountTransaction(models.BankAccountTransaction{BankAccountID: a.ID, UserID: 1, Type: constants.TransactionTypeDeposit,
+		Amount: 1800, TransactionDt: dt, Payee: "ACME CORP PAYROLL", ExternalID: "FIT1"})
+	assert.Nil(t, err)
+
+	_, err = fms.DB.InsertCategorizationRule(models.CategorizationRule{UserID: 1, Name: "Coffee", PayeePattern: "coffee", Category: "Dining"})
+	assert.Nil(t, err)
+
+	tarr := []models.BankAccountTransaction{
+		{BankAccountID: a.ID, UserID: 1, Type: constants.TransactionTypeDeposit, Amount: 1800, TransactionDt: dt, Payee: "ACME CORP PAYROLL", ExternalID: "FIT1"},
+		{BankAccountID: a.ID, UserID: 1, Type: constants.TransactionTypeWithdrawal, Amount: 4.5, TransactionDt: dt, Payee: "COFFEE HUT", ExternalID: "FIT2"},
+		{BankAccountID: a.ID, UserID: 1, Type: constants.TransactionTypeWithdrawal, Amount: 60, TransactionDt: dt, Payee: "GAS STATION", ExternalID: "FIT3"},
+	}
+
+	res, err := fms.ImportBankAccountTransactions(a, tarr)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, res.Imported)
+	assert.Equal(t, 1, res.Duplicates)
+	assert.Equal(t, 2, len(res.Transactions))
+	assert.Equal(t, "Dining", res.Transactions[0].Category)
+	assert.Greater(t, res.Transactions[0].ID, 0)
+
+	saved, err := fms.DB.GetAllBankAccountTransactionsByBankAccountID(a.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(saved))
+
+	//Importing the statement again imports nothing
+	res, err = fms.ImportBankAccountTransactions(a, tarr)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, res.Imported)
+	assert.Equal(t, 3, res.Duplicates)
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM bank_account_transactions")
+	p.GormDB.Exec("DELETE FROM bank_accounts")
+	p.GormDB.Exec("DELETE FROM categorization_rules")
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,64 @@
+package fmservice
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/fmUtil"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Function GetUserBudgetStatuses compares each of a user's budgets to their planned and actual spending for the month containing t
+// uId - The ID of the user to get budget statuses for
+// t - A date in the month to compare
+func (fms *FMService) GetUserBudgetStatuses(uId int, t time.Time) ([]models.BudgetStatus, error) {
+	method := "fm_budgetservice.GetUserBudgetStatuses"
+	klogger.Enter(method)
+
+	if uId <= 0 {
+		err := errors.New("uId is required")
+		klogger.ExitError(method, err.Error())
+		return nil, err
+	}
+
+	budgets, err := fms.DB.GetAllUserBudgets(uId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	bills, err := fms.DB.GetAllUserBills(uId, "")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	loans, err := fms.DB.GetAllUserLoans(uId, "")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	payments, err := fms.DB.GetAllUserLoanPayments(uId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	transactions, err := fms.DB.GetAllUserBankAccountTransactions(uId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	for _, l := range loans {
+		l.LoadPayments(payments)
+	}
+
+	statuses := models.GetBudgetStatuses(t, fmUtil.GetMonthsBetween(time.Now(), t), budgets, bills, loans, transactions)
+
+	klogger.Exit(method)
+	return statuses, nil
+}
@@ -0,0 +1,38 @@
+package fmservice
+
+import (
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Function GetUserCalendar loads the paydays, bill due dates, loan payments and credit card payments of a user into calendar c and finalizes it
+// uId - The ID of the user to build the calendar for
+// c - The calendar to load, with its dates and starting balance already validated
+// t - The date loan and credit card balances are current as of
+func (fms *FMService) GetUserCalendar(uId int, c models.Calendar, t time.Time) (models.Calendar, error) {
+	method := "fm_calendarservice.GetUserCalendar"
+	klogger.Enter(method)
+
+	data, err := fms.loadUserSummaryData(uId, c.From, false)
+	if err != nil {
+		klogger.ExitError(method, "failed to load user summary data:\n%v", err)
+		return c, err
+	}
+
+	err = c.LoadLoans(data.Loans, t)
+	if err != nil {
+		klogger.ExitError(method, "failed to load user loans:\n%v", err)
+		return c, err
+	}
+
+	c.LoadIncomes(data.Incomes)
+	c.LoadBills(data.Bills)
+	c.LoadCreditCards(data.CreditCards, t)
+
+	c.Finalize()
+
+	klogger.Exit(method)
+	return c, nil
+}
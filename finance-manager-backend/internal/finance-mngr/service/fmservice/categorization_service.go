@@ -0,0 +1,58 @@
+package fmservice
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Function ApplyCategorizationRules re-runs a user's categorization rules against all of their bank account transactions
+// uId - The ID of the user to categorize transactions for
+// Returns the number of transactions whose category or link was changed
+func (fms *FMService) ApplyCategorizationRules(uId int) (int, error) {
+	method := "fm_categorizationservice.ApplyCategorizationRules"
+	klogger.Enter(method)
+
+	if uId <= 0 {
+		err := errors.New("uId is required")
+		klogger.ExitError(method, err.Error())
+		return 0, err
+	}
+
+	rules, err := fms.DB.GetAllUserCategorizationRules(uId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return 0, err
+	}
+
+	if len(rules) == 0 {
+		klogger.Exit(method)
+		return 0, nil
+	}
+
+	transactions, err := fms.DB.GetAllUserBankAccountTransactions(uId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return 0, err
+	}
+
+	updated := 0
+
+	for _, t := range transactions {
+		if !t.ApplyCategorizationRules(rules) {
+			continue
+		}
+
+		err = fms.DB.UpdateBankAccountTransactionCategory(*t)
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return updated, err
+		}
+
+		updated++
+	}
+
+	klogger.Exit(method)
+	return updated, nil
+}
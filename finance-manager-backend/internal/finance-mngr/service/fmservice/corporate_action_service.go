@@ -0,0 +1,123 @@
+package fmservice
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Function IngestStockCorporateActions saves the splits and dividends of ticker t that have not already been saved. The stock data of t
+// and the user stocks held across each new split are adjusted for it. Returns the number of splits and dividends saved
+// t - The ticker the splits and dividends are for
+// sarr - The splits of t
+// darr - The dividends of t
+func (fms *FMService) IngestStockCorporateActions(t string, sarr []models.StockSplit, darr []models.StockDividend) (int, error) {
+	method := "fm_corporateactionservice.IngestStockCorporateActions"
+	klogger.Enter(method)
+
+	saved := 0
+
+	esarr, err := fms.DB.GetStockSplitsByTicker(t)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return saved, err
+	}
+
+	for _, s := range sarr {
+		if s.Ticker != t || isSavedStockSplit(s, esarr) {
+			continue
+		}
+
+		_, err = fms.DB.SaveStockSplit(s)
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return saved, err
+		}
+
+		esarr = append(esarr, s)
+		saved++
+	}
+
+	edarr, err := fms.DB.GetStockDividendsByTicker(t)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return saved, err
+	}
+
+	for _, d := range darr {
+		if d.Ticker != t || isSavedStockDividend(d, edarr) {
+			continue
+		}
+
+		_, err = fms.DB.InsertStockDividend(d)
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return saved, err
+		}
+
+		edarr = append(edarr, d)
+		saved++
+	}
+
+	klogger.Debug(method, "saved %d corporate actions for %s", saved, t)
+	klogger.Exit(method)
+	return saved, nil
+}
+
+// Function ApplyStockSplits splits the user stocks of ticker t that are held across any of its saved splits. User stocks that have already
+// been split are left as they are
+// t - The ticker to split user stocks of
+func (fms *FMService) ApplyStockSplits(t string) error {
+	method := "fm_corporateactionservice.ApplyStockSplits"
+	klogger.Enter(method)
+
+	sarr, err := fms.DB.GetStockSplitsByTicker(t)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return err
+	}
+
+	for _, s := range sarr {
+		err = fms.DB.SplitUserStocks(s)
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return err
+		}
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function isSavedStockSplit returns if a split on the same date as s is in sarr
+func isSavedStockSplit(s models.StockSplit, sarr []models.StockSplit) bool {
+	method := "fm_corporateactionservice.isSavedStockSplit"
+	klogger.Enter(method)
+
+	for _, e := range sarr {
+		if e.ExecutionDt.Equal(s.ExecutionDt) {
+			klogger.Exit(method)
+			return true
+		}
+	}
+
+	klogger.Exit(method)
+	return false
+}
+
+// Function isSavedStockDividend returns if a dividend with the same ex-dividend date as d is in darr
+func isSavedStockDividend(d models.StockDividend, darr []models.StockDividend) bool {
+	method := "fm_corporateactionservice.isSavedStockDividend"
+	klogger.Enter(method)
+
+	for _, e := range darr {
+		if e.ExDividendDt.Equal(d.ExDividendDt) {
+			klogger.Exit(method)
+			return true
+		}
+	}
+
+	klogger.Exit(method)
+	return false
+}
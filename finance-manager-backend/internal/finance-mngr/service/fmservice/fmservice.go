@@ -2,8 +2,10 @@ package fmservice
 
 import (
 	"finance-manager-backend/internal/finance-mngr/repository"
+	"finance-manager-backend/internal/finance-mngr/tax"
 )
 
 type FMService struct {
-	DB repository.DatabaseRepo
+	DB        repository.DatabaseRepo
+	TaxEngine tax.TaxEngine
 }
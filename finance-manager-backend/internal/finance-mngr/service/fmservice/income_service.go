@@ -0,0 +1,40 @@
+package fmservice
+
+import (
+	"finance-manager-backend/internal/finance-mngr/models"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Function CalcUserIncomeTaxes calculates the taxes of all of a user's incomes together with the tax engine
+// iarr - The user's incomes with their versions and logged hours loaded
+func (fms *FMService) CalcUserIncomeTaxes(iarr []*models.Income) error {
+	method := "fm_incomeservice.CalcUserIncomeTaxes"
+	klogger.Enter(method)
+
+	err := models.CalcUserTaxes(iarr, fms.TaxEngine)
+	if err != nil {
+		klogger.ExitError(method, "failed to calculate user income taxes:\n%v", err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function CalcIncomeTaxes calculates the taxes of a single income with the tax engine. Returns an error when the engine
+// does not support the income's filing status or state
+// i - The income to calculate taxes for
+func (fms *FMService) CalcIncomeTaxes(i *models.Income) error {
+	method := "fm_incomeservice.CalcIncomeTaxes"
+	klogger.Enter(method)
+
+	err := i.CalcTaxes(fms.TaxEngine)
+	if err != nil {
+		klogger.ExitError(method, "failed to calculate income taxes:\n%v", err)
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
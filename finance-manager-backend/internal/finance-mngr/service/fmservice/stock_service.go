@@ -52,6 +52,14 @@ func (fms *FMService) GetUserPortfolioBalanceHistory(uId int, d int) ([]models.P
 		return hist, nil
 	}
 
+	//Stock data is adjusted for every saved split, so quantities held before a split are valued at the split quantity
+	sarr, err := fms.DB.GetStockSplitsByTicker("")
+
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return hist, err
+	}
+
 	//Next Loop through each user position and load stock data for that position. Add total value for each date
 	histMap := make(map[time.Time]models.PortfolioBalanceHistory)
 
@@ -81,15 +89,17 @@ func (fms *FMService) GetUserPortfolioBalanceHistory(uId int, d int) ([]models.P
 
 		//Next, Loop through stock Data for this entry and add totals to each date in map
 		for _, s := range sl {
+			q := us.Quantity * models.GetSplitRatioAfter(us.Ticker, sarr, s.Date)
+
 			if histMap[s.Date].Date.IsZero() {
 
 				//Initialize value
 				hd := models.PortfolioBalanceHistory{
 					Date:  s.Date,
-					Close: us.Quantity * s.Close,
-					Open:  us.Quantity * s.Open,
-					High:  us.Quantity * s.High,
-					Low:   us.Quantity * s.Low,
+					Close: q * s.Close,
+					Open:  q * s.Open,
+					High:  q * s.High,
+					Low:   q * s.Low,
 				}
 
 				histMap[s.Date] = hd
@@ -97,10 +107,10 @@ func (fms *FMService) GetUserPortfolioBalanceHistory(uId int, d int) ([]models.P
 
 				//Pull obj from map and update values before reinserting
 				hd := histMap[s.Date]
-				hd.Close += (us.Quantity * s.Close)
-				hd.Open += (us.Quantity * s.Open)
-				hd.High += (us.Quantity * s.High)
-				hd.Low += (us.Quantity * s.Low)
+				hd.Close += (q * s.Close)
+				hd.Open += (q * s.Open)
+				hd.High += (q * s.High)
+				hd.Low += (q * s.Low)
 
 				histMap[s.Date] = hd
 			}
@@ -125,3 +135,134 @@ func (fms *FMService) GetUserPortfolioBalanceHistory(uId int, d int) ([]models.P
 	klogger.Exit(method)
 	return hist, nil
 }
+
+// Function GetUserPortfolioPerformance calculates the returns of a user's portfolio and of each stock they have owned for each period up to t
+// uId - The ID of the user to calculate performance for
+// periods - The periods to calculate returns over
+// t - The date returns are measured up to
+func (fms *FMService) GetUserPortfolioPerformance(uId int, periods []string, t time.Time) (models.PortfolioPerformance, error) {
+	method := "fm_stockservice.GetUserPortfolioPerformance"
+	klogger.Enter(method)
+
+	if uId <= 0 {
+		err := errors.New("uId is required")
+		klogger.ExitError(method, err.Error())
+		return models.PortfolioPerformance{}, err
+	}
+
+	series, err := fms.getUserPerformanceSeries(uId, t)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return models.PortfolioPerformance{}, err
+	}
+
+	klogger.Exit(method)
+	return models.NewPortfolioPerformance(periods, series, t), nil
+}
+
+// Function getUserPerformanceSeries values each stock a user has owned on each day from the first day it was owned up to t
+// uId - The ID of the user to value stocks for
+// t - The date to value stocks up to
+func (fms *FMService) getUserPerformanceSeries(uId int, t time.Time) (map[string][]models.PerformanceDay, error) {
+	method := "fm_stockservice.getUserPerformanceSeries"
+	klogger.Enter(method)
+
+	usl, err := fms.DB.GetAllUserStocksByDateRange(uId, "", time.Time{}, t)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	sarr, err := fms.DB.GetStockSplitsByTicker("")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	darr, err := fms.DB.GetStockDividendsByTicker("")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	parr := models.GetDividendPayments(usl, darr)
+
+	//Each stock is measured from the first day it was owned
+	inception := make(map[string]time.Time)
+
+	for _, us := range usl {
+		if us.Type == constants.UserStockTypeWatch {
+			continue
+		}
+
+		if d, ok := inception[us.Ticker]; !ok || us.EffectiveDt.Before(d) {
+			inception[us.Ticker] = us.EffectiveDt
+		}
+	}
+
+	series := make(map[string][]models.PerformanceDay)
+
+	for ticker, sd := range inception {
+		bars, err := fms.DB.GetStockDataByTickerAndDateRange(ticker, sd, t)
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return nil, err
+		}
+
+		series[ticker] = models.BuildPerformanceDays(ticker, usl, bars, sarr, parr)
+	}
+
+	klogger.Exit(method)
+	return series, nil
+}
+
+// Function GetUserPortfolioBenchmarks compares the portfolio balance history of a user to each benchmark ticker over the dates of hist
+// uId - The ID of the user the history belongs to
+// hist - The portfolio balance history of the user, ordered by date
+// tickers - The benchmark tickers to compare to. Each must already be loaded
+func (fms *FMService) GetUserPortfolioBenchmarks(uId int, hist []models.PortfolioBalanceHistory, tickers []string) ([]models.BenchmarkComparison, error) {
+	method := "fm_stockservice.GetUserPortfolioBenchmarks"
+	klogger.Enter(method)
+
+	barr := []models.BenchmarkComparison{}
+
+	if uId <= 0 {
+		err := errors.New("uId is required")
+		klogger.ExitError(method, err.Error())
+		return barr, err
+	}
+
+	if len(hist) == 0 || len(tickers) == 0 {
+		klogger.Exit(method)
+		return barr, nil
+	}
+
+	sd := hist[0].Date
+	ed := hist[len(hist)-1].Date
+
+	series, err := fms.getUserPerformanceSeries(uId, ed)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return barr, err
+	}
+
+	var darrs [][]models.PerformanceDay
+	for _, days := range series {
+		darrs = append(darrs, days)
+	}
+
+	days := models.CombinePerformanceDays(darrs)
+
+	for _, ticker := range tickers {
+		bars, err := fms.DB.GetStockDataByTickerAndDateRange(ticker, sd, ed)
+		if err != nil {
+			klogger.ExitError(method, constants.UnexpectedSQLError, err)
+			return barr, err
+		}
+
+		barr = append(barr, models.NewBenchmarkComparison(ticker, hist, days, bars))
+	}
+
+	klogger.Exit(method)
+	return barr, nil
+}
@@ -114,3 +114,66 @@ func TestGetUserPortfolioBalanceHistory(t *testing.T) {
 
 	klogger.Exit(method)
 }
+
+func TestGetUserPortfolioBalanceHistory_split(t *testing.T) {
+	method := "fm_stockservice.TestGetUserPortfolioBalanceHistory_split"
+	klogger.Enter(method)
+
+	d := time.Now()
+	d = time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.Local)
+
+	//A 2:1 split two days ago. Saved stock data is already adjusted for it, so the price does not change
+	s := models.StockSplit{
+		Ticker:      "AAPL",
+		ExecutionDt: d.Add(-2 * 24 * time.Hour),
+		SplitFrom:   1,
+		SplitTo:     2,
+	}
+
+	fms.DB.InsertStockSplit(s)
+
+	us1 := models.UserStock{
+		UserId:       1,
+		Type:         constants.UserStockTypeOwn,
+		Ticker:       "AAPL",
+		Quantity:     1,
+		EffectiveDt:  d.Add(-5 * 24 * time.Hour),
+		ExpirationDt: sql.NullTime{Time: s.ExecutionDt.Add(-1 * time.Millisecond), Valid: true},
+	}
+	us2 := models.UserStock{
+		UserId:      1,
+		Type:        constants.UserStockTypeOwn,
+		Ticker:      "AAPL",
+		Quantity:    2,
+		EffectiveDt: s.ExecutionDt,
+	}
+
+	fms.DB.InsertUserStock(us1)
+	fms.DB.InsertUserStock(us2)
+
+	for sd := d.Add(-4 * 24 * time.Hour); sd.Compare(d) <= 0; sd = sd.Add(24 * time.Hour) {
+		s1 := models.StockData{
+			Ticker: "AAPL",
+			Close:  50,
+			Date:   sd,
+		}
+		p.GormDB.Create(&s1)
+	}
+
+	hist, err := fms.GetUserPortfolioBalanceHistory(1, 5)
+
+	//The share held before the split is worth the same as the two held after it
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(hist))
+
+	for _, h := range hist {
+		assert.Equal(t, 100.0, h.Close)
+	}
+
+	//Cleanup
+	p.GormDB.Exec("DELETE FROM user_stocks")
+	p.GormDB.Exec("DELETE FROM stock_data")
+	p.GormDB.Exec("DELETE FROM stock_splits")
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,217 @@
+package fmservice
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Function GetUserSummary builds the Summary of a user's finances for the month containing t
+// uId - The ID of the user to build the summary for
+// t - A date in the month to summarize
+// includeDividends - Whether to add the dividends paid to the user during the month to their income
+func (fms *FMService) GetUserSummary(uId int, t time.Time, includeDividends bool) (models.Summary, error) {
+	method := "fm_summaryservice.GetUserSummary"
+	klogger.Enter(method)
+
+	summary := models.Summary{
+		Date: t,
+	}
+
+	data, err := fms.loadUserSummaryData(uId, t, includeDividends)
+	if err != nil {
+		klogger.ExitError(method, "failed to load user summary data:\n%v", err)
+		return summary, err
+	}
+
+	summary.LoadData(data)
+	summary.Finalize()
+
+	klogger.Exit(method)
+	return summary, nil
+}
+
+// Function GetUserSummaryProjection builds the Summary of a user's finances for each of months months beginning with the month containing t
+// uId - The ID of the user to build the summaries for
+// t - A date in the first month to summarize
+// months - The number of months to summarize
+// includeDividends - Whether to add the dividends paid to the user during each month to their income
+func (fms *FMService) GetUserSummaryProjection(uId int, t time.Time, months int, includeDividends bool) ([]models.Summary, error) {
+	method := "fm_summaryservice.GetUserSummaryProjection"
+	klogger.Enter(method)
+
+	if months < 1 || months > constants.SummaryMaxMonths {
+		err := errors.New("months is out of range")
+		klogger.ExitError(method, err.Error())
+		return nil, err
+	}
+
+	data, err := fms.loadUserSummaryData(uId, t, includeDividends)
+	if err != nil {
+		klogger.ExitError(method, "failed to load user summary data:\n%v", err)
+		return nil, err
+	}
+
+	summaries := models.ProjectSummaries(t, months, data)
+
+	klogger.Exit(method)
+	return summaries, nil
+}
+
+// Function GetUserDividendPayments returns the payments a user receives from the dividends of the stocks they have owned up to t
+// uId - The ID of the user to get dividend payments for
+// t - The last date stocks are held through
+func (fms *FMService) GetUserDividendPayments(uId int, t time.Time) ([]models.DividendPayment, error) {
+	method := "fm_summaryservice.GetUserDividendPayments"
+	klogger.Enter(method)
+
+	darr, err := fms.DB.GetStockDividendsByTicker("")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	usl, err := fms.DB.GetAllUserStocksByDateRange(uId, "", time.Time{}, t)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return nil, err
+	}
+
+	klogger.Exit(method)
+	return models.GetDividendPayments(usl, darr), nil
+}
+
+// Function loadUserSummaryData loads the loans, incomes, bills, credit cards, savings goals, bank accounts and budgets of a user
+// and prepares them to be summarized as of t. Dividend payments are only loaded when includeDividends is true
+func (fms *FMService) loadUserSummaryData(uId int, t time.Time, includeDividends bool) (models.SummaryData, error) {
+	method := "fm_summaryservice.loadUserSummaryData"
+	klogger.Enter(method)
+
+	var data models.SummaryData
+
+	if uId <= 0 {
+		err := errors.New("uId is required")
+		klogger.ExitError(method, err.Error())
+		return data, err
+	}
+
+	loans, err := fms.DB.GetAllUserLoans(uId, "")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return data, err
+	}
+
+	payments, err := fms.DB.GetAllUserLoanPayments(uId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return data, err
+	}
+
+	incomes, err := fms.DB.GetAllUserIncomes(uId, "")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return data, err
+	}
+
+	versions, err := fms.DB.GetAllUserIncomeVersions(uId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return data, err
+	}
+
+	hours, err := fms.DB.GetAllUserIncomeHours(uId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return data, err
+	}
+
+	bills, err := fms.DB.GetAllUserBills(uId, "")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return data, err
+	}
+
+	ccs, err := fms.DB.GetAllUserCreditCards(uId, "")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return data, err
+	}
+
+	goals, err := fms.DB.GetAllUserSavingsGoals(uId, "")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return data, err
+	}
+
+	contributions, err := fms.DB.GetAllUserSavingsGoalContributions(uId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return data, err
+	}
+
+	accounts, err := fms.DB.GetAllUserBankAccounts(uId, "")
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return data, err
+	}
+
+	transactions, err := fms.DB.GetAllUserBankAccountTransactions(uId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return data, err
+	}
+
+	budgets, err := fms.DB.GetAllUserBudgets(uId)
+	if err != nil {
+		klogger.ExitError(method, constants.UnexpectedSQLError, err)
+		return data, err
+	}
+
+	for _, i := range incomes {
+		i.LoadVersions(versions)
+		i.LoadLoggedHours(hours)
+		i.PopulateEmptyValues(t)
+	}
+
+	err = models.CalcUserTaxes(incomes, fms.TaxEngine)
+	if err != nil {
+		klogger.ExitError(method, "failed to calculate user income taxes:\n%v", err)
+		return data, err
+	}
+
+	for _, l := range loans {
+		l.LoadPayments(payments)
+	}
+
+	for _, g := range goals {
+		g.LoadContributions(contributions)
+		g.LoadIncome(incomes)
+		g.CalcProgress(t)
+	}
+
+	for _, a := range accounts {
+		a.LoadTransactions(transactions)
+	}
+
+	if includeDividends {
+		data.Dividends, err = fms.GetUserDividendPayments(uId, t)
+		if err != nil {
+			klogger.ExitError(method, "failed to load user dividends:\n%v", err)
+			return data, err
+		}
+	}
+
+	data.Loans = loans
+	data.Incomes = incomes
+	data.Bills = bills
+	data.CreditCards = ccs
+	data.SavingsGoals = goals
+	data.BankAccounts = accounts
+	data.Budgets = budgets
+
+	klogger.Exit(method)
+	return data, nil
+}
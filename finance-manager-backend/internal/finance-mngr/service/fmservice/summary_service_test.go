@@ -0,0 +1,53 @@
+package fmservice
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUserSummary(t *testing.T) {
+	method := "fm_summaryservice_test.TestGetUserSummary"
+	klogger.Enter(method)
+
+	d := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+
+	//Test with invalid userId
+	_, err := fms.GetUserSummary(0, d, false)
+	assert.NotNil(t, err)
+
+	//A user without any data has an empty summary
+	s, err := fms.GetUserSummary(1, d, true)
+	assert.Nil(t, err)
+	assert.Equal(t, d, s.Date)
+	assert.Equal(t, 0.0, s.IncomeSummary.DividendIncome)
+
+	klogger.Exit(method)
+}
+
+func TestGetUserSummaryProjection(t *testing.T) {
+	method := "fm_summaryservice_test.TestGetUserSummaryProjection"
+	klogger.Enter(method)
+
+	d := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+
+	//Test with invalid userId and months
+	_, err := fms.GetUserSummaryProjection(0, d, 3, false)
+	assert.NotNil(t, err)
+
+	_, err = fms.GetUserSummaryProjection(1, d, 0, false)
+	assert.NotNil(t, err)
+
+	_, err = fms.GetUserSummaryProjection(1, d, constants.SummaryMaxMonths+1, false)
+	assert.NotNil(t, err)
+
+	sarr, err := fms.GetUserSummaryProjection(1, d, 3, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(sarr))
+	assert.Equal(t, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), sarr[2].Date)
+
+	klogger.Exit(method)
+}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/jon-kamis/klogger"
@@ -79,7 +80,12 @@ func (ps *PolygonService) makeExternalCall(api string) ([]byte, error) {
 	method := "polygon_service.makeExternalCall"
 	klogger.Enter(method)
 
-	uri := api + "?apiKey=" + ps.PolygonApiKey
+	sep := "?"
+	if strings.Contains(api, "?") {
+		sep = "&"
+	}
+
+	uri := api + sep + "apiKey=" + ps.PolygonApiKey
 	klogger.Debug(method, "attempting to call external uri %s", api)
 
 	response, err := http.Get(uri)
@@ -217,3 +223,109 @@ func (ps *PolygonService) FetchStockWithTickerForDateRange(t string, d1 time.Tim
 	klogger.Exit(method)
 	return s, nil
 }
+
+// Function getReferenceApi returns the root of the polygon API that reference APIs are called from. BaseApi includes the
+// version of the aggregate APIs
+func (ps *PolygonService) getReferenceApi() string {
+	method := "polygon_service.getReferenceApi"
+	klogger.Enter(method)
+	klogger.Exit(method)
+	return strings.TrimSuffix(strings.TrimSuffix(ps.BaseApi, "/"), "/v2")
+}
+
+// Function FetchStockSplitsWithTicker fetches every split of ticker t
+func (ps *PolygonService) FetchStockSplitsWithTicker(t string) ([]models.StockSplit, error) {
+	method := "polygon_service.FetchStockSplitsWithTicker"
+	klogger.Enter(method)
+
+	api := fmt.Sprintf(ps.getReferenceApi()+constants.PolygonGetSplitsAPI, t)
+
+	resp, err := ps.makeExternalCall(api)
+	var s []models.StockSplit
+	var sr restmodels.SplitResponse
+
+	if err != nil {
+		klogger.ExitError(method, err.Error())
+		return s, err
+	}
+
+	err = json.Unmarshal(resp, &sr)
+	if err != nil {
+		klogger.ExitError(method, err.Error())
+		return s, err
+	}
+
+	for _, sri := range sr.Results {
+		d, err := time.Parse(constants.PolygonDateFormat, sri.ExecutionDate)
+
+		if err != nil {
+			klogger.ExitError(method, err.Error())
+			return s, err
+		}
+
+		i := models.StockSplit{
+			Ticker:      sri.Ticker,
+			ExecutionDt: d,
+			SplitFrom:   sri.SplitFrom,
+			SplitTo:     sri.SplitTo,
+		}
+		s = append(s, i)
+	}
+
+	klogger.Exit(method)
+	return s, nil
+}
+
+// Function FetchStockDividendsWithTicker fetches every cash dividend of ticker t. Dividends that have not yet been given a pay date are skipped
+func (ps *PolygonService) FetchStockDividendsWithTicker(t string) ([]models.StockDividend, error) {
+	method := "polygon_service.FetchStockDividendsWithTicker"
+	klogger.Enter(method)
+
+	api := fmt.Sprintf(ps.getReferenceApi()+constants.PolygonGetDividendsAPI, t)
+
+	resp, err := ps.makeExternalCall(api)
+	var d []models.StockDividend
+	var dr restmodels.DividendResponse
+
+	if err != nil {
+		klogger.ExitError(method, err.Error())
+		return d, err
+	}
+
+	err = json.Unmarshal(resp, &dr)
+	if err != nil {
+		klogger.ExitError(method, err.Error())
+		return d, err
+	}
+
+	for _, dri := range dr.Results {
+		if dri.PayDate == "" {
+			continue
+		}
+
+		ex, err := time.Parse(constants.PolygonDateFormat, dri.ExDividendDate)
+
+		if err != nil {
+			klogger.ExitError(method, err.Error())
+			return d, err
+		}
+
+		pay, err := time.Parse(constants.PolygonDateFormat, dri.PayDate)
+
+		if err != nil {
+			klogger.ExitError(method, err.Error())
+			return d, err
+		}
+
+		i := models.StockDividend{
+			Ticker:       dri.Ticker,
+			CashAmount:   dri.CashAmount,
+			ExDividendDt: ex,
+			PayDt:        pay,
+		}
+		d = append(d, i)
+	}
+
+	klogger.Exit(method)
+	return d, nil
+}
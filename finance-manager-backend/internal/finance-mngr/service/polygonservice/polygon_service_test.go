@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/jon-kamis/klogger"
 	"github.com/stretchr/testify/assert"
@@ -157,3 +158,52 @@ func TestFetchStockWithTicker(t *testing.T) {
 
 	klogger.Exit(method)
 }
+
+func TestFetchStockSplitsWithTicker(t *testing.T) {
+	method := "polygon_service_test.TestFetchStockSplitsWithTicker"
+	klogger.Enter(method)
+
+	ticker := "AAPL"
+
+	sarr, err := ps.FetchStockSplitsWithTicker(ticker)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(sarr))
+	assert.Equal(t, ticker, sarr[0].Ticker)
+	assert.Equal(t, time.Date(2020, 8, 31, 0, 0, 0, 0, time.UTC), sarr[0].ExecutionDt)
+	assert.Equal(t, 4.0, sarr[0].GetRatio())
+
+	klogger.Exit(method)
+}
+
+func TestFetchStockDividendsWithTicker(t *testing.T) {
+	method := "polygon_service_test.TestFetchStockDividendsWithTicker"
+	klogger.Enter(method)
+
+	ticker := "AAPL"
+
+	darr, err := ps.FetchStockDividendsWithTicker(ticker)
+
+	//Dividends without a pay date are skipped
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(darr))
+	assert.Equal(t, ticker, darr[0].Ticker)
+	assert.Equal(t, 0.24, darr[0].CashAmount)
+	assert.Equal(t, time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC), darr[0].ExDividendDt)
+	assert.Equal(t, time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), darr[0].PayDt)
+
+	klogger.Exit(method)
+}
+
+func TestGetReferenceApi(t *testing.T) {
+	method := "polygon_service_test.TestGetReferenceApi"
+	klogger.Enter(method)
+
+	p := PolygonService{BaseApi: "https://api.polygon.io/v2"}
+	assert.Equal(t, "https://api.polygon.io", p.getReferenceApi())
+
+	p.BaseApi = "http://localhost:8081"
+	assert.Equal(t, "http://localhost:8081", p.getReferenceApi())
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,215 @@
+package statementimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Type CsvMapping names the columns of a CSV statement. Columns are named by their header, or by their zero based index when the
+// statement has no header row. Statements either have a signed AmountColumn or separate DebitColumn and CreditColumn
+type CsvMapping struct {
+	DateColumn   string
+	AmountColumn string
+	DebitColumn  string
+	CreditColumn string
+	PayeeColumn  string
+	MemoColumn   string
+	IdColumn     string
+	DateFormat   string
+	NoHeader     bool
+}
+
+func (m *CsvMapping) Validate() error {
+	method := "CsvMapping.Validate"
+	klogger.Enter(method)
+
+	if m.DateColumn == "" {
+		err := errors.New("dateColumn is required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	if m.AmountColumn == "" && m.DebitColumn == "" && m.CreditColumn == "" {
+		err := errors.New("amountColumn or debitColumn and creditColumn are required")
+		klogger.ExitError(method, err.Error())
+		return err
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+// Function ParseCSV reads the rows of a CSV statement from r using the columns named by m. Blank rows are skipped
+func ParseCSV(r io.Reader, m CsvMapping) ([]models.BankAccountTransaction, error) {
+	method := "statementimport.ParseCSV"
+	klogger.Enter(method)
+
+	err := m.Validate()
+	if err != nil {
+		klogger.ExitError(method, err.Error())
+		return nil, err
+	}
+
+	if m.DateFormat == "" {
+		m.DateFormat = constants.StatementCsvDefaultDateFormat
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		klogger.ExitError(method, "failed to read statement:\n%v", err)
+		return nil, err
+	}
+
+	var header []string
+	if !m.NoHeader && len(rows) > 0 {
+		header = rows[0]
+		rows = rows[1:]
+	}
+
+	cols := make(map[string]int)
+	for name, c := range map[string]string{"date": m.DateColumn, "amount": m.AmountColumn, "debit": m.DebitColumn,
+		"credit": m.CreditColumn, "payee": m.PayeeColumn, "memo": m.MemoColumn, "id": m.IdColumn} {
+		if c == "" {
+			continue
+		}
+
+		i, err := getColumnIndex(header, c)
+		if err != nil {
+			klogger.ExitError(method, err.Error())
+			return nil, err
+		}
+
+		cols[name] = i
+	}
+
+	tarr := []models.BankAccountTransaction{}
+
+	for n, row := range rows {
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+
+		//Row numbers count the header so that they match the line of the file
+		line := n + 1
+		if header != nil {
+			line++
+		}
+
+		d, err := time.Parse(m.DateFormat, getValue(row, cols, "date"))
+		if err != nil {
+			err = fmt.Errorf("row %d has an invalid date", line)
+			klogger.ExitError(method, err.Error())
+			return nil, err
+		}
+
+		var amount float64
+
+		if _, ok := cols["amount"]; ok {
+			amount, err = parseAmount(getValue(row, cols, "amount"))
+		} else {
+			var debit, credit float64
+			debit, err = parseAmount(getValue(row, cols, "debit"))
+
+			if err == nil {
+				credit, err = parseAmount(getValue(row, cols, "credit"))
+			}
+
+			amount = math.Abs(credit) - math.Abs(debit)
+		}
+
+		if err != nil {
+			err = fmt.Errorf("row %d has an invalid amount", line)
+			klogger.ExitError(method, err.Error())
+			return nil, err
+		}
+
+		tarr = append(tarr, newTransaction(d, amount, getValue(row, cols, "payee"), getValue(row, cols, "memo"), getValue(row, cols, "id")))
+	}
+
+	klogger.Exit(method)
+	return tarr, nil
+}
+
+// Function getColumnIndex returns the index of column c. Columns are matched to the header without regard to case,
+// and otherwise must be an index
+func getColumnIndex(header []string, c string) (int, error) {
+	method := "statementimport.getColumnIndex"
+	klogger.Enter(method)
+
+	for i, h := range header {
+		h = strings.TrimPrefix(h, "\ufeff")
+
+		if strings.EqualFold(strings.TrimSpace(h), strings.TrimSpace(c)) {
+			klogger.Exit(method)
+			return i, nil
+		}
+	}
+
+	i, err := strconv.Atoi(c)
+	if err != nil || i < 0 {
+		err = fmt.Errorf("column %s was not found", c)
+		klogger.ExitError(method, err.Error())
+		return -1, err
+	}
+
+	klogger.Exit(method)
+	return i, nil
+}
+
+// Function getValue returns the trimmed value of a mapped column of row, or an empty string if the column is not mapped or the row is short
+func getValue(row []string, cols map[string]int, name string) string {
+	method := "statementimport.getValue"
+	klogger.Enter(method)
+
+	i, ok := cols[name]
+	if !ok || i >= len(row) {
+		klogger.Exit(method)
+		return ""
+	}
+
+	klogger.Exit(method)
+	return strings.TrimSpace(row[i])
+}
+
+// Function parseAmount parses a currency amount such as -1,234.56, $12.00 or (12.00). Empty amounts are zero
+func parseAmount(s string) (float64, error) {
+	method := "statementimport.parseAmount"
+	klogger.Enter(method)
+
+	s = strings.NewReplacer("$", "", ",", "", " ", "").Replace(s)
+
+	if s == "" {
+		klogger.Exit(method)
+		return 0, nil
+	}
+
+	sign := 1.0
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		sign = -1
+		s = s[1 : len(s)-1]
+	}
+
+	a, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		klogger.ExitError(method, "failed to parse amount:\n%v", err)
+		return 0, err
+	}
+
+	klogger.Exit(method)
+	return sign * a, nil
+}
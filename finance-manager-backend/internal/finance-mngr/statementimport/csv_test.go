@@ -0,0 +1,91 @@
+package statementimport
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCSV(t *testing.T) {
+	method := "csv_test.TestParseCSV"
+	klogger.Enter(method)
+
+	//Headers are matched without regard to case
+	m := CsvMapping{DateColumn: "posting date", AmountColumn: "Amount", PayeeColumn: "Description"}
+
+	tarr, err := ParseCSV(openFixture(t, "checking.csv"), m)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(tarr))
+
+	assert.Equal(t, constants.TransactionTypeDeposit, tarr[0].Type)
+	assert.Equal(t, 1800.0, tarr[0].Amount)
+	assert.Equal(t, time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC), tarr[0].TransactionDt)
+	assert.Equal(t, "ACME CORP PAYROLL", tarr[0].Payee)
+
+	//Thousands separators, currency symbols and parentheses are understood
+	assert.Equal(t, constants.TransactionTypeWithdrawal, tarr[1].Type)
+	assert.Equal(t, 1200.0, tarr[1].Amount)
+	assert.Equal(t, constants.TransactionTypeWithdrawal, tarr[4].Type)
+	assert.Equal(t, 250.0, tarr[4].Amount)
+
+	klogger.Exit(method)
+}
+
+func TestParseCSV_debitCredit(t *testing.T) {
+	method := "csv_test.TestParseCSV_debitCredit"
+	klogger.Enter(method)
+
+	m := CsvMapping{
+		DateColumn:   "0",
+		PayeeColumn:  "1",
+		DebitColumn:  "2",
+		CreditColumn: "3",
+		MemoColumn:   "4",
+		DateFormat:   time.DateOnly,
+		NoHeader:     true,
+	}
+
+	tarr, err := ParseCSV(openFixture(t, "debit-credit.csv"), m)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(tarr))
+
+	assert.Equal(t, constants.TransactionTypeDeposit, tarr[0].Type)
+	assert.Equal(t, 1800.0, tarr[0].Amount)
+	assert.Equal(t, "DIRECT DEPOSIT", tarr[0].Description)
+
+	assert.Equal(t, constants.TransactionTypeWithdrawal, tarr[1].Type)
+	assert.Equal(t, 1200.0, tarr[1].Amount)
+	assert.Equal(t, time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC), tarr[1].TransactionDt)
+
+	klogger.Exit(method)
+}
+
+func TestParseCSV_invalid(t *testing.T) {
+	method := "csv_test.TestParseCSV_invalid"
+	klogger.Enter(method)
+
+	//A date column is required
+	_, err := ParseCSV(openFixture(t, "checking.csv"), CsvMapping{AmountColumn: "Amount"})
+	assert.NotNil(t, err)
+
+	//An amount, debit or credit column is required
+	_, err = ParseCSV(openFixture(t, "checking.csv"), CsvMapping{DateColumn: "Posting Date"})
+	assert.NotNil(t, err)
+
+	//Columns must exist
+	_, err = ParseCSV(openFixture(t, "checking.csv"), CsvMapping{DateColumn: "Date", AmountColumn: "Amount"})
+	assert.NotNil(t, err)
+
+	//Dates must match the date format
+	_, err = ParseCSV(openFixture(t, "checking.csv"), CsvMapping{DateColumn: "Posting Date", AmountColumn: "Amount", DateFormat: time.DateOnly})
+	assert.NotNil(t, err)
+
+	_, err = ParseCSV(strings.NewReader("Date,Amount\n02/01/2024,ten"), CsvMapping{DateColumn: "Date", AmountColumn: "Amount"})
+	assert.EqualError(t, err, "row 2 has an invalid amount")
+
+	klogger.Exit(method)
+}
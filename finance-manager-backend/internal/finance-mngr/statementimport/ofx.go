@@ -0,0 +1,139 @@
+package statementimport
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Function ParseOFX reads the STMTTRN transactions of an OFX or QFX statement from r.
+// Both the SGML statements of OFX 1.x, whose elements are not closed, and the XML statements of OFX 2.x are supported
+func ParseOFX(r io.Reader) ([]models.BankAccountTransaction, error) {
+	method := "statementimport.ParseOFX"
+	klogger.Enter(method)
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		klogger.ExitError(method, "failed to read statement:\n%v", err)
+		return nil, err
+	}
+
+	s := string(b)
+	start := strings.Index(strings.ToUpper(s), "<OFX>")
+
+	if start < 0 {
+		err = errors.New("file is not an OFX statement")
+		klogger.ExitError(method, err.Error())
+		return nil, err
+	}
+
+	tarr := []models.BankAccountTransaction{}
+	var fields map[string]string
+
+	for _, token := range strings.Split(s[start:], "<")[1:] {
+		tag, value, found := strings.Cut(token, ">")
+
+		if !found {
+			continue
+		}
+
+		tag = strings.ToUpper(strings.TrimSpace(tag))
+		value = html.UnescapeString(strings.TrimSpace(value))
+
+		switch {
+		case tag == "STMTTRN":
+			fields = make(map[string]string)
+		case tag == "/STMTTRN" && fields != nil:
+			t, err := newOFXTransaction(fields)
+			if err != nil {
+				err = fmt.Errorf("transaction %d %v", len(tarr)+1, err)
+				klogger.ExitError(method, err.Error())
+				return nil, err
+			}
+
+			tarr = append(tarr, t)
+			fields = nil
+		case fields != nil && !strings.HasPrefix(tag, "/") && value != "":
+			//PAYEE aggregates hold their own NAME, so the first NAME of a transaction is kept
+			if _, ok := fields[tag]; !ok {
+				fields[tag] = value
+			}
+		}
+	}
+
+	klogger.Exit(method)
+	return tarr, nil
+}
+
+// Function newOFXTransaction returns the transaction described by the elements of a STMTTRN
+func newOFXTransaction(fields map[string]string) (models.BankAccountTransaction, error) {
+	method := "statementimport.newOFXTransaction"
+	klogger.Enter(method)
+
+	d, err := parseOFXDate(fields["DTPOSTED"])
+	if err != nil {
+		klogger.ExitError(method, err.Error())
+		return models.BankAccountTransaction{}, err
+	}
+
+	amount, err := parseOFXAmount(fields["TRNAMT"])
+	if err != nil {
+		err = errors.New("has an invalid amount")
+		klogger.ExitError(method, err.Error())
+		return models.BankAccountTransaction{}, err
+	}
+
+	klogger.Exit(method)
+	return newTransaction(d, amount, fields["NAME"], fields["MEMO"], fields["FITID"]), nil
+}
+
+// Function parseOFXAmount returns the value of an OFX amount. A comma is the decimal separator of amounts without a period,
+// and is otherwise a thousands separator
+func parseOFXAmount(s string) (float64, error) {
+	method := "statementimport.parseOFXAmount"
+	klogger.Enter(method)
+
+	if strings.Contains(s, ".") {
+		s = strings.ReplaceAll(s, ",", "")
+	} else {
+		s = strings.ReplaceAll(s, ",", ".")
+	}
+
+	a, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		klogger.ExitError(method, "failed to parse amount:\n%v", err)
+		return 0, err
+	}
+
+	klogger.Exit(method)
+	return a, nil
+}
+
+// Function parseOFXDate returns the day of an OFX datetime. Datetimes begin with YYYYMMDD and may be followed by a time and time zone
+func parseOFXDate(s string) (time.Time, error) {
+	method := "statementimport.parseOFXDate"
+	klogger.Enter(method)
+
+	if len(s) < 8 {
+		err := errors.New("has an invalid date")
+		klogger.ExitError(method, err.Error())
+		return time.Time{}, err
+	}
+
+	d, err := time.Parse("20060102", s[:8])
+	if err != nil {
+		err = errors.New("has an invalid date")
+		klogger.ExitError(method, err.Error())
+		return time.Time{}, err
+	}
+
+	klogger.Exit(method)
+	return d, nil
+}
@@ -0,0 +1,99 @@
+package statementimport
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOFX_sgml(t *testing.T) {
+	method := "ofx_test.TestParseOFX_sgml"
+	klogger.Enter(method)
+
+	tarr, err := ParseOFX(openFixture(t, "checking.ofx"))
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(tarr))
+
+	assert.Equal(t, constants.TransactionTypeDeposit, tarr[0].Type)
+	assert.Equal(t, 1800.0, tarr[0].Amount)
+	assert.Equal(t, time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC), tarr[0].TransactionDt)
+	assert.Equal(t, "202402020001", tarr[0].ExternalID)
+	assert.Equal(t, "ACME CORP PAYROLL", tarr[0].Payee)
+	assert.Equal(t, "DIRECT DEPOSIT", tarr[0].Description)
+
+	assert.Equal(t, constants.TransactionTypeWithdrawal, tarr[1].Type)
+	assert.Equal(t, 1200.0, tarr[1].Amount)
+
+	//Entities are decoded
+	assert.Equal(t, "CORNER GROCERY & DELI", tarr[2].Payee)
+
+	klogger.Exit(method)
+}
+
+func TestParseOFX_xml(t *testing.T) {
+	method := "ofx_test.TestParseOFX_xml"
+	klogger.Enter(method)
+
+	tarr, err := ParseOFX(openFixture(t, "credit.qfx"))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(tarr))
+
+	//Payees are read from the PAYEE aggregate
+	assert.Equal(t, "STREAMFLIX", tarr[0].Payee)
+	assert.Equal(t, "MONTHLY PLAN", tarr[0].Description)
+	assert.Equal(t, 15.99, tarr[0].Amount)
+	assert.Equal(t, time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC), tarr[0].TransactionDt)
+
+	assert.Equal(t, constants.TransactionTypeDeposit, tarr[1].Type)
+	assert.Equal(t, "QFX0002", tarr[1].ExternalID)
+
+	klogger.Exit(method)
+}
+
+func TestParseOFX_invalid(t *testing.T) {
+	method := "ofx_test.TestParseOFX_invalid"
+	klogger.Enter(method)
+
+	_, err := ParseOFX(openFixture(t, "checking.csv"))
+	assert.NotNil(t, err)
+
+	_, err = ParseOFX(strings.NewReader("<OFX><STMTTRN><DTPOSTED>2024<TRNAMT>1.00</STMTTRN></OFX>"))
+	assert.NotNil(t, err)
+
+	_, err = ParseOFX(strings.NewReader("<OFX><STMTTRN><DTPOSTED>20240201<TRNAMT>one</STMTTRN></OFX>"))
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
+
+func TestParseOFXAmount(t *testing.T) {
+	method := "ofx_test.TestParseOFXAmount"
+	klogger.Enter(method)
+
+	a, err := parseOFXAmount("-42.50")
+	assert.Nil(t, err)
+	assert.Equal(t, -42.5, a)
+
+	//Commas are thousands separators in amounts with a period
+	a, err = parseOFXAmount("1,234.56")
+	assert.Nil(t, err)
+	assert.Equal(t, 1234.56, a)
+
+	a, err = parseOFXAmount("-1,200,000.00")
+	assert.Nil(t, err)
+	assert.Equal(t, -1200000.0, a)
+
+	//And decimal separators in amounts without one
+	a, err = parseOFXAmount("-42,50")
+	assert.Nil(t, err)
+	assert.Equal(t, -42.5, a)
+
+	_, err = parseOFXAmount("1,234,56")
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,104 @@
+// Package statementimport parses the OFX, QFX and CSV statements exported by banks into bank account transactions
+package statementimport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/internal/finance-mngr/models"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jon-kamis/klogger"
+)
+
+// Function Parse reads the transactions of a statement in format f from r. Transactions are returned in the order they appear in the
+// statement without an account, and each has an ExternalID that is the same every time the statement is parsed.
+// m is only used by CSV statements
+func Parse(f string, r io.Reader, m CsvMapping) ([]models.BankAccountTransaction, error) {
+	method := "statementimport.Parse"
+	klogger.Enter(method)
+
+	var tarr []models.BankAccountTransaction
+	var err error
+
+	switch f {
+	case constants.StatementFormatOFX, constants.StatementFormatQFX:
+		tarr, err = ParseOFX(r)
+	case constants.StatementFormatCSV:
+		tarr, err = ParseCSV(r, m)
+	default:
+		err = errors.New("format must be one of ofx, qfx or csv")
+	}
+
+	if err != nil {
+		klogger.ExitError(method, err.Error())
+		return nil, err
+	}
+
+	//Statements may list transactions that have not moved any money, such as declined payments
+	tarr = slices.DeleteFunc(tarr, func(t models.BankAccountTransaction) bool {
+		return t.Amount == 0
+	})
+
+	assignExternalIDs(tarr)
+
+	klogger.Exit(method)
+	return tarr, nil
+}
+
+// Function newTransaction returns a deposit for positive amounts and a withdrawal for negative amounts
+func newTransaction(d time.Time, amount float64, payee string, memo string, id string) models.BankAccountTransaction {
+	method := "statementimport.newTransaction"
+	klogger.Enter(method)
+
+	t := models.BankAccountTransaction{
+		Type:          constants.TransactionTypeDeposit,
+		Amount:        math.Round(math.Abs(amount)*100) / 100,
+		TransactionDt: d,
+		Payee:         strings.TrimSpace(payee),
+		Description:   strings.TrimSpace(memo),
+		ExternalID:    strings.TrimSpace(id),
+	}
+
+	if amount < 0 {
+		t.Type = constants.TransactionTypeWithdrawal
+	}
+
+	if t.Payee == "" {
+		t.Payee = t.Description
+	}
+
+	klogger.Exit(method)
+	return t
+}
+
+// Function assignExternalIDs gives each transaction without an ID from its bank a hash of its date, amount, payee and description.
+// Identical transactions in the same statement are told apart by the number of times they have already appeared
+func assignExternalIDs(tarr []models.BankAccountTransaction) {
+	method := "statementimport.assignExternalIDs"
+	klogger.Enter(method)
+
+	seen := make(map[string]int)
+
+	for i := range tarr {
+		t := &tarr[i]
+
+		if t.ExternalID != "" {
+			continue
+		}
+
+		key := fmt.Sprintf("%s|%s|%.2f|%s|%s", t.TransactionDt.Format(time.DateOnly), t.Type, t.Amount, t.Payee, t.Description)
+		sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%d", key, seen[key])))
+		seen[key]++
+
+		t.ExternalID = hex.EncodeToString(sum[:])
+	}
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,81 @@
+package statementimport
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/test/logtest"
+	"os"
+	"testing"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+const testFixtureDir = "../../../test/fixtures/statements/"
+
+func TestMain(m *testing.M) {
+
+	logtest.SetKloggerTestFileNameEnv()
+
+	method := "statementimport_test.TestMain"
+	klogger.Enter(method)
+
+	//Execute Code
+	code := m.Run()
+
+	klogger.Exit(method)
+	os.Exit(code)
+}
+
+func openFixture(t *testing.T, fn string) *os.File {
+	f, err := os.Open(testFixtureDir + fn)
+	if err != nil {
+		t.Fatalf("failed to open fixture %s: %v", fn, err)
+	}
+
+	t.Cleanup(func() { f.Close() })
+	return f
+}
+
+func TestParse(t *testing.T) {
+	method := "statementimport_test.TestParse"
+	klogger.Enter(method)
+
+	//Transactions that moved no money are skipped
+	tarr, err := Parse(constants.StatementFormatQFX, openFixture(t, "credit.qfx"), CsvMapping{})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tarr))
+	assert.Equal(t, "QFX0001", tarr[0].ExternalID)
+
+	_, err = Parse("pdf", openFixture(t, "credit.qfx"), CsvMapping{})
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
+
+func TestParse_externalIds(t *testing.T) {
+	method := "statementimport_test.TestParse_externalIds"
+	klogger.Enter(method)
+
+	m := CsvMapping{DateColumn: "Posting Date", AmountColumn: "Amount", PayeeColumn: "Description", IdColumn: "Reference"}
+
+	tarr, err := Parse(constants.StatementFormatCSV, openFixture(t, "checking.csv"), m)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(tarr))
+
+	//Transactions without a bank id are given a hash, and identical transactions are told apart
+	for _, tr := range tarr {
+		assert.Equal(t, 64, len(tr.ExternalID))
+	}
+
+	assert.NotEqual(t, tarr[2].ExternalID, tarr[3].ExternalID)
+
+	//The same statement is given the same ids every time it is parsed
+	again, err := Parse(constants.StatementFormatCSV, openFixture(t, "checking.csv"), m)
+	assert.Nil(t, err)
+
+	for i := range tarr {
+		assert.Equal(t, tarr[i].ExternalID, again[i].ExternalID)
+	}
+
+	klogger.Exit(method)
+}
@@ -0,0 +1,160 @@
+package tax
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/jon-kamis/klogger"
+	"gopkg.in/yaml.v3"
+)
+
+// Type TaxBracket taxes income above Min at Rate percent until the next bracket begins
+type TaxBracket struct {
+	Min  float64 `yaml:"min"`
+	Rate float64 `yaml:"rate"`
+}
+
+// Type TaxTable holds the standard deductions and tax brackets of a single taxing authority keyed by filing status
+type TaxTable struct {
+	StandardDeduction map[string]float64      `yaml:"standardDeduction"`
+	Brackets          map[string][]TaxBracket `yaml:"brackets"`
+}
+
+// Type FicaTable holds the Social Security and Medicare rates. Social Security is only owed on wages up to its wage base,
+// and additional Medicare tax is owed on wages above a threshold keyed by filing status
+type FicaTable struct {
+	SocialSecurityRate          float64            `yaml:"socialSecurityRate"`
+	SocialSecurityWageBase      float64            `yaml:"socialSecurityWageBase"`
+	MedicareRate                float64            `yaml:"medicareRate"`
+	AdditionalMedicareRate      float64            `yaml:"additionalMedicareRate"`
+	AdditionalMedicareThreshold map[string]float64 `yaml:"additionalMedicareThreshold"`
+}
+
+// Type BracketTaxEngine calculates progressive federal and state income tax and FICA from bracket tables
+type BracketTaxEngine struct {
+	Year    int                 `yaml:"year"`
+	Federal TaxTable            `yaml:"federal"`
+	Fica    FicaTable           `yaml:"fica"`
+	States  map[string]TaxTable `yaml:"states"`
+}
+
+// Function LoadBracketTaxEngine reads a BracketTaxEngine from the YAML bracket tables in file fn
+func LoadBracketTaxEngine(fn string) (*BracketTaxEngine, error) {
+	method := "bracket_tax_engine.LoadBracketTaxEngine"
+	klogger.Enter(method)
+
+	f, err := os.ReadFile(fn)
+	if err != nil {
+		klogger.ExitError(method, "failed to read tax table file:\n%v", err)
+		return nil, err
+	}
+
+	var e BracketTaxEngine
+	err = yaml.Unmarshal(f, &e)
+	if err != nil {
+		klogger.ExitError(method, "failed to parse tax table file:\n%v", err)
+		return nil, err
+	}
+
+	e.sortBrackets()
+
+	klogger.Exit(method)
+	return &e, nil
+}
+
+func (e *BracketTaxEngine) CalcAnnualLiability(g float64, f float64, filingStatus string, state string) (TaxLiability, error) {
+	method := "bracket_tax_engine.CalcAnnualLiability"
+	klogger.Enter(method)
+
+	var l TaxLiability
+
+	if g < 0 || f < 0 {
+		err := errors.New("income cannot be negative")
+		klogger.ExitError(method, err.Error())
+		return l, err
+	}
+
+	fb, ok := e.Federal.Brackets[filingStatus]
+	if !ok {
+		err := fmt.Errorf("filing status %s is not supported", filingStatus)
+		klogger.ExitError(method, err.Error())
+		return l, err
+	}
+
+	l.Federal = CalcBracketTax(g-e.Federal.StandardDeduction[filingStatus], fb)
+
+	if state != "" {
+		st, ok := e.States[strings.ToUpper(state)]
+		if !ok {
+			err := fmt.Errorf("state %s is not supported", state)
+			klogger.ExitError(method, err.Error())
+			return l, err
+		}
+
+		l.State = CalcBracketTax(g-st.StandardDeduction[filingStatus], st.Brackets[filingStatus])
+	}
+
+	l.SocialSecurity = math.Min(f, e.Fica.SocialSecurityWageBase) * (e.Fica.SocialSecurityRate / 100)
+	l.Medicare = f * (e.Fica.MedicareRate / 100)
+
+	if t, ok := e.Fica.AdditionalMedicareThreshold[filingStatus]; ok && f > t {
+		l.Medicare += (f - t) * (e.Fica.AdditionalMedicareRate / 100)
+	}
+
+	l.Total = l.Federal + l.State + l.SocialSecurity + l.Medicare
+
+	klogger.Exit(method)
+	return l, nil
+}
+
+// Function CalcBracketTax returns the tax owed on taxable income t using progressive brackets b.
+// Brackets must be ordered by their minimum
+func CalcBracketTax(t float64, b []TaxBracket) float64 {
+	method := "bracket_tax_engine.CalcBracketTax"
+	klogger.Enter(method)
+
+	tax := 0.0
+
+	for k, br := range b {
+		if t <= br.Min {
+			break
+		}
+
+		top := t
+		if k+1 < len(b) && b[k+1].Min < t {
+			top = b[k+1].Min
+		}
+
+		tax += (top - br.Min) * (br.Rate / 100)
+	}
+
+	klogger.Exit(method)
+	return tax
+}
+
+// Function sortBrackets orders every bracket table by its minimum so tables may be configured in any order
+func (e *BracketTaxEngine) sortBrackets() {
+	method := "bracket_tax_engine.sortBrackets"
+	klogger.Enter(method)
+
+	tables := []TaxTable{e.Federal}
+	for _, st := range e.States {
+		tables = append(tables, st)
+	}
+
+	for _, t := range tables {
+		for _, fs := range constants.ValidFilingStatuses {
+			b := t.Brackets[fs]
+			sort.SliceStable(b, func(i, j int) bool {
+				return b[i].Min < b[j].Min
+			})
+		}
+	}
+
+	klogger.Exit(method)
+}
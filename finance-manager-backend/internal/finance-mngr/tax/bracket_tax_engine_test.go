@@ -0,0 +1,119 @@
+package tax
+
+import (
+	"finance-manager-backend/internal/finance-mngr/constants"
+	"finance-manager-backend/test/logtest"
+	"os"
+	"testing"
+
+	"github.com/jon-kamis/klogger"
+	"github.com/stretchr/testify/assert"
+)
+
+const testTaxTableFile = "../../../properties/tax-tables.yml"
+
+func TestMain(m *testing.M) {
+
+	logtest.SetKloggerTestFileNameEnv()
+
+	method := "bracket_tax_engine_test.TestMain"
+	klogger.Enter(method)
+
+	//Execute Code
+	code := m.Run()
+
+	klogger.Exit(method)
+	os.Exit(code)
+}
+
+func TestLoadBracketTaxEngine(t *testing.T) {
+	method := "bracket_tax_engine_test.TestLoadBracketTaxEngine"
+	klogger.Enter(method)
+
+	e, err := LoadBracketTaxEngine(testTaxTableFile)
+	assert.Nil(t, err)
+	assert.Equal(t, 7, len(e.Federal.Brackets[constants.FilingStatusSingle]))
+	assert.Equal(t, 168600.0, e.Fica.SocialSecurityWageBase)
+
+	//States may share brackets between filing statuses
+	assert.Equal(t, e.States["VA"].Brackets[constants.FilingStatusSingle], e.States["VA"].Brackets[constants.FilingStatusMarriedJoint])
+
+	_, err = LoadBracketTaxEngine("does-not-exist.yml")
+	assert.NotNil(t, err)
+
+	klogger.Exit(method)
+}
+
+func TestCalcBracketTax(t *testing.T) {
+	method := "bracket_tax_engine_test.TestCalcBracketTax"
+	klogger.Enter(method)
+
+	b := []TaxBracket{
+		{Min: 0, Rate: 10},
+		{Min: 1000, Rate: 20},
+		{Min: 2000, Rate: 30},
+	}
+
+	assert.Equal(t, 0.0, CalcBracketTax(-500, b))
+	assert.Equal(t, 50.0, CalcBracketTax(500, b))
+	assert.Equal(t, 100.0, CalcBracketTax(1000, b))
+	assert.Equal(t, 200.0, CalcBracketTax(1500, b))
+	assert.Equal(t, 600.0, CalcBracketTax(3000, b))
+	assert.Equal(t, 0.0, CalcBracketTax(3000, nil))
+
+	klogger.Exit(method)
+}
+
+func TestCalcAnnualLiability(t *testing.T) {
+	method := "bracket_tax_engine_test.TestCalcAnnualLiability"
+	klogger.Enter(method)
+
+	e, err := LoadBracketTaxEngine(testTaxTableFile)
+	assert.Nil(t, err)
+
+	l, err := e.CalcAnnualLiability(60000, 60000, constants.FilingStatusSingle, "")
+	assert.Nil(t, err)
+	assert.InDelta(t, 5216.0, l.Federal, 0.001)
+	assert.Equal(t, 0.0, l.State)
+	assert.InDelta(t, 3720.0, l.SocialSecurity, 0.001)
+	assert.InDelta(t, 870.0, l.Medicare, 0.001)
+	assert.InDelta(t, 9806.0, l.Total, 0.001)
+
+	//State codes are not case sensitive
+	l, err = e.CalcAnnualLiability(60000, 60000, constants.FilingStatusSingle, "va")
+	assert.Nil(t, err)
+	assert.InDelta(t, 2732.5, l.State, 0.001)
+
+	l, err = e.CalcAnnualLiability(60000, 60000, constants.FilingStatusSingle, "TX")
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, l.State)
+
+	//Social Security stops at the wage base and additional medicare applies above the threshold
+	l, err = e.CalcAnnualLiability(250000, 250000, constants.FilingStatusSingle, "")
+	assert.Nil(t, err)
+	assert.InDelta(t, 10453.2, l.SocialSecurity, 0.001)
+	assert.InDelta(t, 4075.0, l.Medicare, 0.001)
+
+	//Income below the standard deduction owes no income tax
+	l, err = e.CalcAnnualLiability(20000, 20000, constants.FilingStatusMarriedJoint, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, l.Federal)
+
+	_, err = e.CalcAnnualLiability(60000, 60000, "invalid", "")
+	assert.NotNil(t, err)
+
+	_, err = e.CalcAnnualLiability(60000, 60000, constants.FilingStatusSingle, "ZZ")
+	assert.NotNil(t, err)
+
+	_, err = e.CalcAnnualLiability(-1, -1, constants.FilingStatusSingle, "")
+	assert.NotNil(t, err)
+
+	//Pre-tax deductions may lower income tax without lowering FICA wages
+	l, err = e.CalcAnnualLiability(50000, 60000, constants.FilingStatusSingle, "")
+	assert.Nil(t, err)
+	assert.InDelta(t, 4016.0, l.Federal, 0.001)
+	assert.InDelta(t, 3720.0, l.SocialSecurity, 0.001)
+	assert.InDelta(t, 870.0, l.Medicare, 0.001)
+
+	klogger.Exit(method)
+}
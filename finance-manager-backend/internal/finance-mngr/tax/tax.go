@@ -0,0 +1,20 @@
+// Package tax contains the engines used to calculate the taxes owed on a user's income
+package tax
+
+// Type TaxEngine calculates the annual tax liability of an income.
+// Engines are pluggable so that other tax systems can be supported without changing the models that use them
+type TaxEngine interface {
+
+	//Calculates the annual tax liability for a filing status and two letter state code where g is the income subject to income tax
+	//and f is the wages subject to Social Security and Medicare. An empty state does not owe state income tax
+	CalcAnnualLiability(g float64, f float64, filingStatus string, state string) (TaxLiability, error)
+}
+
+// Type TaxLiability is the itemized tax owed on an income for a period of time
+type TaxLiability struct {
+	Federal        float64 `json:"federal"`
+	State          float64 `json:"state"`
+	SocialSecurity float64 `json:"socialSecurity"`
+	Medicare       float64 `json:"medicare"`
+	Total          float64 `json:"total"`
+}
@@ -19,13 +19,30 @@ type AppValidator interface {
 
 	//Loans
 	LoanBelongsToUser(loan models.Loan, userId int) error
+	LoanPaymentBelongsToUser(p models.LoanPayment, userId int) error
 
 	//Incomes
 	IncomeBelongsToUser(income models.Income, userId int) error
+	IncomeVersionBelongsToUser(v models.IncomeVersion, userId int) error
+	IncomeHoursBelongsToUser(h models.IncomeHours, userId int) error
+
+	//Savings Goals
+	SavingsGoalBelongsToUser(g models.SavingsGoal, userId int) error
+	SavingsGoalContributionBelongsToUser(c models.SavingsGoalContribution, userId int) error
 
 	//Bills
 	BillBelongsToUser(bill models.Bill, userId int) error
 
+	//Bank Accounts
+	BankAccountBelongsToUser(a models.BankAccount, userId int) error
+	BankAccountTransactionBelongsToUser(t models.BankAccountTransaction, userId int) error
+
+	//Budgets
+	BudgetBelongsToUser(b models.Budget, userId int) error
+
+	//Categorization Rules
+	CategorizationRuleBelongsToUser(r models.CategorizationRule, userId int) error
+
 	//Credit Cards
 	CreditCardBelongsToUser(cc models.CreditCard, userId int) error
 }
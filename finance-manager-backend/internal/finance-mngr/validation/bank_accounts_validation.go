@@ -0,0 +1,34 @@
+package validation
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/models"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func (fmv *FinanceManagerValidator) BankAccountBelongsToUser(a models.BankAccount, userId int) error {
+	method := "bank_accounts_validation.BankAccountBelongsToUser"
+	klogger.Enter(method)
+
+	if a.ID == 0 || a.UserID == 0 || userId == 0 || a.UserID != userId {
+		klogger.ExitError(method, "bank account does not belong to user")
+		return errors.New("forbidden")
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (fmv *FinanceManagerValidator) BankAccountTransactionBelongsToUser(t models.BankAccountTransaction, userId int) error {
+	method := "bank_accounts_validation.BankAccountTransactionBelongsToUser"
+	klogger.Enter(method)
+
+	if t.ID == 0 || t.UserID == 0 || userId == 0 || t.UserID != userId {
+		klogger.ExitError(method, "bank account transaction does not belong to user")
+		return errors.New("forbidden")
+	}
+
+	klogger.Exit(method)
+	return nil
+}
@@ -0,0 +1,73 @@
+package validation
+
+import (
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func TestBankAccountBelongsToUser(t *testing.T) {
+	method := "bank_accounts_validation_test.TestBankAccountBelongsToUser"
+	klogger.Enter(method)
+
+	v := FinanceManagerValidator{}
+
+	a := models.BankAccount{
+		ID:     1,
+		UserID: 1,
+	}
+
+	err := v.BankAccountBelongsToUser(a, 1)
+
+	if err != nil {
+		t.Errorf("Unexpected error when validating Bank Account belongs to user %v\n", err)
+	}
+
+	err = v.BankAccountBelongsToUser(models.BankAccount{}, 1)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	err = v.BankAccountBelongsToUser(a, 2)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	klogger.Exit(method)
+}
+
+func TestBankAccountTransactionBelongsToUser(t *testing.T) {
+	method := "bank_accounts_validation_test.TestBankAccountTransactionBelongsToUser"
+	klogger.Enter(method)
+
+	v := FinanceManagerValidator{}
+
+	tr := models.BankAccountTransaction{
+		ID:            1,
+		BankAccountID: 1,
+		UserID:        1,
+	}
+
+	err := v.BankAccountTransactionBelongsToUser(tr, 1)
+
+	if err != nil {
+		t.Errorf("Unexpected error when validating Bank Account Transaction belongs to user %v\n", err)
+	}
+
+	err = v.BankAccountTransactionBelongsToUser(models.BankAccountTransaction{}, 1)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	err = v.BankAccountTransactionBelongsToUser(tr, 2)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	klogger.Exit(method)
+}
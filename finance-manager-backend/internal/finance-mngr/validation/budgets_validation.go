@@ -0,0 +1,21 @@
+package validation
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/models"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func (fmv *FinanceManagerValidator) BudgetBelongsToUser(b models.Budget, userId int) error {
+	method := "budgets_validation.BudgetBelongsToUser"
+	klogger.Enter(method)
+
+	if b.ID == 0 || b.UserID == 0 || userId == 0 || b.UserID != userId {
+		klogger.ExitError(method, "budget does not belong to user")
+		return errors.New("forbidden")
+	}
+
+	klogger.Exit(method)
+	return nil
+}
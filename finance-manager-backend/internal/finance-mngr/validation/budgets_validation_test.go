@@ -0,0 +1,40 @@
+package validation
+
+import (
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func TestBudgetBelongsToUser(t *testing.T) {
+	method := "budgets_validation_test.TestBudgetBelongsToUser"
+	klogger.Enter(method)
+
+	v := FinanceManagerValidator{}
+
+	b := models.Budget{
+		ID:     1,
+		UserID: 1,
+	}
+
+	err := v.BudgetBelongsToUser(b, 1)
+
+	if err != nil {
+		t.Errorf("Unexpected error when validating Budget belongs to user %v\n", err)
+	}
+
+	err = v.BudgetBelongsToUser(models.Budget{}, 1)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	err = v.BudgetBelongsToUser(b, 2)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	klogger.Exit(method)
+}
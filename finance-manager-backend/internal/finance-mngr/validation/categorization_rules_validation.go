@@ -0,0 +1,21 @@
+package validation
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/models"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func (fmv *FinanceManagerValidator) CategorizationRuleBelongsToUser(r models.CategorizationRule, userId int) error {
+	method := "categorization_rules_validation.CategorizationRuleBelongsToUser"
+	klogger.Enter(method)
+
+	if r.ID == 0 || r.UserID == 0 || userId == 0 || r.UserID != userId {
+		klogger.ExitError(method, "categorization rule does not belong to user")
+		return errors.New("forbidden")
+	}
+
+	klogger.Exit(method)
+	return nil
+}
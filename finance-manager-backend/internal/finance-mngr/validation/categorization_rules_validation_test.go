@@ -0,0 +1,40 @@
+package validation
+
+import (
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func TestCategorizationRuleBelongsToUser(t *testing.T) {
+	method := "categorization_rules_validation_test.TestCategorizationRuleBelongsToUser"
+	klogger.Enter(method)
+
+	v := FinanceManagerValidator{}
+
+	r := models.CategorizationRule{
+		ID:     1,
+		UserID: 1,
+	}
+
+	err := v.CategorizationRuleBelongsToUser(r, 1)
+
+	if err != nil {
+		t.Errorf("Unexpected error when validating Categorization Rule belongs to user %v\n", err)
+	}
+
+	err = v.CategorizationRuleBelongsToUser(models.CategorizationRule{}, 1)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	err = v.CategorizationRuleBelongsToUser(r, 2)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	klogger.Exit(method)
+}
@@ -19,3 +19,29 @@ func (fmv *FinanceManagerValidator) IncomeBelongsToUser(income models.Income, us
 	klogger.Exit(method)
 	return nil
 }
+
+func (fmv *FinanceManagerValidator) IncomeVersionBelongsToUser(v models.IncomeVersion, userId int) error {
+	method := "incomes_validation.IncomeVersionBelongsToUser"
+	klogger.Enter(method)
+
+	if v.ID == 0 || v.UserID == 0 || userId == 0 || v.UserID != userId {
+		klogger.ExitError(method, "income version does not belong to user")
+		return errors.New("forbidden")
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (fmv *FinanceManagerValidator) IncomeHoursBelongsToUser(h models.IncomeHours, userId int) error {
+	method := "incomes_validation.IncomeHoursBelongsToUser"
+	klogger.Enter(method)
+
+	if h.ID == 0 || h.UserID == 0 || userId == 0 || h.UserID != userId {
+		klogger.ExitError(method, "income hours do not belong to user")
+		return errors.New("forbidden")
+	}
+
+	klogger.Exit(method)
+	return nil
+}
@@ -81,3 +81,69 @@ func TestIncomeBelongsToUser_doesNotBelongToUser(t *testing.T) {
 
 	klogger.Enter(method)
 }
+
+func TestIncomeVersionBelongsToUser(t *testing.T) {
+	method := "incomes_validation_test.TestIncomeVersionBelongsToUser"
+	klogger.Enter(method)
+
+	v := FinanceManagerValidator{}
+
+	iv := models.IncomeVersion{
+		ID:       1,
+		IncomeID: 1,
+		UserID:   1,
+	}
+
+	err := v.IncomeVersionBelongsToUser(iv, 1)
+
+	if err != nil {
+		t.Errorf("Unexpected error when validating Income Version belongs to user %v\n", err)
+	}
+
+	err = v.IncomeVersionBelongsToUser(models.IncomeVersion{}, 1)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	err = v.IncomeVersionBelongsToUser(iv, 2)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	klogger.Exit(method)
+}
+
+func TestIncomeHoursBelongsToUser(t *testing.T) {
+	method := "incomes_validation_test.TestIncomeHoursBelongsToUser"
+	klogger.Enter(method)
+
+	v := FinanceManagerValidator{}
+
+	iv := models.IncomeHours{
+		ID:       1,
+		IncomeID: 1,
+		UserID:   1,
+	}
+
+	err := v.IncomeHoursBelongsToUser(iv, 1)
+
+	if err != nil {
+		t.Errorf("Unexpected error when validating Income Hours belongs to user %v\n", err)
+	}
+
+	err = v.IncomeHoursBelongsToUser(models.IncomeHours{}, 1)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	err = v.IncomeHoursBelongsToUser(iv, 2)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	klogger.Exit(method)
+}
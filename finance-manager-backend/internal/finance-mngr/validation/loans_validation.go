@@ -19,3 +19,16 @@ func (fmv *FinanceManagerValidator) LoanBelongsToUser(loan models.Loan, userId i
 	klogger.Exit(method)
 	return nil
 }
+
+func (fmv *FinanceManagerValidator) LoanPaymentBelongsToUser(p models.LoanPayment, userId int) error {
+	method := "loans_validation.LoanPaymentBelongsToUser"
+	klogger.Enter(method)
+
+	if p.ID == 0 || p.UserID == 0 || userId == 0 || p.UserID != userId {
+		klogger.ExitError(method, "loan payment does not belong to user")
+		return errors.New("forbidden")
+	}
+
+	klogger.Exit(method)
+	return nil
+}
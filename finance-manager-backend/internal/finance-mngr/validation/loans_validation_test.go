@@ -81,3 +81,36 @@ func TestLoanBelongsToUser_doesNotBelongToUser(t *testing.T) {
 
 	klogger.Enter(method)
 }
+
+func TestLoanPaymentBelongsToUser(t *testing.T) {
+	method := "loans_validation_test.TestLoanPaymentBelongsToUser"
+	klogger.Enter(method)
+
+	v := FinanceManagerValidator{}
+
+	p := models.LoanPayment{
+		ID:     1,
+		LoanID: 1,
+		UserID: 1,
+	}
+
+	err := v.LoanPaymentBelongsToUser(p, 1)
+
+	if err != nil {
+		t.Errorf("Unexpected error when validating Loan Payment belongs to user %v\n", err)
+	}
+
+	err = v.LoanPaymentBelongsToUser(models.LoanPayment{}, 1)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	err = v.LoanPaymentBelongsToUser(p, 2)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	klogger.Exit(method)
+}
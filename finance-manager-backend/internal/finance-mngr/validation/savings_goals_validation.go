@@ -0,0 +1,34 @@
+package validation
+
+import (
+	"errors"
+	"finance-manager-backend/internal/finance-mngr/models"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func (fmv *FinanceManagerValidator) SavingsGoalBelongsToUser(g models.SavingsGoal, userId int) error {
+	method := "savings_goals_validation.SavingsGoalBelongsToUser"
+	klogger.Enter(method)
+
+	if g.ID == 0 || g.UserID == 0 || userId == 0 || g.UserID != userId {
+		klogger.ExitError(method, "savings goal does not belong to user")
+		return errors.New("forbidden")
+	}
+
+	klogger.Exit(method)
+	return nil
+}
+
+func (fmv *FinanceManagerValidator) SavingsGoalContributionBelongsToUser(c models.SavingsGoalContribution, userId int) error {
+	method := "savings_goals_validation.SavingsGoalContributionBelongsToUser"
+	klogger.Enter(method)
+
+	if c.ID == 0 || c.UserID == 0 || userId == 0 || c.UserID != userId {
+		klogger.ExitError(method, "savings goal contribution does not belong to user")
+		return errors.New("forbidden")
+	}
+
+	klogger.Exit(method)
+	return nil
+}
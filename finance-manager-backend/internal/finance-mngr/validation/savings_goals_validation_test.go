@@ -0,0 +1,73 @@
+package validation
+
+import (
+	"finance-manager-backend/internal/finance-mngr/models"
+	"testing"
+
+	"github.com/jon-kamis/klogger"
+)
+
+func TestSavingsGoalBelongsToUser(t *testing.T) {
+	method := "savings_goals_validation_test.TestSavingsGoalBelongsToUser"
+	klogger.Enter(method)
+
+	v := FinanceManagerValidator{}
+
+	g := models.SavingsGoal{
+		ID:     1,
+		UserID: 1,
+	}
+
+	err := v.SavingsGoalBelongsToUser(g, 1)
+
+	if err != nil {
+		t.Errorf("Unexpected error when validating Savings Goal belongs to user %v\n", err)
+	}
+
+	err = v.SavingsGoalBelongsToUser(models.SavingsGoal{}, 1)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	err = v.SavingsGoalBelongsToUser(g, 2)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	klogger.Exit(method)
+}
+
+func TestSavingsGoalContributionBelongsToUser(t *testing.T) {
+	method := "savings_goals_validation_test.TestSavingsGoalContributionBelongsToUser"
+	klogger.Enter(method)
+
+	v := FinanceManagerValidator{}
+
+	c := models.SavingsGoalContribution{
+		ID:            1,
+		SavingsGoalID: 1,
+		UserID:        1,
+	}
+
+	err := v.SavingsGoalContributionBelongsToUser(c, 1)
+
+	if err != nil {
+		t.Errorf("Unexpected error when validating Savings Goal Contribution belongs to user %v\n", err)
+	}
+
+	err = v.SavingsGoalContributionBelongsToUser(models.SavingsGoalContribution{}, 1)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	err = v.SavingsGoalContributionBelongsToUser(c, 2)
+
+	if err == nil {
+		t.Errorf("expected error but nothing was thrown\n")
+	}
+
+	klogger.Exit(method)
+}
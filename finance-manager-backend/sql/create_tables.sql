@@ -101,6 +101,21 @@ ALTER TABLE public.user_roles ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
     CACHE 1
 );
 
+--
+-- Name: bank_accounts; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.bank_accounts (
+    id integer NOT NULL,
+    user_id integer NOT NULL,
+    name character varying(255) NOT NULL,
+    type character varying(255) NOT NULL,
+    opening_balance NUMERIC(10, 2) DEFAULT 0 NOT NULL,
+    opening_dt timestamp NOT NULL,
+    create_dt timestamp,
+    last_update_dt timestamp
+);
+
 --
 -- Name: bank_account_id_seq; Type: SEQUENCE; Schema: public; Owner: -
 --
@@ -129,6 +144,13 @@ CREATE TABLE public.loans (
     monthly_payment NUMERIC(10,2),
     interest_rate NUMERIC(10,5),
     loan_term integer not null,
+    initial_fixed_months integer DEFAULT 0 NOT NULL,
+    adjustment_interval integer DEFAULT 0 NOT NULL,
+    adjusted_rate NUMERIC(10,5) DEFAULT 0 NOT NULL,
+    initial_cap NUMERIC(10,5) DEFAULT 0 NOT NULL,
+    periodic_cap NUMERIC(10,5) DEFAULT 0 NOT NULL,
+    lifetime_cap NUMERIC(10,5) DEFAULT 0 NOT NULL,
+    rate_steps jsonb,
     create_dt timestamp without time zone,
     last_update_dt timestamp without time zone
 );
@@ -180,7 +202,18 @@ CREATE TABLE public.incomes (
     amount NUMERIC(10, 2) NOT NULL,
     frequency character varying(255) NOT NULL,
     tax_percentage NUMERIC(10, 2) NOT NULL,
+    filing_status character varying(255) DEFAULT '' NOT NULL,
+    state character varying(255) DEFAULT '' NOT NULL,
+    retirement_percentage NUMERIC(10, 4) DEFAULT 0 NOT NULL,
+    roth_percentage NUMERIC(10, 4) DEFAULT 0 NOT NULL,
+    hsa_contribution NUMERIC(10, 2) DEFAULT 0 NOT NULL,
+    health_premium NUMERIC(10, 2) DEFAULT 0 NOT NULL,
+    garnishment NUMERIC(10, 2) DEFAULT 0 NOT NULL,
+    regular_hours_cap NUMERIC(10, 2) DEFAULT 0 NOT NULL,
+    overtime_multiplier NUMERIC(10, 4) DEFAULT 0 NOT NULL,
     start_dt timestamp,
+    end_dt timestamp,
+    account_id integer DEFAULT 0 NOT NULL,
     create_dt timestamp,
     last_update_dt timestamp without time zone
 );
@@ -284,6 +317,92 @@ ALTER TABLE public.user_stocks ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY
     CACHE 1
 );
 
+--
+-- Name: stock_splits; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.stock_splits (
+    id integer NOT NULL,
+    ticker character varying(255) NOT NULL,
+    execution_dt timestamp NOT NULL,
+    split_from NUMERIC(10,4) NOT NULL,
+    split_to NUMERIC(10,4) NOT NULL,
+    create_dt timestamp,
+    last_update_dt timestamp
+);
+
+--
+-- Name: stock_splits_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+ALTER TABLE public.stock_splits ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.stock_splits_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+--
+-- Name: stock_dividends; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.stock_dividends (
+    id integer NOT NULL,
+    ticker character varying(255) NOT NULL,
+    cash_amount NUMERIC(12,6) NOT NULL,
+    ex_dividend_dt timestamp NOT NULL,
+    pay_dt timestamp NOT NULL,
+    create_dt timestamp,
+    last_update_dt timestamp
+);
+
+--
+-- Name: stock_dividends_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+ALTER TABLE public.stock_dividends ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.stock_dividends_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+--
+-- Name: stock_transactions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.stock_transactions (
+    id integer NOT NULL,
+    user_id integer NOT NULL,
+    ticker character varying(255) NOT NULL,
+    type character varying(255) NOT NULL,
+    quantity NUMERIC(10,4) NOT NULL,
+    price NUMERIC(12,4) DEFAULT 0 NOT NULL,
+    fees NUMERIC(10,2) DEFAULT 0 NOT NULL,
+    lot_method character varying(255) DEFAULT '' NOT NULL,
+    lots jsonb,
+    transaction_dt timestamp NOT NULL,
+    create_dt timestamp,
+    last_update_dt timestamp
+);
+
+--
+-- Name: stock_transactions_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+ALTER TABLE public.stock_transactions ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.stock_transaction_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
 --
 -- Name: bills; Type: TABLE; Schema: public; Owner: -
 --
@@ -293,6 +412,10 @@ CREATE TABLE public.bills (
     user_id integer NOT NULL,
     name character varying(255) NOT NULL,
     amount NUMERIC(10, 2) NOT NULL,
+    frequency character varying(255) NOT NULL DEFAULT '',
+    due_dt timestamp,
+    end_dt timestamp,
+    account_id integer DEFAULT 0 NOT NULL,
     create_dt timestamp,
     last_update_dt timestamp
 );
@@ -340,6 +463,276 @@ ALTER TABLE public.credit_cards ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY
     CACHE 1
 );
 
+--
+-- Name: loan_payments; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.loan_payments (
+    id integer NOT NULL,
+    loan_id integer NOT NULL,
+    user_id integer NOT NULL,
+    type character varying(255) NOT NULL,
+    amount NUMERIC(10, 2) NOT NULL,
+    payment_dt timestamp NOT NULL,
+    create_dt timestamp,
+    last_update_dt timestamp
+);
+
+--
+-- Name: loan_payments_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+ALTER TABLE public.loan_payments ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.loan_payment_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+--
+-- Name: summary_snapshots; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.summary_snapshots (
+    id integer NOT NULL,
+    user_id integer NOT NULL,
+    snapshot_dt timestamp NOT NULL,
+    total_income NUMERIC(10, 2) NOT NULL,
+    total_cost NUMERIC(10, 2) NOT NULL,
+    net_funds NUMERIC(10, 2) NOT NULL,
+    loan_balance NUMERIC(10, 2) NOT NULL,
+    credit_card_balance NUMERIC(10, 2) NOT NULL,
+    total_balance NUMERIC(10, 2) NOT NULL,
+    credit_utilization NUMERIC(10, 2) NOT NULL,
+    account_balance NUMERIC(10, 2) DEFAULT 0 NOT NULL,
+    net_worth NUMERIC(10, 2) DEFAULT 0 NOT NULL,
+    create_dt timestamp
+);
+
+--
+-- Name: summary_snapshots_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+ALTER TABLE public.summary_snapshots ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.summary_snapshot_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+--
+-- Name: income_versions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.income_versions (
+    id integer NOT NULL,
+    income_id integer NOT NULL,
+    user_id integer NOT NULL,
+    rate NUMERIC(10, 2) NOT NULL,
+    hours NUMERIC(10, 2) DEFAULT 0 NOT NULL,
+    effective_dt timestamp NOT NULL,
+    create_dt timestamp,
+    last_update_dt timestamp
+);
+
+--
+-- Name: income_versions_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+ALTER TABLE public.income_versions ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.income_version_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+--
+-- Name: income_hours; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.income_hours (
+    id integer NOT NULL,
+    income_id integer NOT NULL,
+    user_id integer NOT NULL,
+    pay_dt timestamp NOT NULL,
+    hours NUMERIC(10, 2) NOT NULL,
+    create_dt timestamp,
+    last_update_dt timestamp
+);
+
+--
+-- Name: income_hours_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+ALTER TABLE public.income_hours ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.income_hours_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+--
+-- Name: savings_goals; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.savings_goals (
+    id integer NOT NULL,
+    user_id integer NOT NULL,
+    name character varying(255) NOT NULL,
+    target NUMERIC(10, 2) NOT NULL,
+    deadline timestamp NOT NULL,
+    income_id integer DEFAULT 0 NOT NULL,
+    contribution_per_pay NUMERIC(10, 2) DEFAULT 0 NOT NULL,
+    create_dt timestamp,
+    last_update_dt timestamp
+);
+
+--
+-- Name: savings_goals_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+ALTER TABLE public.savings_goals ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.savings_goal_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+--
+-- Name: savings_goal_contributions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.savings_goal_contributions (
+    id integer NOT NULL,
+    savings_goal_id integer NOT NULL,
+    user_id integer NOT NULL,
+    amount NUMERIC(10, 2) NOT NULL,
+    contribution_dt timestamp NOT NULL,
+    create_dt timestamp,
+    last_update_dt timestamp
+);
+
+--
+-- Name: savings_goal_contributions_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+ALTER TABLE public.savings_goal_contributions ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.savings_goal_contribution_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+--
+-- Name: bank_account_transactions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.bank_account_transactions (
+    id integer NOT NULL,
+    bank_account_id integer NOT NULL,
+    user_id integer NOT NULL,
+    type character varying(255) NOT NULL,
+    amount NUMERIC(10, 2) NOT NULL,
+    transaction_dt timestamp NOT NULL,
+    description character varying(255) DEFAULT '' NOT NULL,
+    transfer_account_id integer DEFAULT 0 NOT NULL,
+    source character varying(255) DEFAULT '' NOT NULL,
+    source_id integer DEFAULT 0 NOT NULL,
+    payee character varying(255) DEFAULT '' NOT NULL,
+    external_id character varying(255) DEFAULT '' NOT NULL,
+    category character varying(255) DEFAULT '' NOT NULL,
+    create_dt timestamp,
+    last_update_dt timestamp
+);
+
+--
+-- Name: bank_account_transactions_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+ALTER TABLE public.bank_account_transactions ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.bank_account_transaction_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+--
+-- Name: categorization_rules; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.categorization_rules (
+    id integer NOT NULL,
+    user_id integer NOT NULL,
+    name character varying(255) NOT NULL,
+    priority integer DEFAULT 0 NOT NULL,
+    payee_pattern character varying(255) DEFAULT '' NOT NULL,
+    min_amount NUMERIC(10, 2),
+    max_amount NUMERIC(10, 2),
+    account_id integer DEFAULT 0 NOT NULL,
+    category character varying(255) DEFAULT '' NOT NULL,
+    source character varying(255) DEFAULT '' NOT NULL,
+    source_id integer DEFAULT 0 NOT NULL,
+    create_dt timestamp,
+    last_update_dt timestamp
+);
+
+--
+-- Name: categorization_rules_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+ALTER TABLE public.categorization_rules ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.categorization_rule_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+--
+-- Name: budgets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.budgets (
+    id integer NOT NULL,
+    user_id integer NOT NULL,
+    category character varying(255) NOT NULL,
+    monthly_limit NUMERIC(10, 2) DEFAULT 0 NOT NULL,
+    rollover boolean DEFAULT false NOT NULL,
+    source character varying(255) DEFAULT '' NOT NULL,
+    source_id integer DEFAULT 0 NOT NULL,
+    start_dt timestamp NOT NULL,
+    create_dt timestamp,
+    last_update_dt timestamp
+);
+
+--
+-- Name: budgets_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+ALTER TABLE public.budgets ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.budget_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
 COPY public.users (id, username, first_name, last_name, email, password, create_dt, last_update_dt) FROM stdin;
 1	admin	admin	istrator	admin@fm.com	$2a$10$S9nLk.BzkZuSPXvdn6JXoO0VX/tf8QNebc0ct8J39n.mU8Gzz.pPS	2023-11-13 00:00:00	2023-11-13 00:00:00
 \.
@@ -360,6 +753,111 @@ COPY public.user_roles (id, user_id, role_id, code, create_dt, last_update_dt) F
 
 SELECT pg_catalog.setval('public.user_roles_id_seq', 3, true);
 
+--
+-- Name: summary_snapshots summary_snapshots_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.summary_snapshots
+    ADD CONSTRAINT summary_snapshots_pkey PRIMARY KEY (id);
+
+--
+-- Name: summary_snapshots summary_snapshots_user_id_snapshot_dt_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.summary_snapshots
+    ADD CONSTRAINT summary_snapshots_user_id_snapshot_dt_key UNIQUE (user_id, snapshot_dt);
+
+--
+-- Name: income_versions income_versions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.income_versions
+    ADD CONSTRAINT income_versions_pkey PRIMARY KEY (id);
+
+--
+-- Name: income_hours income_hours_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.income_hours
+    ADD CONSTRAINT income_hours_pkey PRIMARY KEY (id);
+
+--
+-- Name: savings_goals savings_goals_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.savings_goals
+    ADD CONSTRAINT savings_goals_pkey PRIMARY KEY (id);
+
+--
+-- Name: savings_goal_contributions savings_goal_contributions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.savings_goal_contributions
+    ADD CONSTRAINT savings_goal_contributions_pkey PRIMARY KEY (id);
+
+--
+-- Name: bank_account_transactions bank_account_transactions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bank_account_transactions
+    ADD CONSTRAINT bank_account_transactions_pkey PRIMARY KEY (id);
+
+--
+-- Name: categorization_rules categorization_rules_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.categorization_rules
+    ADD CONSTRAINT categorization_rules_pkey PRIMARY KEY (id);
+
+--
+-- Name: budgets budgets_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.budgets
+    ADD CONSTRAINT budgets_pkey PRIMARY KEY (id);
+
+--
+-- Name: budgets budgets_user_id_category_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.budgets
+    ADD CONSTRAINT budgets_user_id_category_key UNIQUE (user_id, category);
+
+--
+-- Name: stock_transactions stock_transactions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.stock_transactions
+    ADD CONSTRAINT stock_transactions_pkey PRIMARY KEY (id);
+
+--
+-- Name: stock_splits stock_splits_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.stock_splits
+    ADD CONSTRAINT stock_splits_pkey PRIMARY KEY (id);
+
+--
+-- Name: stock_splits stock_splits_ticker_execution_dt_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.stock_splits
+    ADD CONSTRAINT stock_splits_ticker_execution_dt_key UNIQUE (ticker, execution_dt);
+
+--
+-- Name: stock_dividends stock_dividends_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.stock_dividends
+    ADD CONSTRAINT stock_dividends_pkey PRIMARY KEY (id);
+
+--
+-- Name: stock_dividends stock_dividends_ticker_ex_dividend_dt_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.stock_dividends
+    ADD CONSTRAINT stock_dividends_ticker_ex_dividend_dt_key UNIQUE (ticker, ex_dividend_dt);
+
 --
 -- Name: users users_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -367,6 +865,13 @@ SELECT pg_catalog.setval('public.user_roles_id_seq', 3, true);
 ALTER TABLE ONLY public.loans
     ADD CONSTRAINT loans_pkey PRIMARY KEY (id);
 
+--
+-- Name: loan_payments loan_payments_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.loan_payments
+    ADD CONSTRAINT loan_payments_pkey PRIMARY KEY (id);
+
 --
 -- Name: users users_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -381,6 +886,13 @@ ALTER TABLE ONLY public.users
 ALTER TABLE ONLY public.bank_accounts
     ADD CONSTRAINT bank_account_pkey PRIMARY KEY (id);
 
+--
+-- Name: bank_account_transactions bank_account_transactions_external_id_key; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX bank_account_transactions_external_id_key ON public.bank_account_transactions
+    USING btree (bank_account_id, external_id) WHERE external_id <> '';
+
 --
 -- Name: roles roles_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
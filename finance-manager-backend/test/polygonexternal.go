@@ -6,6 +6,7 @@ import (
 	"finance-manager-backend/internal/finance-mngr/models/restmodels"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -28,6 +29,8 @@ func (m *MockPolygonApi) Routes() http.Handler {
 
 	r.Get(fmt.Sprintf(constants.PolygonGetPrevCloseAPI, "{ticker}"), m.Handler.MockGetStockByTicker)
 	r.Get(fmt.Sprintf(constants.PolygonGetDateRangeAPI, "{ticker}", "{startDt}", "{endDt}"), m.Handler.MockGetStockByTicker)
+	r.Get(strings.Split(constants.PolygonGetSplitsAPI, "?")[0], m.Handler.MockGetSplitsByTicker)
+	r.Get(strings.Split(constants.PolygonGetDividendsAPI, "?")[0], m.Handler.MockGetDividendsByTicker)
 	return r
 }
 
@@ -65,3 +68,65 @@ func (h *MockPolygonHandler) MockGetStockByTicker(w http.ResponseWriter, r *http
 	h.JSONUtil.WriteJSON(w, http.StatusOK, pc)
 	klogger.Exit(method)
 }
+
+func (h *MockPolygonHandler) MockGetSplitsByTicker(w http.ResponseWriter, r *http.Request) {
+	method := "polygonexternal.MockGetSplitsByTicker"
+	klogger.Enter(method)
+
+	ticker := r.URL.Query().Get("ticker")
+
+	i := restmodels.SplitResponseItem{
+		Id:            "1",
+		ExecutionDate: "2020-08-31",
+		SplitFrom:     1,
+		SplitTo:       4,
+		Ticker:        ticker,
+	}
+
+	sr := restmodels.SplitResponse{
+		RequestId: "1",
+		Results:   []restmodels.SplitResponseItem{i},
+		Status:    "OK",
+	}
+
+	h.JSONUtil.WriteJSON(w, http.StatusOK, sr)
+	klogger.Exit(method)
+}
+
+func (h *MockPolygonHandler) MockGetDividendsByTicker(w http.ResponseWriter, r *http.Request) {
+	method := "polygonexternal.MockGetDividendsByTicker"
+	klogger.Enter(method)
+
+	ticker := r.URL.Query().Get("ticker")
+
+	i1 := restmodels.DividendResponseItem{
+		Id:             "1",
+		CashAmount:     0.24,
+		Currency:       "USD",
+		DividendType:   "CD",
+		ExDividendDate: "2024-02-09",
+		Frequency:      4,
+		PayDate:        "2024-02-15",
+		Ticker:         ticker,
+	}
+
+	//Dividends that have been declared without a pay date
+	i2 := restmodels.DividendResponseItem{
+		Id:             "2",
+		CashAmount:     0.25,
+		Currency:       "USD",
+		DividendType:   "CD",
+		ExDividendDate: "2024-05-10",
+		Frequency:      4,
+		Ticker:         ticker,
+	}
+
+	dr := restmodels.DividendResponse{
+		RequestId: "1",
+		Results:   []restmodels.DividendResponseItem{i1, i2},
+		Status:    "OK",
+	}
+
+	h.JSONUtil.WriteJSON(w, http.StatusOK, dr)
+	klogger.Exit(method)
+}
@@ -45,9 +45,24 @@ func InitTables(db *gorm.DB) {
 	db.AutoMigrate(&models.UserRole{})
 	db.AutoMigrate(&models.Bill{})
 	db.AutoMigrate(&models.CreditCard{})
+	db.AutoMigrate(&models.Loan{})
+	db.AutoMigrate(&models.LoanPayment{})
 	db.AutoMigrate(&models.Stock{})
 	db.AutoMigrate(&models.UserStock{})
 	db.AutoMigrate(&models.StockData{})
+	db.AutoMigrate(&models.StockSplit{})
+	db.AutoMigrate(&models.StockDividend{})
+	db.AutoMigrate(&models.StockTransaction{})
+	db.AutoMigrate(&models.SummarySnapshot{})
+	db.AutoMigrate(&models.Income{})
+	db.AutoMigrate(&models.IncomeVersion{})
+	db.AutoMigrate(&models.IncomeHours{})
+	db.AutoMigrate(&models.SavingsGoal{})
+	db.AutoMigrate(&models.SavingsGoalContribution{})
+	db.AutoMigrate(&models.BankAccount{})
+	db.AutoMigrate(&models.BankAccountTransaction{})
+	db.AutoMigrate(&models.CategorizationRule{})
+	db.AutoMigrate(&models.Budget{})
 	klogger.Info(method, "tables initialized")
 
 	//Seed Data